@@ -21,16 +21,45 @@
 
 package tgdb
 
-import "time"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 type TGAdminConnection interface {
 	TGConnection
 	// CheckpointServer allows the programmatic control to do a checkpoint on server
 	CheckpointServer() TGError
 
+	// CreateAttributeDescriptor creates an attribute descriptor on the server. The descriptor is
+	// usually built with TGGraphMetadata.CreateAttributeDescriptor
+	CreateAttributeDescriptor(attrDesc TGAttributeDescriptor) TGError
+
+	// CreateEdgeType creates an edge type between two node types. Empty node type names allow
+	// edges between any node types. The attributes must already exist and pkeys must be a subset of attrs
+	CreateEdgeType(name string, fromNodeType, toNodeType string, direction TGDirectionType, attrs []string, pkeys []string) TGError
+
+	// CreateIndex creates an index on the attributes for the given node types
+	CreateIndex(name string, nodeTypes []string, attrs []string, unique bool) TGError
+
+	// CreateNodeType creates a node type. The attributes must already exist and pkeys must be a subset of attrs
+	CreateNodeType(name string, attrs []string, pkeys []string) TGError
+
+	// CreateUser creates a user with the given password and roles
+	CreateUser(name string, password string, roles []string) TGError
+
+	// Describe returns the server's description of the named system object - a node type,
+	// edge type, attribute descriptor, index or user
+	Describe(name string) (TGAdminDescription, TGError)
+
 	// DumpServerStackTrace allows the programmatic control to dump the stack trace on the server console
 	DumpServerStackTrace() TGError
 
+	// ExportDatabase streams the contents of the database to the writer in batches, without
+	// needing the server's admin tool. A nil options uses the defaults
+	ExportDatabase(w io.Writer, opts *TGExportOptions) TGError
+
 	// GetAttributeDescriptors gets the list of attribute descriptors
 	GetAttributeDescriptors() ([]TGAttributeDescriptor, TGError)
 
@@ -47,6 +76,10 @@ type TGAdminConnection interface {
 	// GetUsers gets the list of users
 	GetUsers() ([]TGUserInfo, TGError)
 
+	// ImportDatabase streams data previously written by ExportDatabase back into the database,
+	// and returns the number of instances imported per entity type. A nil options uses the defaults
+	ImportDatabase(r io.Reader, opts *TGImportOptions) ([]TGBulkDescriptor, TGError)
+
 	// KillConnection allows the programmatic control to stop a particular connection instance
 	KillConnection(sessionId int64) TGError
 
@@ -57,6 +90,87 @@ type TGAdminConnection interface {
 	StopServer() TGError
 }
 
+// ======= Bulk Import / Export Options =======
+const (
+	LoadOptionInsert = "insert"
+	LoadOptionUpsert = "upsert"
+
+	ErrorOptionStop   = "stop"
+	ErrorOptionIgnore = "ignore"
+
+	DateFormatMDY = "MDY"
+	DateFormatDMY = "DMY"
+	DateFormatYMD = "YMD"
+)
+
+// TGBulkDescriptor describes the number of instances of an entity type in a bulk export or import
+type TGBulkDescriptor interface {
+	// GetTypeName returns the name of the entity type
+	GetTypeName() string
+	// IsNode returns true if the entity type is a node type, false for an edge type
+	IsNode() bool
+	// GetNumInstances returns the number of instances of the entity type
+	GetNumInstances() int64
+}
+
+// TGBulkProgress reports how far a bulk export or import has got
+type TGBulkProgress struct {
+	// TypeName is the entity type of the batch that was just transferred
+	TypeName string
+	// Batches is the number of batches transferred so far
+	Batches int
+	// Entities is the number of entities transferred so far
+	Entities int64
+	// TotalEntities is the number of entities in the whole export
+	TotalEntities int64
+	// Bytes is the number of data bytes transferred so far
+	Bytes int64
+}
+
+// TGBulkProgressCallback is called after every batch of a bulk export or import
+type TGBulkProgressCallback func(progress TGBulkProgress)
+
+// TGExportOptions controls ExportDatabase
+type TGExportOptions struct {
+	// Context cancels the export; the server is asked to abandon it. Defaults to context.Background()
+	Context context.Context
+	// BatchSize is the maximum number of entities the server sends per batch. Defaults to 1000
+	BatchSize int
+	// Progress, if set, is called after every batch written to the writer
+	Progress TGBulkProgressCallback
+}
+
+// TGImportOptions controls ImportDatabase
+type TGImportOptions struct {
+	// Context cancels the import between batches. Defaults to context.Background()
+	Context context.Context
+	// LoadOption is LoadOptionInsert (default) or LoadOptionUpsert
+	LoadOption string
+	// ErrorOption is ErrorOptionStop (default) or ErrorOptionIgnore
+	ErrorOption string
+	// DateFormat is the date format of the exported data, DateFormatYMD by default
+	DateFormat string
+	// Progress, if set, is called after every batch sent to the server
+	Progress TGBulkProgressCallback
+}
+
+// TGAdminDescription is the server's description of a system object, as returned by TGAdminConnection.Describe
+type TGAdminDescription interface {
+	// GetSystemType returns the type of the described system object
+	GetSystemType() TGSystemType
+	// GetPrimary returns the described object: a TGNodeType, TGEdgeType, TGAttributeDescriptor,
+	// TGIndexInfo or TGUserInfo depending on GetSystemType
+	GetPrimary() interface{}
+	// GetAttributeDescriptors returns the attributes of a described node type, edge type or index
+	GetAttributeDescriptors() []TGAttributeDescriptor
+	// GetIndices returns the indices of a described node type
+	GetIndices() []TGIndexInfo
+	// GetFromNodeType returns the from-node type of a described edge type, if it has one
+	GetFromNodeType() TGNodeType
+	// GetToNodeType returns the to-node type of a described edge type, if it has one
+	GetToNodeType() TGNodeType
+}
+
 // TGCacheStatistics allows users to retrieve the Cache Statistics from server
 type TGCacheStatistics interface {
 	// GetDataCacheEntries returns the data-cache entries
@@ -23,7 +23,9 @@ package tgdb
 
 import (
 	"bytes"
+	"context"
 	"sync"
+	"time"
 )
 
 type TGChannel interface {
@@ -51,10 +53,14 @@ type TGChannel interface {
 	GetChannelURL() TGChannelUrl
 	// GetConnectionIndex gets the Connection Index
 	GetConnectionIndex() int
+	// GetCredentialProvider gets the provider of the credentials the channel authenticates with
+	GetCredentialProvider() TGCredentialProvider
 	// GetDataCryptoGrapher gets the Data Crypto Grapher
 	GetDataCryptoGrapher() TGDataCryptoGrapher
 	// GetExceptionCondition gets the Exception Condition
 	GetExceptionCondition() *sync.Cond
+	// GetExceptionListener gets the listener notified of channel failures and reconnects
+	GetExceptionListener() TGChannelExceptionListener
 	// GetLinkState gets the Link/Channel State
 	GetLinkState() LinkState
 	// GetNoOfConnections gets number of connections this channel has
@@ -65,7 +71,9 @@ type TGChannel interface {
 	GetProperties() TGProperties
 	// GetReader gets the Channel Reader
 	GetReader() TGChannelReader
-	// GetResponses gets the Channel Response Map
+	// GetResponse gets the Channel Response registered for a request, or nil
+	GetResponse(reqId int64) TGChannelResponse
+	// GetResponses gets a snapshot of the Channel Response Map
 	GetResponses() map[int64]TGChannelResponse
 	// GetServerProtocolVersion gets Server Protocol Version
 	//GetServerProtocolVersion() int
@@ -83,14 +91,22 @@ type TGChannel interface {
 	SendMessage(msg TGMessage) TGError
 	// SendRequest sends a Message, waits for a response in the message format, and blocks the thread till it gets the response
 	SendRequest(msg TGMessage, response TGChannelResponse) (TGMessage, TGError)
+	// SendRequestContext is SendRequest that stops waiting for the response once the context is cancelled or expires
+	SendRequestContext(ctx context.Context, msg TGMessage, response TGChannelResponse) (TGMessage, TGError)
 	// SetChannelLinkState sets the Link/Channel State
 	SetChannelLinkState(state LinkState)
 	// SetChannelURL sets the channel URL
 	SetChannelURL(channelUrl TGChannelUrl)
 	// SetConnectionIndex sets the connection index
 	SetConnectionIndex(index int)
+	// SetCredentialProvider sets the provider of the credentials the channel authenticates with
+	SetCredentialProvider(credentials TGCredentialProvider)
+	// SetExceptionListener sets the listener notified of channel failures and reconnects
+	SetExceptionListener(listener TGChannelExceptionListener)
 	// SetNoOfConnections sets number of connections
 	SetNoOfConnections(count int32)
+	// RemoveResponse removes the Channel Response registered for a request
+	RemoveResponse(reqId int64)
 	// SetResponse sets the ChannelResponse Map
 	SetResponse(reqId int64, response TGChannelResponse)
 	// SetAuthToken sets the Authorization Token
@@ -185,6 +201,8 @@ type TGChannelReader interface {
 type TGChannelResponse interface {
 	// Await waits (loops) till the channel response receives reply message from the server
 	Await(tester StatusTester)
+	// AwaitContext waits like Await, but returns a timeout or cancel error as soon as the context is done
+	AwaitContext(ctx context.Context, tester StatusTester) TGError
 	// GetCallback gets a Callback object
 	GetCallback() Callback
 	// GetReply gets Reply object
@@ -244,6 +262,56 @@ type TGDataCryptoGrapher interface {
 	Encrypt(decBuffer []byte) ([]byte, TGError)
 }
 
+// TGChannelExceptionListener is an event listener that gets triggered when the channel fails over or gives up
+type TGChannelExceptionListener interface {
+	// OnException is called when the channel could not be re-established on any of the primary or FT urls
+	OnException(ex TGError, duringClose bool)
+	// OnReconnect is called after the channel has re-connected, re-authenticated and is about to retry pending requests
+	OnReconnect(url TGChannelUrl)
+}
+
+// TGRequestObserver is notified each time a request sent on a channel completes
+type TGRequestObserver interface {
+	// OnRequestCompleted is called with the verb of the request, how long it took and the error it failed with, if any
+	OnRequestCompleted(verb string, latency time.Duration, err TGError)
+}
+
+// Attributes a channel sets on the span of each request
+const (
+	SpanAttributeVerb        = "tgdb.verb"
+	SpanAttributeRequestId   = "tgdb.request_id"
+	SpanAttributeSessionId   = "tgdb.session_id"
+	SpanAttributeMessageSize = "tgdb.message_size"
+	SpanAttributeOutcome     = "tgdb.outcome"
+)
+
+// TGRequestTracer opens a span around each request sent on a channel
+type TGRequestTracer interface {
+	// StartSpan opens a span as a child of the span carried by ctx, if any, and returns a context carrying the new span
+	StartSpan(ctx context.Context, name string) (context.Context, TGRequestSpan)
+}
+
+// TGRequestSpan is the span around one request
+type TGRequestSpan interface {
+	// SetAttribute records an attribute of the request
+	SetAttribute(key string, value interface{})
+	// End closes the span with the error the request failed with, if any
+	End(err TGError)
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan returns a copy of ctx carrying span, so that the requests sent with it are traced as its children
+func ContextWithSpan(ctx context.Context, span TGRequestSpan) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext returns the span carried by ctx, or nil
+func SpanFromContext(ctx context.Context) TGRequestSpan {
+	span, _ := ctx.Value(spanContextKey{}).(TGRequestSpan)
+	return span
+}
+
 type LinkEventHandler interface {
 	OnException(exception TGError, duringClose bool)
 	OnReconnect() bool
@@ -0,0 +1,281 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: main.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+// Command tgdb-wiredump prints the PDUs of the wire capture files written by a channel with
+// tgdb.connection.enableTrace set, one line per PDU or one JSON object per line.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"tgdb"
+	"tgdb/impl"
+	"time"
+)
+
+type captureLine struct {
+	Type            string    `json:"type"`
+	File            string    `json:"file"`
+	FormatVersion   uint16    `json:"formatVersion"`
+	ProtocolVersion string    `json:"protocolVersion"`
+	ClientId        string    `json:"clientId"`
+	CreatedAt       time.Time `json:"createdAt"`
+}
+
+type pduLine struct {
+	Type        string           `json:"type"`
+	File        string           `json:"file"`
+	Index       int              `json:"index"`
+	Time        time.Time        `json:"time"`
+	Direction   string           `json:"direction"`
+	Length      int              `json:"length"`
+	Header      *impl.WireHeader `json:"header,omitempty"`
+	DecodeError string           `json:"decodeError,omitempty"`
+	Summary     string           `json:"summary,omitempty"`
+	Hex         string           `json:"hex,omitempty"`
+}
+
+type filter struct {
+	verbs     map[string]bool
+	sessionId int64
+	session   bool
+	direction string
+}
+
+func main() {
+	verbPtr := flag.String("verb", "", "Only show the PDUs of these verbs")
+	sessionPtr := flag.String("session", "", "Only show the PDUs of this session")
+	directionPtr := flag.String("direction", "", "Only show the PDUs sent or received")
+	jsonPtr := flag.Bool("json", false, "Emit one JSON object per line")
+	hexPtr := flag.Bool("hex", false, "Include a hex dump of each PDU")
+	fullPtr := flag.Bool("full", false, "Do not truncate the payload summaries")
+
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: tgdb-wiredump [--verb names] [--session id] [--direction sent|received] [--json] [--hex] [--full] capture_file...\n\n")
+		fmt.Fprintf(os.Stderr, "optional arguments:\n")
+		fmt.Fprintf(os.Stderr, "  --verb names          Comma separated verb names or ids, e.g. VerbQueryRequest,VerbQueryResponse\n")
+		fmt.Fprintf(os.Stderr, "  --session id          Only show the PDUs of this session id\n")
+		fmt.Fprintf(os.Stderr, "  --direction           Only show the PDUs sent or received by the client\n")
+		fmt.Fprintf(os.Stderr, "  --json                Emit one JSON object per line instead of text\n")
+		fmt.Fprintf(os.Stderr, "  --hex                 Include a hex dump of each PDU\n")
+		fmt.Fprintf(os.Stderr, "  --full                Do not truncate the payload summaries\n")
+		fmt.Fprintf(os.Stderr, "  -h, --help            Show this help message.\n")
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := newFilter(*verbPtr, *sessionPtr, *directionPtr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "tgdb-wiredump: %s\n", err.Error())
+		os.Exit(2)
+	}
+
+	// Decoding failures are reported per PDU - keep the client library quiet
+	logger := impl.DefaultTGLogManager().GetLogger()
+	logger.SetLogLevel(tgdb.ErrorLog)
+	logger.SetLogWriter(ioutil.Discard)
+
+	failed := false
+	for _, fileName := range flag.Args() {
+		if err := dumpFile(fileName, f, *jsonPtr, *hexPtr, *fullPtr); err != nil {
+			fmt.Fprintf(os.Stderr, "tgdb-wiredump: %s: %s\n", fileName, err.Error())
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func newFilter(verbs, session, direction string) (*filter, error) {
+	f := &filter{verbs: make(map[string]bool)}
+	for _, verb := range strings.Split(verbs, ",") {
+		verb = strings.TrimSpace(verb)
+		if verb == "" {
+			continue
+		}
+		if id, err := strconv.Atoi(verb); err == nil {
+			verb = impl.GetVerb(id).GetName()
+		}
+		f.verbs[strings.ToLower(verb)] = true
+	}
+	if session != "" {
+		id, err := strconv.ParseInt(session, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid session id '%s'", session)
+		}
+		f.sessionId = id
+		f.session = true
+	}
+	switch strings.ToLower(direction) {
+	case "", "sent", "received":
+		f.direction = strings.ToLower(direction)
+	default:
+		return nil, fmt.Errorf("invalid direction '%s' - use sent or received", direction)
+	}
+	return f, nil
+}
+
+func (f *filter) matches(record *impl.WireCaptureRecord, header *impl.WireHeader) bool {
+	if f.direction != "" && record.Direction.String() != f.direction {
+		return false
+	}
+	if len(f.verbs) == 0 && !f.session {
+		return true
+	}
+	if header == nil {
+		return false
+	}
+	if len(f.verbs) > 0 && !f.verbs[strings.ToLower(header.VerbName)] {
+		return false
+	}
+	return !f.session || header.SessionId == f.sessionId
+}
+
+func dumpFile(fileName string, f *filter, asJson, withHex, full bool) error {
+	in, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	reader, tgErr := impl.NewWireCaptureReader(in)
+	if tgErr != nil {
+		return tgErr
+	}
+	captureHeader := reader.GetHeader()
+	out := json.NewEncoder(os.Stdout)
+	if asJson {
+		out.Encode(captureLine{
+			Type:            "capture",
+			File:            fileName,
+			FormatVersion:   captureHeader.FormatVersion,
+			ProtocolVersion: formatProtocolVersion(captureHeader.ProtocolVersion),
+			ClientId:        captureHeader.ClientId,
+			CreatedAt:       captureHeader.CreatedAt,
+		})
+	} else {
+		fmt.Printf("# %s: capture format %d, protocol %s, client '%s', created %s\n", fileName, captureHeader.FormatVersion,
+			formatProtocolVersion(captureHeader.ProtocolVersion), captureHeader.ClientId, captureHeader.CreatedAt.Format(time.RFC3339Nano))
+	}
+
+	for index := 1; ; index++ {
+		record, tgErr := reader.ReadRecord()
+		if tgErr != nil {
+			return tgErr
+		}
+		if record == nil {
+			return nil
+		}
+		line := decodeRecord(fileName, index, record, withHex, full)
+		if !f.matches(record, line.Header) {
+			continue
+		}
+		if asJson {
+			out.Encode(line)
+		} else {
+			printLine(line, captureHeader)
+		}
+	}
+}
+
+func decodeRecord(fileName string, index int, record *impl.WireCaptureRecord, withHex, full bool) *pduLine {
+	line := &pduLine{
+		Type:      "pdu",
+		File:      fileName,
+		Index:     index,
+		Time:      record.Timestamp,
+		Direction: record.Direction.String(),
+		Length:    len(record.Pdu),
+	}
+	if withHex {
+		line.Hex = hex.EncodeToString(record.Pdu)
+	}
+	header, err := impl.DecodeWireHeader(record.Pdu)
+	if err != nil {
+		line.DecodeError = err.GetErrorMsg()
+		return line
+	}
+	line.Header = header
+	summary, decodeErr := decodePayload(record.Pdu)
+	if decodeErr != "" {
+		line.DecodeError = decodeErr
+		return line
+	}
+	if !full && len(summary) > 256 {
+		summary = summary[:256] + "..."
+	}
+	line.Summary = summary
+	return line
+}
+
+// decodePayload creates the message for the PDU and returns its summary, or why it could not be created
+func decodePayload(pdu []byte) (summary string, decodeErr string) {
+	defer func() {
+		if r := recover(); r != nil {
+			summary, decodeErr = "", fmt.Sprintf("panic while decoding the payload: %v", r)
+		}
+	}()
+	msg, err := impl.CreateMessageFromBuffer(pdu, 0, len(pdu))
+	if err != nil {
+		return "", err.GetErrorMsg()
+	}
+	return msg.String(), ""
+}
+
+func printLine(line *pduLine, captureHeader impl.WireCaptureHeader) {
+	fmt.Printf("%6d %s %-8s %6d bytes", line.Index, line.Time.Format("15:04:05.000000"), line.Direction, line.Length)
+	if h := line.Header; h != nil {
+		fmt.Printf(" %s(%d) request=%d session=%d seq=%d protocol=%s", h.VerbName, h.VerbId, h.RequestId, h.SessionId,
+			h.SequenceNo, formatProtocolVersion(h.ProtocolVersion))
+		if h.ProtocolVersion != captureHeader.ProtocolVersion {
+			fmt.Printf(" (client %s)", formatProtocolVersion(captureHeader.ProtocolVersion))
+		}
+	}
+	fmt.Println()
+	if line.DecodeError != "" {
+		fmt.Printf("       ! %s\n", line.DecodeError)
+	}
+	if line.Summary != "" {
+		fmt.Printf("       %s\n", line.Summary)
+	}
+	if line.Hex != "" {
+		for i := 0; i < len(line.Hex); i += 64 {
+			end := i + 64
+			if end > len(line.Hex) {
+				end = len(line.Hex)
+			}
+			fmt.Printf("       %04x  %s\n", i/2, line.Hex[i:end])
+		}
+	}
+}
+
+func formatProtocolVersion(version uint16) string {
+	return fmt.Sprintf("%d.%d", version>>8, version&0xff)
+}
@@ -21,19 +21,37 @@
 
 package tgdb
 
+import (
+	"context"
+	"time"
+)
+
 type TGConnection interface {
+	// Begin starts an explicit transaction. Its changes are tracked on the transaction, apart from the implicit
+	// change list that Commit and Rollback flush. The server ties a transaction to the session rather than to its
+	// id, so a connection runs one explicit transaction at a time: Begin waits, for as long as opts.Context allows,
+	// until the open one is committed or rolled back. Use a connection per concurrent transaction
+	Begin(opts *TGTransactionOptions) (TGTransaction, TGError)
 	// Commit commits the current transaction on this connection
 	Commit() (TGResultSet, TGError)
+	// CommitContext is Commit that stops waiting on the server once the context is cancelled or expires
+	CommitContext(ctx context.Context) (TGResultSet, TGError)
 	// Connect establishes a network connection to the TGDB server
 	Connect() TGError
 	// CloseQuery closes a specific query and associated objects
 	CloseQuery(queryHashId int64) (TGQuery, TGError)
+	// CloseQueryContext is CloseQuery that stops waiting on the server once the context is cancelled or expires
+	CloseQueryContext(ctx context.Context, queryHashId int64) (TGQuery, TGError)
 	// CreateQuery creates a reusable query object that can be used to execute one or more statement
 	CreateQuery(expr string) (TGQuery, TGError)
+	// CreateQueryContext is CreateQuery that stops waiting on the server once the context is cancelled or expires
+	CreateQueryContext(ctx context.Context, expr string) (TGQuery, TGError)
 	// DecryptBuffer decrypts the encrypted buffer by sending a DecryptBufferRequest to the server
 	DecryptBuffer(is TGInputStream) ([]byte, TGError)
 	// DecryptEntity decrypts the encrypted entity using channel's data cryptographer
 	DecryptEntity(entityId int64) ([]byte, TGError)
+	// DecryptEntityContext is DecryptEntity that stops waiting on the server once the context is cancelled or expires
+	DecryptEntityContext(ctx context.Context, entityId int64) ([]byte, TGError)
 	// DeleteEntity marks an ENTITY for delete operation. Upon commit, the entity will be deleted from the database
 	DeleteEntity(entity TGEntity) TGError
 	// Disconnect breaks the connection from the TGDB server
@@ -42,12 +60,16 @@ type TGConnection interface {
 	EncryptEntity(rawBuffer []byte) ([]byte, TGError)
 	// ExecuteGremlinQuery executes a Gremlin Grammer-Based query with  query options
 	ExecuteGremlinQuery(expr string, collection []interface{}, options TGQueryOption) ([]interface{}, TGError)
+	// ExecuteGremlinQueryContext is ExecuteGremlinQuery that stops waiting on the server once the context is cancelled or expires
+	ExecuteGremlinQueryContext(ctx context.Context, expr string, collection []interface{}, options TGQueryOption) ([]interface{}, TGError)
 	// ExecuteQuery executes a query in either tqql or gremlin format.
 	// Format is determined by either 'tgql://' or 'gremlin://' prefixes in the 'expr' argument.
 	// The format can also be specified by using 'tgdb.connection.defaultQueryLanguage'
 	// connection property with value 'tgql' or 'gremlin'. Prefix in the query expression is no needed
 	// if connection property is used.
 	ExecuteQuery(expr string, options TGQueryOption) (TGResultSet, TGError)
+	// ExecuteQueryContext is ExecuteQuery that stops waiting on the server once the context is cancelled or expires
+	ExecuteQueryContext(ctx context.Context, expr string, options TGQueryOption) (TGResultSet, TGError)
 	// ExecuteQueryWithFilter executes an immediate query with specified filter & query options
 	// The query option is place holder at this time
 	// @param expr A subset of SQL-92 where clause
@@ -56,8 +78,12 @@ type TGConnection interface {
 	// @param endCondition condition used to stop the traversal
 	// @param option Query options for executing. Can be null, then it will use the default option
 	ExecuteQueryWithFilter(expr string, edgeFilter string, traversalCondition string, endCondition string, options TGQueryOption) (TGResultSet, TGError)
+	// ExecuteQueryWithFilterContext is ExecuteQueryWithFilter that stops waiting on the server once the context is cancelled or expires
+	ExecuteQueryWithFilterContext(ctx context.Context, expr string, edgeFilter string, traversalCondition string, endCondition string, options TGQueryOption) (TGResultSet, TGError)
 	// ExecuteQueryWithId executes an immediate query for specified id & query options
 	ExecuteQueryWithId(queryHashId int64, option TGQueryOption) (TGResultSet, TGError)
+	// ExecuteQueryWithIdContext is ExecuteQueryWithId that stops waiting on the server once the context is cancelled or expires
+	ExecuteQueryWithIdContext(ctx context.Context, queryHashId int64, option TGQueryOption) (TGResultSet, TGError)
 	// GetAddedList gets a list of added entities
 	GetAddedList() map[int64]TGEntity
 	// GetChangedList gets a list of changed entities
@@ -70,16 +96,26 @@ type TGConnection interface {
 	GetConnectionProperties() TGProperties
 	// GetEntities gets a result set of entities given an non-uniqueKey
 	GetEntities(key TGKey, properties TGProperties) (TGResultSet, TGError)
+	// GetEntitiesContext is GetEntities that stops waiting on the server once the context is cancelled or expires
+	GetEntitiesContext(ctx context.Context, key TGKey, properties TGProperties) (TGResultSet, TGError)
 	// GetEntity gets an Entity given an UniqueKey for the Object
 	GetEntity(key TGKey, options TGQueryOption) (TGEntity, TGError)
+	// GetEntityContext is GetEntity that stops waiting on the server once the context is cancelled or expires
+	GetEntityContext(ctx context.Context, key TGKey, options TGQueryOption) (TGEntity, TGError)
 	// GetGraphMetadata gets the Graph Metadata
 	GetGraphMetadata(refresh bool) (TGGraphMetadata, TGError)
+	// GetGraphMetadataContext is GetGraphMetadata that stops waiting on the server once the context is cancelled or expires
+	GetGraphMetadataContext(ctx context.Context, refresh bool) (TGGraphMetadata, TGError)
 	// GetGraphObjectFactory gets the Graph Object Factory for Object creation
 	GetGraphObjectFactory() (TGGraphObjectFactory, TGError)
 	// GetLargeObjectAsBytes gets an Binary Large Object Entity given an UniqueKey for the Object
 	GetLargeObjectAsBytes(entityId int64, decryptFlag bool) ([]byte, TGError)
+	// GetLargeObjectAsBytesContext is GetLargeObjectAsBytes that stops waiting on the server once the context is cancelled or expires
+	GetLargeObjectAsBytesContext(ctx context.Context, entityId int64, decryptFlag bool) ([]byte, TGError)
 	// GetRemovedList gets a list of removed entities
 	GetRemovedList() map[int64]TGEntity
+	// GetRetryPolicy gets the policy used to retry queries and closure-based commits
+	GetRetryPolicy() *TGRetryPolicy
 	// InsertEntity marks an ENTITY for insert operation. Upon commit, the entity will be inserted in the database
 	InsertEntity(entity TGEntity) TGError
 	// Rollback rolls back the current transaction on this connection
@@ -90,6 +126,8 @@ type TGConnection interface {
 	SetConnectionProperties(connProps TGProperties)
 	// SetExceptionListener sets exception listener
 	SetExceptionListener(listener TGConnectionExceptionListener)
+	// SetRetryPolicy sets the policy used to retry queries and closure-based commits
+	SetRetryPolicy(policy *TGRetryPolicy)
 	// UpdateEntity marks an ENTITY for update operation. Upon commit, the entity will be updated in the database
 	// When commit is called, the object is resolved to check if it is dirty. Entity.setAttribute calls make the entity
 	// dirty. If it is dirty, then the object is send to the server for update, otherwise it is ignored.
@@ -103,6 +141,8 @@ type TGConnectionPool interface {
 	AdminLock()
 	// AdminUnlock unlocks the connection pool so that the list of connections can be updated
 	AdminUnlock()
+	// AddPoolListener registers a listener for the acquire, release, evict and reconnect events of the pool
+	AddPoolListener(listener TGConnectionPoolListener)
 	// Connect establishes connection from this pool of available/configured connections to the TGDB server
 	// Exception could be BadAuthentication or BadUrl
 	Connect() TGError
@@ -114,13 +154,67 @@ type TGConnectionPool interface {
 	// 0 :      Indefinite
 	// -1 :     Immediate
 	// &gt; :   That many seconds
+	// Callers wait in line, and get connections in the order they asked for them
 	Get() (TGConnection, TGError)
+	// GetContext is Get that also stops waiting once the context is cancelled or expires
+	GetContext(ctx context.Context) (TGConnection, TGError)
 	// GetPoolSize gets pool size
 	GetPoolSize() int
+	// GetRetryPolicy gets the retry policy handed to the connections of this pool
+	GetRetryPolicy() *TGRetryPolicy
 	// ReleaseConnection frees the connection and sends back to the pool
 	ReleaseConnection(conn TGConnection) (TGConnectionPool, TGError)
+	// RemovePoolListener unregisters a listener added with AddPoolListener
+	RemovePoolListener(listener TGConnectionPoolListener)
 	// SetExceptionListener sets exception listener
 	SetExceptionListener(lsnr TGConnectionExceptionListener)
+	// SetRetryPolicy sets the retry policy of this pool and of every connection in it
+	SetRetryPolicy(policy *TGRetryPolicy)
+	// Stats returns a snapshot of the usage counters of the pool
+	Stats() TGConnectionPoolStats
+}
+
+// TGConnectionPoolStats is a snapshot of the usage of a connection pool. The counters are totals since the pool was created
+type TGConnectionPoolStats struct {
+	PoolSize int
+	// InUse, Idle and Waiting are the connections handed out, the free connections and the callers waiting in Get
+	InUse   int
+	Idle    int
+	Waiting int
+	// Acquisitions counts the connections handed out, and Waits those of them the caller had to wait for
+	Acquisitions int64
+	Waits        int64
+	// WaitTime is the time all the callers of Get have spent waiting
+	WaitTime time.Duration
+	// Timeouts counts the callers of Get that gave up before a connection was free
+	Timeouts int64
+	// Evictions counts the connections replaced as broken or past their max lifetime
+	Evictions int64
+	// Reconnects counts the times a channel of the pool failed over and reconnected
+	Reconnects int64
+	// Retries and RetryFailures add up the retry counts of the connections, see TGRetryPolicy
+	Retries       int64
+	RetryFailures int64
+}
+
+// TGConnectionPoolListener is notified of the life cycle of the pooled connections. The callbacks are made
+// synchronously from the pool, so they must return quickly
+type TGConnectionPoolListener interface {
+	// OnAcquire is called when a connection is handed out, with how long the caller waited for it
+	OnAcquire(conn TGConnection, wait time.Duration)
+	// OnRelease is called when a connection is returned to the pool
+	OnRelease(conn TGConnection)
+	// OnEvict is called when a connection is taken out of the pool to be replaced
+	OnEvict(conn TGConnection, reason string)
+	// OnReconnect is called for every connection on a channel that failed over and reconnected
+	OnReconnect(conn TGConnection)
+}
+
+// TGCredentialProvider supplies the credentials a channel authenticates with. It is consulted every time the channel
+// authenticates, including after a reconnect, so rotated credentials are picked up without recreating the connection
+type TGCredentialProvider interface {
+	// GetCredentials returns the user name and password. An empty user name keeps the one configured for the channel
+	GetCredentials() (string, []byte, TGError)
 }
 
 type TGConnectionExceptionListener interface {
@@ -130,9 +224,48 @@ type TGConnectionExceptionListener interface {
 
 type TGTransaction interface {
 	TGSerializable
+	// GetTransactionId gets the id the server assigned to this transaction
+	GetTransactionId() int64
+	// Insert marks an entity for insert when this transaction commits
+	Insert(entity TGEntity) TGError
+	// Update marks an entity for update when this transaction commits
+	Update(entity TGEntity) TGError
+	// Delete marks an entity for delete when this transaction commits
+	Delete(entity TGEntity) TGError
+	// Get gets an entity by key as this transaction sees it - pending updates are returned and pending deletes are not
+	Get(key TGKey, options TGQueryOption) (TGEntity, TGError)
+	// Commit sends the changes of this transaction to the server
+	Commit() (TGResultSet, TGError)
+	// Rollback discards the changes of this transaction
+	Rollback() TGError
+	// IsActive checks whether the transaction can still be used, i.e. it is neither committed nor rolled back
+	IsActive() bool
 	String() string
 }
 
+// TGTransactionOptions controls Begin
+type TGTransactionOptions struct {
+	// Context bounds every server round trip the transaction makes. Defaults to context.Background()
+	Context context.Context
+}
+
+// TGRetryPolicy controls how queries and closure-based commits are retried after a transient failure
+type TGRetryPolicy struct {
+	// MaxAttempts is the number of tries including the first one. 1 or less disables retries
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry. Each later wait is Multiplier times longer, up to MaxBackoff
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Jitter randomizes each wait by up to this fraction of it, between 0 and 1
+	Jitter float64
+	// RetryableErrorTypes lists the error types, as returned by TGError.GetErrorType, that are retried. A channel that
+	// could not be reconnected is matched as TGErrorChannelDisconnected, whatever the failure that broke it
+	RetryableErrorTypes []int
+	// RetryableTransactionStatuses lists the commit failures that are retried when the caller supplies a closure
+	RetryableTransactionStatuses []TGTransactionStatus
+}
+
 // ======= Various Connection Types =======
 type TypeConnection int
 const (
@@ -145,6 +278,12 @@ type TGConnectionFactory interface {
 	CreateAdminConnection(url, user, pwd string, env map[string]string) (TGConnection, TGError)
 	CreateConnectionPool(url, user, pwd string, poolSize int, env map[string]string) (TGConnectionPool, TGError)
 	CreateConnectionPoolWithType(url, user, pwd string, poolSize int, env map[string]string, connType TypeConnection) (TGConnectionPool, TGError)
+	// CreateConnectionWithCredentials creates a connection that authenticates with the credentials of the provider
+	CreateConnectionWithCredentials(url string, credentials TGCredentialProvider, env map[string]string) (TGConnection, TGError)
+	// CreateAdminConnectionWithCredentials creates an admin connection that authenticates with the credentials of the provider
+	CreateAdminConnectionWithCredentials(url string, credentials TGCredentialProvider, env map[string]string) (TGConnection, TGError)
+	// CreateConnectionPoolWithCredentials creates a connection pool whose channels authenticate with the credentials of the provider
+	CreateConnectionPoolWithCredentials(url string, credentials TGCredentialProvider, poolSize int, env map[string]string, connType TypeConnection) (TGConnectionPool, TGError)
 }
 
 
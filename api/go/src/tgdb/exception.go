@@ -32,4 +32,17 @@ type TGError interface {
 	GetErrorMsg() string
 	GetErrorDetails() string
 	GetServerErrorCode() int
-}
\ No newline at end of file
+}
+
+// TGTransactionError is the error returned when the server rejects a transaction
+type TGTransactionError interface {
+	TGError
+	// GetTransactionStatus returns the status the server reported
+	GetTransactionStatus() TGTransactionStatus
+	// GetEntityIds returns the virtual ids of the entities the failure most likely involves. The server only
+	// reports a status, so these are candidates - when the status cannot be narrowed down, every entity in the
+	// transaction is listed.
+	GetEntityIds() []int64
+	// GetAttributeNames returns the names of the attributes the failure most likely involves, as candidates too
+	GetAttributeNames() []string
+}
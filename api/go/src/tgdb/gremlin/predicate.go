@@ -0,0 +1,174 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: predicate.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package gremlin
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"tgdb"
+	"tgdb/impl"
+)
+
+// P is a predicate on an attribute value, used as the value of a Has step
+type P struct {
+	name   string
+	values []interface{}
+}
+
+// Eq matches values equal to value
+func Eq(value interface{}) P {
+	return P{name: "eq", values: []interface{}{value}}
+}
+
+// Neq matches values not equal to value
+func Neq(value interface{}) P {
+	return P{name: "neq", values: []interface{}{value}}
+}
+
+// Lt matches values less than value
+func Lt(value interface{}) P {
+	return P{name: "lt", values: []interface{}{value}}
+}
+
+// Lte matches values less than or equal to value
+func Lte(value interface{}) P {
+	return P{name: "lte", values: []interface{}{value}}
+}
+
+// Gt matches values greater than value
+func Gt(value interface{}) P {
+	return P{name: "gt", values: []interface{}{value}}
+}
+
+// Gte matches values greater than or equal to value
+func Gte(value interface{}) P {
+	return P{name: "gte", values: []interface{}{value}}
+}
+
+// Within matches values equal to one of values
+func Within(values ...interface{}) P {
+	return P{name: "within", values: values}
+}
+
+// Without matches values equal to none of values
+func Without(values ...interface{}) P {
+	return P{name: "without", values: values}
+}
+
+// StartingWith matches string values that start with prefix
+func StartingWith(prefix string) P {
+	return P{name: "startingWith", values: []interface{}{prefix}}
+}
+
+func (p P) render() (string, tgdb.TGError) {
+	if len(p.values) == 0 {
+		errMsg := fmt.Sprintf("Predicate '%s' needs at least one value", p.name)
+		return "", impl.GetErrorByType(impl.TGQryParsingError, TGDB_GREMLIN_ERROR, errMsg, "")
+	}
+	args, err := literals(p.values)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s(%s)", p.name, strings.Join(args, ", ")), nil
+}
+
+// valueArgument renders the value of a Has step, which is either a predicate or a literal
+func valueArgument(value interface{}) (string, tgdb.TGError) {
+	if p, ok := value.(P); ok {
+		return p.render()
+	}
+	if p, ok := value.(*P); ok && p != nil {
+		return p.render()
+	}
+	return Literal(value)
+}
+
+func literals(values []interface{}) ([]string, tgdb.TGError) {
+	args := make([]string, 0, len(values))
+	for _, value := range values {
+		arg, err := Literal(value)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// Literal renders a value as a Gremlin literal. Strings are single quoted with quotes, backslashes and
+// control characters escaped, so user input can never end the literal early.
+func Literal(value interface{}) (string, tgdb.TGError) {
+	switch v := value.(type) {
+	case string:
+		return quote(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float32:
+		return floatLiteral(float64(v), 32)
+	case float64:
+		return floatLiteral(v, 64)
+	case *big.Int:
+		if v != nil {
+			return v.String(), nil
+		}
+	case *big.Float:
+		if v != nil && !v.IsInf() {
+			return v.Text('g', -1), nil
+		}
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.String:
+		return quote(rv.String()), nil
+	}
+	errMsg := fmt.Sprintf("Value '%+v' of type %T cannot be used in a Gremlin query", value, value)
+	return "", impl.GetErrorByType(impl.TGQryInvalidDataType, TGDB_GREMLIN_ERROR, errMsg, "")
+}
+
+func floatLiteral(v float64, bitSize int) (string, tgdb.TGError) {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		errMsg := fmt.Sprintf("Value '%v' cannot be used in a Gremlin query", v)
+		return "", impl.GetErrorByType(impl.TGQryInvalidDataType, TGDB_GREMLIN_ERROR, errMsg, "")
+	}
+	return strconv.FormatFloat(v, 'g', -1, bitSize), nil
+}
+
+var literalEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`'`, `\'`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"\b", `\b`,
+	"\f", `\f`,
+)
+
+func quote(s string) string {
+	return "'" + literalEscaper.Replace(s) + "'"
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: predicate_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package gremlin_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"tgdb/gremlin"
+	"tgdb/impl"
+)
+
+type name string
+
+func TestLiteral(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    interface{}
+		expected string
+	}{
+		{"plain string", "alice", `'alice'`},
+		{"empty string", "", `''`},
+		{"single quote", "o'brien", `'o\'brien'`},
+		{"backslash", `c:\temp`, `'c:\\temp'`},
+		{"escaped quote", `\'`, `'\\\''`},
+		{"quote and paren injection", "x') .drop() .V('", `'x\') .drop() .V(\''`},
+		{"control characters", "a\nb\rc\td\be\ff", `'a\nb\rc\td\be\ff'`},
+		{"string kind", name("bob's"), `'bob\'s'`},
+		{"bool", true, "true"},
+		{"int", -42, "-42"},
+		{"int8", int8(8), "8"},
+		{"uint64", uint64(math.MaxUint64), "18446744073709551615"},
+		{"float32", float32(1.5), "1.5"},
+		{"float64", 0.1, "0.1"},
+		{"big int", big.NewInt(1234567890), "1234567890"},
+		{"big float", big.NewFloat(2.5), "2.5"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			literal, err := gremlin.Literal(test.value)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if literal != test.expected {
+				t.Fatalf("Expected %s, got %s", test.expected, literal)
+			}
+		})
+	}
+}
+
+func TestLiteralRejectsUnsupportedValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{"nil", nil},
+		{"NaN", math.NaN()},
+		{"infinity", math.Inf(1)},
+		{"nil big int", (*big.Int)(nil)},
+		{"struct", struct{ A int }{1}},
+		{"slice", []string{"a"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			literal, err := gremlin.Literal(test.value)
+			if err == nil {
+				t.Fatalf("Expected an error, got %s", literal)
+			}
+			if err.GetErrorType() != impl.TGQryInvalidDataType {
+				t.Fatalf("Expected an invalid data type error, got '%s'", err.Error())
+			}
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate gremlin.P
+		expected  string
+	}{
+		{"eq", gremlin.Eq("it's"), `has('name', eq('it\'s'))`},
+		{"neq", gremlin.Neq(1), `has('name', neq(1))`},
+		{"lt", gremlin.Lt(2), `has('name', lt(2))`},
+		{"lte", gremlin.Lte(3), `has('name', lte(3))`},
+		{"gt", gremlin.Gt(4.5), `has('name', gt(4.5))`},
+		{"gte", gremlin.Gte(5), `has('name', gte(5))`},
+		{"within", gremlin.Within("a", "b'"), `has('name', within('a', 'b\''))`},
+		{"without", gremlin.Without(1, 2), `has('name', without(1, 2))`},
+		{"startingWith", gremlin.StartingWith(`a\`), `has('name', startingWith('a\\'))`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := gremlin.NewGraphTraversalSource(nil).V().Has("name", test.predicate).Build()
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if query != "g.V()."+test.expected {
+				t.Fatalf("Expected g.V().%s, got %s", test.expected, query)
+			}
+		})
+	}
+
+	if _, err := gremlin.NewGraphTraversalSource(nil).V().Has("name", gremlin.Within()).Build(); err == nil || err.GetErrorType() != impl.TGQryParsingError {
+		t.Fatal("Expected a predicate without values to be rejected")
+	}
+}
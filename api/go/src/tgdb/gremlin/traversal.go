@@ -0,0 +1,498 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: traversal.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+// Package gremlin builds Gremlin query strings for ExecuteGremlinStrQuery from typed steps, for example
+//
+//	g := gremlin.NewGraphTraversalSource(gmd)
+//	query, err := g.V().Has("person", "name", name).Out("knows").Values("age").Build()
+//
+// Values are always rendered as escaped literals, and when the source has graph metadata the type and
+// attribute names used by the steps are checked against it.
+package gremlin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"tgdb"
+	"tgdb/impl"
+)
+
+const (
+	TGDB_GREMLIN_ERROR string = "TGDB-GREMLIN-ERR"
+
+	// QueryPrefix selects the Gremlin language in TGConnection.ExecuteQuery
+	QueryPrefix = "gremlin://"
+)
+
+// elementKind is what the traversal emits after a step, which decides the steps that may follow
+type elementKind int
+
+const (
+	elementVertex elementKind = iota
+	elementEdge
+	elementValue
+)
+
+func (k elementKind) String() string {
+	switch k {
+	case elementVertex:
+		return "vertices"
+	case elementEdge:
+		return "edges"
+	}
+	return "values"
+}
+
+// GraphTraversalSource starts traversals. With a nil metadata no names are validated
+type GraphTraversalSource struct {
+	metadata   tgdb.TGGraphMetadata
+	loaded     bool
+	loadErr    tgdb.TGError
+	nodeTypes  map[string]bool
+	edgeTypes  map[string]bool
+	attributes map[string]bool
+}
+
+// NewGraphTraversalSource creates a source that validates steps against the graph metadata
+func NewGraphTraversalSource(gmd tgdb.TGGraphMetadata) *GraphTraversalSource {
+	return &GraphTraversalSource{metadata: gmd}
+}
+
+// V starts a traversal over the vertices with the given ids, or over all vertices
+func (g *GraphTraversalSource) V(ids ...interface{}) *GraphTraversal {
+	return g.start("V", elementVertex, ids)
+}
+
+// E starts a traversal over the edges with the given ids, or over all edges
+func (g *GraphTraversalSource) E(ids ...interface{}) *GraphTraversal {
+	return g.start("E", elementEdge, ids)
+}
+
+func (g *GraphTraversalSource) start(name string, kind elementKind, ids []interface{}) *GraphTraversal {
+	t := &GraphTraversal{source: g, current: kind}
+	args, err := literals(ids)
+	if err != nil {
+		t.err = err
+		return t
+	}
+	t.steps = []string{fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))}
+	return t
+}
+
+// names reads the type and attribute names once from the metadata
+func (g *GraphTraversalSource) names() tgdb.TGError {
+	if g.loaded {
+		return g.loadErr
+	}
+	g.loaded = true
+	g.nodeTypes = make(map[string]bool)
+	g.edgeTypes = make(map[string]bool)
+	g.attributes = make(map[string]bool)
+	nodeTypes, err := g.metadata.GetNodeTypes()
+	if err != nil {
+		g.loadErr = err
+		return err
+	}
+	for _, nodeType := range nodeTypes {
+		g.nodeTypes[nodeType.GetName()] = true
+	}
+	edgeTypes, err := g.metadata.GetEdgeTypes()
+	if err != nil {
+		g.loadErr = err
+		return err
+	}
+	for _, edgeType := range edgeTypes {
+		g.edgeTypes[edgeType.GetName()] = true
+	}
+	attrDescs, err := g.metadata.GetAttributeDescriptors()
+	if err != nil {
+		g.loadErr = err
+		return err
+	}
+	for _, attrDesc := range attrDescs {
+		g.attributes[attrDesc.GetName()] = true
+	}
+	return nil
+}
+
+// GraphTraversal is an immutable chain of steps. Every step returns a new traversal, so a partial
+// traversal can be shared and extended in different ways. The first invalid step is reported by Build
+type GraphTraversal struct {
+	source  *GraphTraversalSource
+	steps   []string
+	current elementKind
+	err     tgdb.TGError
+}
+
+/////////////////////////////////////////////////////////////////
+// Filter steps
+/////////////////////////////////////////////////////////////////
+
+// Has filters on an attribute. It takes the forms
+//
+//	Has(key)                 elements that have the attribute
+//	Has(key, value)          elements whose attribute equals value, or matches value when it is a P
+//	Has(label, key, value)   the same, limited to elements of the type label
+func (t *GraphTraversal) Has(labelOrKey string, args ...interface{}) *GraphTraversal {
+	return t.step("has", func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if err := next.requireElements("has"); err != nil {
+			return nil, err
+		}
+		switch len(args) {
+		case 0:
+			return next.keyArgs(labelOrKey)
+		case 1:
+			return next.keyValueArgs(labelOrKey, args[0])
+		case 2:
+			key, ok := args[0].(string)
+			if !ok {
+				return nil, invalidStep(fmt.Sprintf("has(label, key, value) expects a string key, got %T", args[0]))
+			}
+			if err := next.checkLabels(labelOrKey); err != nil {
+				return nil, err
+			}
+			kv, err := next.keyValueArgs(key, args[1])
+			if err != nil {
+				return nil, err
+			}
+			return append([]string{quote(labelOrKey)}, kv...), nil
+		}
+		return nil, invalidStep(fmt.Sprintf("has takes at most 3 arguments, got %d", len(args)+1))
+	})
+}
+
+// HasNot keeps elements that do not have the attribute
+func (t *GraphTraversal) HasNot(key string) *GraphTraversal {
+	return t.step("hasNot", func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if err := next.requireElements("hasNot"); err != nil {
+			return nil, err
+		}
+		return next.keyArgs(key)
+	})
+}
+
+// HasLabel keeps elements of one of the given types
+func (t *GraphTraversal) HasLabel(labels ...string) *GraphTraversal {
+	return t.step("hasLabel", func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if err := next.requireElements("hasLabel"); err != nil {
+			return nil, err
+		}
+		if len(labels) == 0 {
+			return nil, invalidStep("hasLabel needs at least one label")
+		}
+		if err := next.checkLabels(labels...); err != nil {
+			return nil, err
+		}
+		return quoteAll(labels), nil
+	})
+}
+
+// Dedup removes duplicates
+func (t *GraphTraversal) Dedup() *GraphTraversal {
+	return t.step("dedup", nil)
+}
+
+// Limit keeps the first n results
+func (t *GraphTraversal) Limit(n int64) *GraphTraversal {
+	return t.step("limit", func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if n < 0 {
+			return nil, invalidStep(fmt.Sprintf("limit must not be negative, got %d", n))
+		}
+		return literals([]interface{}{n})
+	})
+}
+
+// Range keeps the results from low up to, but not including, high
+func (t *GraphTraversal) Range(low, high int64) *GraphTraversal {
+	return t.step("range", func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if low < 0 || high < low {
+			return nil, invalidStep(fmt.Sprintf("invalid range [%d, %d)", low, high))
+		}
+		return literals([]interface{}{low, high})
+	})
+}
+
+/////////////////////////////////////////////////////////////////
+// Navigation steps
+/////////////////////////////////////////////////////////////////
+
+// Out moves to the adjacent vertices over outgoing edges of the given types, or of any type
+func (t *GraphTraversal) Out(edgeLabels ...string) *GraphTraversal {
+	return t.vertexToVertex("out", edgeLabels)
+}
+
+// In moves to the adjacent vertices over incoming edges
+func (t *GraphTraversal) In(edgeLabels ...string) *GraphTraversal {
+	return t.vertexToVertex("in", edgeLabels)
+}
+
+// Both moves to the adjacent vertices over edges in either direction
+func (t *GraphTraversal) Both(edgeLabels ...string) *GraphTraversal {
+	return t.vertexToVertex("both", edgeLabels)
+}
+
+// OutE moves to the outgoing edges
+func (t *GraphTraversal) OutE(edgeLabels ...string) *GraphTraversal {
+	return t.vertexToEdge("outE", edgeLabels)
+}
+
+// InE moves to the incoming edges
+func (t *GraphTraversal) InE(edgeLabels ...string) *GraphTraversal {
+	return t.vertexToEdge("inE", edgeLabels)
+}
+
+// BothE moves to the edges in either direction
+func (t *GraphTraversal) BothE(edgeLabels ...string) *GraphTraversal {
+	return t.vertexToEdge("bothE", edgeLabels)
+}
+
+// OutV moves from edges to their outgoing vertex
+func (t *GraphTraversal) OutV() *GraphTraversal {
+	return t.edgeToVertex("outV")
+}
+
+// InV moves from edges to their incoming vertex
+func (t *GraphTraversal) InV() *GraphTraversal {
+	return t.edgeToVertex("inV")
+}
+
+// BothV moves from edges to both of their vertices
+func (t *GraphTraversal) BothV() *GraphTraversal {
+	return t.edgeToVertex("bothV")
+}
+
+// OtherV moves from edges to the vertex they were not reached from
+func (t *GraphTraversal) OtherV() *GraphTraversal {
+	return t.edgeToVertex("otherV")
+}
+
+/////////////////////////////////////////////////////////////////
+// Value steps
+/////////////////////////////////////////////////////////////////
+
+// Values emits the values of the given attributes, or of all attributes
+func (t *GraphTraversal) Values(keys ...string) *GraphTraversal {
+	return t.elementToValue("values", keys)
+}
+
+// ValueMap emits a map of the given attributes, or of all attributes, per element
+func (t *GraphTraversal) ValueMap(keys ...string) *GraphTraversal {
+	return t.elementToValue("valueMap", keys)
+}
+
+// Id emits the element ids
+func (t *GraphTraversal) Id() *GraphTraversal {
+	return t.elementToValue("id", nil)
+}
+
+// Label emits the element type names
+func (t *GraphTraversal) Label() *GraphTraversal {
+	return t.elementToValue("label", nil)
+}
+
+// Path emits the path that led to each element
+func (t *GraphTraversal) Path() *GraphTraversal {
+	next := t.step("path", nil)
+	next.current = elementValue
+	return next
+}
+
+// Count emits the number of results
+func (t *GraphTraversal) Count() *GraphTraversal {
+	next := t.step("count", nil)
+	next.current = elementValue
+	return next
+}
+
+/////////////////////////////////////////////////////////////////
+// Rendering and execution
+/////////////////////////////////////////////////////////////////
+
+// Build returns the query string for ExecuteGremlinStrQuery, or the error of the first invalid step
+func (t *GraphTraversal) Build() (string, tgdb.TGError) {
+	if t.err != nil {
+		return "", t.err
+	}
+	return "g." + strings.Join(t.steps, "."), nil
+}
+
+// Execute builds the query and runs it on the connection
+func (t *GraphTraversal) Execute(conn tgdb.TGConnection, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return t.ExecuteContext(context.Background(), conn, options)
+}
+
+// ExecuteContext is Execute that stops waiting on the server once ctx is cancelled or expires
+func (t *GraphTraversal) ExecuteContext(ctx context.Context, conn tgdb.TGConnection, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	query, err := t.Build()
+	if err != nil {
+		return nil, err
+	}
+	return conn.ExecuteQueryContext(ctx, QueryPrefix+query, options)
+}
+
+// String returns the query, or a description of the error for an invalid traversal
+func (t *GraphTraversal) String() string {
+	query, err := t.Build()
+	if err != nil {
+		return fmt.Sprintf("invalid traversal: %s", err.GetErrorMsg())
+	}
+	return query
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for GraphTraversal
+/////////////////////////////////////////////////////////////////
+
+// step appends a step whose arguments are rendered by args, which may be nil for a step without any
+func (t *GraphTraversal) step(name string, args func(next *GraphTraversal) ([]string, tgdb.TGError)) *GraphTraversal {
+	next := &GraphTraversal{source: t.source, current: t.current, err: t.err}
+	if t.err != nil {
+		return next
+	}
+	var rendered []string
+	if args != nil {
+		var err tgdb.TGError
+		rendered, err = args(next)
+		if err != nil {
+			next.err = err
+			return next
+		}
+	}
+	next.steps = make([]string, len(t.steps), len(t.steps)+1)
+	copy(next.steps, t.steps)
+	next.steps = append(next.steps, fmt.Sprintf("%s(%s)", name, strings.Join(rendered, ", ")))
+	return next
+}
+
+func (t *GraphTraversal) vertexToVertex(name string, edgeLabels []string) *GraphTraversal {
+	next := t.navigate(name, elementVertex, edgeLabels)
+	next.current = elementVertex
+	return next
+}
+
+func (t *GraphTraversal) vertexToEdge(name string, edgeLabels []string) *GraphTraversal {
+	next := t.navigate(name, elementVertex, edgeLabels)
+	next.current = elementEdge
+	return next
+}
+
+func (t *GraphTraversal) navigate(name string, from elementKind, edgeLabels []string) *GraphTraversal {
+	return t.step(name, func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if next.current != from {
+			return nil, invalidStep(fmt.Sprintf("%s cannot follow a step that emits %s", name, next.current))
+		}
+		if err := next.checkNames("edge type", edgeLabels, func(g *GraphTraversalSource) map[string]bool { return g.edgeTypes }); err != nil {
+			return nil, err
+		}
+		return quoteAll(edgeLabels), nil
+	})
+}
+
+func (t *GraphTraversal) edgeToVertex(name string) *GraphTraversal {
+	next := t.step(name, func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if next.current != elementEdge {
+			return nil, invalidStep(fmt.Sprintf("%s cannot follow a step that emits %s", name, next.current))
+		}
+		return nil, nil
+	})
+	next.current = elementVertex
+	return next
+}
+
+func (t *GraphTraversal) elementToValue(name string, keys []string) *GraphTraversal {
+	next := t.step(name, func(next *GraphTraversal) ([]string, tgdb.TGError) {
+		if err := next.requireElements(name); err != nil {
+			return nil, err
+		}
+		if err := next.checkNames("attribute", keys, func(g *GraphTraversalSource) map[string]bool { return g.attributes }); err != nil {
+			return nil, err
+		}
+		return quoteAll(keys), nil
+	})
+	next.current = elementValue
+	return next
+}
+
+func (t *GraphTraversal) requireElements(name string) tgdb.TGError {
+	if t.current == elementValue {
+		return invalidStep(fmt.Sprintf("%s cannot follow a step that emits %s", name, t.current))
+	}
+	return nil
+}
+
+func (t *GraphTraversal) keyArgs(key string) ([]string, tgdb.TGError) {
+	if err := t.checkNames("attribute", []string{key}, func(g *GraphTraversalSource) map[string]bool { return g.attributes }); err != nil {
+		return nil, err
+	}
+	return []string{quote(key)}, nil
+}
+
+func (t *GraphTraversal) keyValueArgs(key string, value interface{}) ([]string, tgdb.TGError) {
+	args, err := t.keyArgs(key)
+	if err != nil {
+		return nil, err
+	}
+	arg, err := valueArgument(value)
+	if err != nil {
+		return nil, err
+	}
+	return append(args, arg), nil
+}
+
+// checkLabels checks type names against the node or edge types, depending on what the traversal emits
+func (t *GraphTraversal) checkLabels(labels ...string) tgdb.TGError {
+	if t.current == elementEdge {
+		return t.checkNames("edge type", labels, func(g *GraphTraversalSource) map[string]bool { return g.edgeTypes })
+	}
+	return t.checkNames("node type", labels, func(g *GraphTraversalSource) map[string]bool { return g.nodeTypes })
+}
+
+func (t *GraphTraversal) checkNames(kind string, names []string, known func(g *GraphTraversalSource) map[string]bool) tgdb.TGError {
+	for _, name := range names {
+		if name == "" {
+			return invalidStep(fmt.Sprintf("an empty %s name", kind))
+		}
+	}
+	if t.source == nil || t.source.metadata == nil || len(names) == 0 {
+		return nil
+	}
+	if err := t.source.names(); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if !known(t.source)[name] {
+			return invalidStep(fmt.Sprintf("%s '%s' is not defined in the graph metadata", kind, name))
+		}
+	}
+	return nil
+}
+
+func quoteAll(names []string) []string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, quote(name))
+	}
+	return quoted
+}
+
+func invalidStep(reason string) tgdb.TGError {
+	return impl.GetErrorByType(impl.TGQryParsingError, TGDB_GREMLIN_ERROR, fmt.Sprintf("Invalid Gremlin traversal: %s", reason), "")
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: traversal_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package gremlin_test
+
+import (
+	"strings"
+	"testing"
+	"tgdb"
+	"tgdb/gremlin"
+	"tgdb/impl"
+)
+
+// newMetadata returns metadata with a person node type, a knows edge type and name and age attributes
+func newMetadata() tgdb.TGGraphMetadata {
+	gmd := impl.DefaultGraphMetadata()
+	gmd.SetNodeTypes(map[string]tgdb.TGNodeType{"person": impl.NewNodeType("person", nil)})
+	gmd.SetEdgeTypes(map[string]tgdb.TGEdgeType{"knows": impl.NewEdgeType("knows", tgdb.DirectionTypeDirected, nil)})
+	gmd.SetAttributeDescriptors(map[string]tgdb.TGAttributeDescriptor{
+		"name": impl.NewAttributeDescriptorAsArray("name", impl.AttributeTypeString, false),
+		"age":  impl.NewAttributeDescriptorAsArray("age", impl.AttributeTypeInteger, false),
+	})
+	return gmd
+}
+
+func TestBuild(t *testing.T) {
+	g := gremlin.NewGraphTraversalSource(newMetadata())
+	tests := []struct {
+		name      string
+		traversal *gremlin.GraphTraversal
+		expected  string
+	}{
+		{"all vertices", g.V(), "g.V()"},
+		{"vertex ids", g.V(1, 2), "g.V(1, 2)"},
+		{"has label key value", g.V().Has("person", "name", "alice"), "g.V().has('person', 'name', 'alice')"},
+		{"has key", g.V().Has("age"), "g.V().has('age')"},
+		{"has not", g.V().HasNot("age"), "g.V().hasNot('age')"},
+		{"has label", g.V().HasLabel("person"), "g.V().hasLabel('person')"},
+		{"out values", g.V().Out("knows").Values("age"), "g.V().out('knows').values('age')"},
+		{"edges", g.V().OutE("knows").InV().Dedup().Limit(10), "g.V().outE('knows').inV().dedup().limit(10)"},
+		{"edge source", g.E().HasLabel("knows").OtherV().Path(), "g.E().hasLabel('knows').otherV().path()"},
+		{"range count", g.V().Range(0, 5).Count(), "g.V().range(0, 5).count()"},
+		{"value map", g.V().Both().ValueMap("name", "age"), "g.V().both().valueMap('name', 'age')"},
+		{"injection", g.V().Has("name", "x').drop().V('"), `g.V().has('name', 'x\').drop().V(\'')`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := test.traversal.Build()
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if query != test.expected {
+				t.Fatalf("Expected %s, got %s", test.expected, query)
+			}
+		})
+	}
+}
+
+func TestBuildRejectsInvalidSteps(t *testing.T) {
+	g := gremlin.NewGraphTraversalSource(newMetadata())
+	tests := []struct {
+		name      string
+		traversal *gremlin.GraphTraversal
+		reason    string
+	}{
+		{"unknown node type", g.V().HasLabel("car"), "node type 'car' is not defined"},
+		{"unknown labelled node type", g.V().Has("car", "name", "x"), "node type 'car' is not defined"},
+		{"unknown edge type", g.V().Out("likes"), "edge type 'likes' is not defined"},
+		{"unknown edge label", g.E().HasLabel("person"), "edge type 'person' is not defined"},
+		{"unknown attribute", g.V().Has("email", "a@b"), "attribute 'email' is not defined"},
+		{"unknown value key", g.V().Values("email"), "attribute 'email' is not defined"},
+		{"empty name", g.V().Has(""), "an empty attribute name"},
+		{"vertex step on edges", g.E().Out(), "out cannot follow a step that emits edges"},
+		{"edge step on vertices", g.V().InV(), "inV cannot follow a step that emits vertices"},
+		{"filter on values", g.V().Values("age").Has("age"), "has cannot follow a step that emits values"},
+		{"too many has arguments", g.V().Has("person", "name", "x", "y"), "has takes at most 3 arguments"},
+		{"first error wins", g.V().Out("likes").HasLabel("car"), "edge type 'likes'"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			query, err := test.traversal.Build()
+			if err == nil {
+				t.Fatalf("Expected an error, got %s", query)
+			}
+			if err.GetErrorType() != impl.TGQryParsingError || !strings.Contains(err.GetErrorMsg(), test.reason) {
+				t.Fatalf("Expected a parsing error about '%s', got '%s'", test.reason, err.GetErrorMsg())
+			}
+		})
+	}
+}
+
+func TestTraversalsAreImmutable(t *testing.T) {
+	people := gremlin.NewGraphTraversalSource(nil).V().HasLabel("person")
+	friends := people.Out("knows")
+	names := people.Values("name")
+	if people.String() != "g.V().hasLabel('person')" {
+		t.Fatalf("Expected the shared traversal to be unchanged, got %s", people.String())
+	}
+	if friends.String() != "g.V().hasLabel('person').out('knows')" || names.String() != "g.V().hasLabel('person').values('name')" {
+		t.Fatalf("Unexpected traversals %s and %s", friends.String(), names.String())
+	}
+}
+
+func TestNilMetadataSkipsNameChecks(t *testing.T) {
+	query, err := gremlin.NewGraphTraversalSource(nil).V().HasLabel("anything").Out("any").Values("thing").Build()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if query != "g.V().hasLabel('anything').out('any').values('thing')" {
+		t.Fatalf("Unexpected query %s", query)
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"bytes"
 	"encoding/gob"
 	"fmt"
+	"math"
 	"reflect"
 	"strings"
 	"tgdb"
@@ -296,6 +297,111 @@ func extractDatabaseInfoFromInputStream(is tgdb.TGInputStream) (*DatabaseStatist
 	return databaseInfoImpl, nil
 }
 
+// extractDescriptionFromInputStream reads the result of a describe command. The system type
+// leading the result is only peeked at, as the described object reads it again
+func extractDescriptionFromInputStream(is tgdb.TGInputStream) (*AdminDescriptionImpl, tgdb.TGError) {
+	startPos := is.(*ProtocolDataInputStream).GetPosition()
+	sysType, err := is.(*ProtocolDataInputStream).ReadByte() // system type
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading sysType from message buffer"))
+		return nil, err
+	}
+	is.(*ProtocolDataInputStream).SetPosition(startPos)
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read sysType as '%+v'", sysType))
+	}
+
+	description := NewAdminDescriptionImpl(tgdb.TGSystemType(sysType))
+	hasAttributes := true
+	switch tgdb.TGSystemType(sysType) {
+	case tgdb.SystemTypeNode:
+		nodeType := DefaultNodeType()
+		err = nodeType.ReadExternal(is)
+		if err != nil {
+			return nil, err
+		}
+		description.primary = nodeType
+		indexCount, err := is.(*ProtocolDataInputStream).ReadLong() // index count
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading indexCount from message buffer"))
+			return nil, err
+		}
+		for i := int64(0); i < indexCount; i++ {
+			indexInfo, err := extractIndexInfoFromInputStream(is)
+			if err != nil {
+				return nil, err
+			}
+			description.indices = append(description.indices, indexInfo)
+		}
+	case tgdb.SystemTypeEdge:
+		edgeType := DefaultEdgeType()
+		err = edgeType.ReadExternal(is)
+		if err != nil {
+			return nil, err
+		}
+		description.primary = edgeType
+		// Each end of the edge type is only sent if the edge type is restricted to a node type
+		for _, nodeTypeRef := range []*tgdb.TGNodeType{&description.fromNodeType, &description.toNodeType} {
+			hasNodeType, err := is.(*ProtocolDataInputStream).ReadBoolean()
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading hasNodeType from message buffer"))
+				return nil, err
+			}
+			if hasNodeType {
+				nodeType := DefaultNodeType()
+				err = nodeType.ReadExternal(is)
+				if err != nil {
+					return nil, err
+				}
+				*nodeTypeRef = nodeType
+			}
+		}
+	case tgdb.SystemTypeAttributeDescriptor:
+		attrDesc := DefaultAttributeDescriptor()
+		err = attrDesc.ReadExternal(is)
+		if err != nil {
+			return nil, err
+		}
+		description.primary = attrDesc
+		hasAttributes = false
+	case tgdb.SystemTypeIndex:
+		indexInfo, err := extractIndexInfoFromInputStream(is)
+		if err != nil {
+			return nil, err
+		}
+		description.primary = indexInfo
+	case tgdb.SystemTypePrincipal:
+		// The roles that follow the user are not exposed, so they are left unread
+		userInfo, err := extractUserInfoFromInputStream(is)
+		if err != nil {
+			return nil, err
+		}
+		description.primary = userInfo
+		hasAttributes = false
+	default:
+		errMsg := fmt.Sprintf("Unable to describe system object of type %d", sysType)
+		logger.Error(fmt.Sprintf("ERROR: Returning AdminResponseMessage:ReadPayload - %s", errMsg))
+		return nil, GetErrorByType(TGErrorGeneralException, "", errMsg, "")
+	}
+
+	if hasAttributes {
+		attrCount, err := is.(*ProtocolDataInputStream).ReadLong() // attribute count
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading attrCount from message buffer"))
+			return nil, err
+		}
+		for i := int64(0); i < attrCount; i++ {
+			attrDesc := DefaultAttributeDescriptor()
+			err = attrDesc.ReadExternal(is)
+			if err != nil {
+				return nil, err
+			}
+			description.attrDescs = append(description.attrDescs, attrDesc)
+		}
+	}
+	return description, nil
+}
+
 func extractDescriptorListFromInputStream(is tgdb.TGInputStream) ([]tgdb.TGAttributeDescriptor, tgdb.TGError) {
 	attrDescList := make([]tgdb.TGAttributeDescriptor, 0)
 	descCount, err := is.(*ProtocolDataInputStream).ReadInt() // attribute descriptor count
@@ -395,112 +501,120 @@ func extractIndexListFromInputStream(is tgdb.TGInputStream) ([]tgdb.TGIndexInfo,
 		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read indexCount as '%+v'", indexCount))
 	}
 	for i := 0; i < indexCount; i++ {
-		indexType, err := is.(*ProtocolDataInputStream).ReadByte() // Index Type
-		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading indexType from message buffer"))
-			return nil, err
-		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read indexType as '%+v'", indexType))
-		}
-		indexId, err := is.(*ProtocolDataInputStream).ReadInt() // Index Id
-		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading indexId from message buffer"))
-			return nil, err
-		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read indexId as '%+v'", indexId))
-		}
-		indexName, err := is.(*ProtocolDataInputStream).ReadUTF() // Index Name
-		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading indexName from message buffer"))
-			return nil, err
-		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read indexName as '%+v'", indexName))
-		}
-		isUniqueFlag, err := is.(*ProtocolDataInputStream).ReadBoolean() // isUnique flag
+		indexInfo, err := extractIndexInfoFromInputStream(is)
 		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading isUniqueFlag from message buffer"))
 			return nil, err
 		}
+		indexList = append(indexList, indexInfo)
+	}
+	return indexList, nil
+}
 
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read isUniqueFlag as '%+v'", isUniqueFlag))
-		}
-		attrCount, err := is.(*ProtocolDataInputStream).ReadInt() // attribute count
-		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading attrCount from message buffer"))
-			return nil, err
-		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read attrCount as '%+v'", attrCount))
-		}
-		attributes := make([]string, 0)
-		for j := 0; j < attrCount; j++ {
-			attrName, err := is.(*ProtocolDataInputStream).ReadUTF() // attribute Name
-			if err != nil {
-				logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading attrName from message buffer"))
-				return nil, err
-			}
-			if logger.IsDebug() {
-				logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read attrName as '%+v'", attrName))
-			}
-			attributes = append(attributes, attrName)
-		}
+// extractIndexInfoFromInputStream reads a single index entry of an index list or description
+func extractIndexInfoFromInputStream(is tgdb.TGInputStream) (*IndexInfoImpl, tgdb.TGError) {
+	indexType, err := is.(*ProtocolDataInputStream).ReadByte() // Index Type
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading indexType from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read indexType as '%+v'", indexType))
+	}
+	indexId, err := is.(*ProtocolDataInputStream).ReadInt() // Index Id
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading indexId from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read indexId as '%+v'", indexId))
+	}
+	indexName, err := is.(*ProtocolDataInputStream).ReadUTF() // Index Name
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading indexName from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read indexName as '%+v'", indexName))
+	}
+	isUniqueFlag, err := is.(*ProtocolDataInputStream).ReadBoolean() // isUnique flag
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading isUniqueFlag from message buffer"))
+		return nil, err
+	}
 
-		nodeCount, err := is.(*ProtocolDataInputStream).ReadInt() // node count
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read isUniqueFlag as '%+v'", isUniqueFlag))
+	}
+	attrCount, err := is.(*ProtocolDataInputStream).ReadInt() // attribute count
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading attrCount from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read attrCount as '%+v'", attrCount))
+	}
+	attributes := make([]string, 0)
+	for j := 0; j < attrCount; j++ {
+		attrName, err := is.(*ProtocolDataInputStream).ReadUTF() // attribute Name
 		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading nodeCount from message buffer"))
+			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading attrName from message buffer"))
 			return nil, err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read nodeCount as '%+v'", nodeCount))
-		}
-		nodes := make([]string, 0)
-		for k := 0; k < nodeCount; k++ {
-			nodeName, err := is.(*ProtocolDataInputStream).ReadUTF() // node Name
-			if err != nil {
-				logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading nodeName from message buffer"))
-				return nil, err
-			}
-			if logger.IsDebug() {
-				logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read nodeName as '%+v'", nodeName))
-			}
-			nodes = append(nodes, nodeName)
+			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read attrName as '%+v'", attrName))
 		}
+		attributes = append(attributes, attrName)
+	}
 
-		blocksize, err := is.(*ProtocolDataInputStream).ReadInt() // block size
+	nodeCount, err := is.(*ProtocolDataInputStream).ReadInt() // node count
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading nodeCount from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read nodeCount as '%+v'", nodeCount))
+	}
+	nodes := make([]string, 0)
+	for k := 0; k < nodeCount; k++ {
+		nodeName, err := is.(*ProtocolDataInputStream).ReadUTF() // node Name
 		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading blocksize from message buffer"))
+			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading nodeName from message buffer"))
 			return nil, err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read blocksize as '%+v'", blocksize))
+			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read nodeName as '%+v'", nodeName))
 		}
+		nodes = append(nodes, nodeName)
+	}
 
-		numEntries, err := is.(*ProtocolDataInputStream).ReadLong() // num of Entries
-		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading numEntries from message buffer"))
-			return nil, err
-		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read numEntries as '%+v'", numEntries))
-		}
+	blocksize, err := is.(*ProtocolDataInputStream).ReadInt() // block size
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading blocksize from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read blocksize as '%+v'", blocksize))
+	}
 
-		status, err := is.(*ProtocolDataInputStream).ReadBytes() // status
-		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading status from message buffer"))
-			return nil, err
-		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read status as '%+v'", status))
-		}
+	numEntries, err := is.(*ProtocolDataInputStream).ReadLong() // num of Entries
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading numEntries from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read numEntries as '%+v'", numEntries))
+	}
 
-		indexInfo := NewIndexInfoImpl(indexId, indexName, indexType, isUniqueFlag, attributes, nodes, numEntries, string(status))
-		indexList = append(indexList, indexInfo)
+	status, err := is.(*ProtocolDataInputStream).ReadBytes() // status
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading status from message buffer"))
+		return nil, err
 	}
-	return indexList, nil
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read status as '%+v'", status))
+	}
+
+	return NewIndexInfoImpl(indexId, indexName, indexType, isUniqueFlag, attributes, nodes, numEntries, string(status)), nil
 }
 
 func extractMemoryInfoFromInputStream(is tgdb.TGInputStream) (*ServerMemoryInfoImpl, tgdb.TGError) {
@@ -830,68 +944,81 @@ func extractUserListFromInputStream(is tgdb.TGInputStream) ([]tgdb.TGUserInfo, t
 	}
 
 	for i := 0; i < userCount; i++ {
-		userType, err := is.(*ProtocolDataInputStream).ReadByte() // user Type
+		userInfo, err := extractUserInfoFromInputStream(is)
 		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading userType from message buffer"))
 			return nil, err
 		}
-		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read userType as '%+v'", userType))
-		}
+		userList = append(userList, userInfo)
+	}
+	return userList, nil
+}
+
+// extractUserInfoFromInputStream reads a single user entry of a user list or description
+func extractUserInfoFromInputStream(is tgdb.TGInputStream) (*UserInfoImpl, tgdb.TGError) {
+	userType, err := is.(*ProtocolDataInputStream).ReadByte() // user Type
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading userType from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+				logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read userType as '%+v'", userType))
+	}
+
+	userId, err := is.(*ProtocolDataInputStream).ReadInt() // user Id
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading userId from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+				logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read userId as '%+v'", userId))
+	}
+
+	userName, err := is.(*ProtocolDataInputStream).ReadUTF() // user Name
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading userName from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+				logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read userName as '%+v'", userName))
+	}
 
-		userId, err := is.(*ProtocolDataInputStream).ReadInt() // user Id
+	switch tgdb.TGSystemType(userType) {
+	case tgdb.SystemTypePrincipal:
+		_, err := is.(*ProtocolDataInputStream).ReadBytes()
 		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading userId from message buffer"))
+			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading bytes from message buffer"))
 			return nil, err
 		}
-		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read userId as '%+v'", userId))
-		}
-
-		userName, err := is.(*ProtocolDataInputStream).ReadUTF() // user Name
+		numRoles, err := is.(*ProtocolDataInputStream).ReadInt() //number of roles
 		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading userName from message buffer"))
+			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading numRoles from message buffer"))
 			return nil, err
 		}
-		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read userName as '%+v'", userName))
-		}
 
-		switch tgdb.TGSystemType(userType) {
-		case tgdb.SystemTypePrincipal:
-			_, err := is.(*ProtocolDataInputStream).ReadBytes()
+		roleIds := make([]int, numRoles)
+		for j := 0; j < numRoles; j++ {
+			roleId, err := is.(*ProtocolDataInputStream).ReadInt() //roleID
 			if err != nil {
-				logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading bytes from message buffer"))
+				logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading roleID from message buffer"))
 				return nil, err
 			}
-			numRoles, err := is.(*ProtocolDataInputStream).ReadInt() //number of roles
-			if err != nil {
-				logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading numRoles from message buffer"))
-				return nil, err
-			}
-
-			roleIds := make([]int, numRoles)
-			for j := 0; j < numRoles; j++ {
-				roleId, err := is.(*ProtocolDataInputStream).ReadInt() //roleID
-				if err != nil {
-					logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading roleID from message buffer"))
-					return nil, err
-				}
-				roleIds = append(roleIds, roleId)
-			}
-		default:
+			roleIds = append(roleIds, roleId)
 		}
-		userInfo := NewUserInfoImpl(userId, userName, userType)
-		userList = append(userList, userInfo)
+	default:
 	}
-	return userList, nil
+	return NewUserInfoImpl(userId, userName, userType), nil
 }
 
 
 type AdminRequestMessage struct {
 	*AbstractProtocolMessage
-	command    AdminCommand
-	logDetails *ServerLogDetails
+	command      AdminCommand
+	logDetails   *ServerLogDetails
+	attrDesc     tgdb.TGAttributeDescriptor
+	userInfo     *CreateUserInfo
+	indexInfo    *CreateIndexInfo
+	typeInfo     *CreateEntityTypeInfo
+	describeName string
 }
 
 func DefaultAdminRequestMessage() *AdminRequestMessage {
@@ -939,6 +1066,46 @@ func (msg *AdminRequestMessage) SetLogLevel(connId *ServerLogDetails) {
 	msg.logDetails = connId
 }
 
+func (msg *AdminRequestMessage) GetAttributeDescriptor() tgdb.TGAttributeDescriptor {
+	return msg.attrDesc
+}
+
+func (msg *AdminRequestMessage) GetCreateEntityTypeInfo() *CreateEntityTypeInfo {
+	return msg.typeInfo
+}
+
+func (msg *AdminRequestMessage) GetCreateIndexInfo() *CreateIndexInfo {
+	return msg.indexInfo
+}
+
+func (msg *AdminRequestMessage) GetCreateUserInfo() *CreateUserInfo {
+	return msg.userInfo
+}
+
+func (msg *AdminRequestMessage) GetDescribeName() string {
+	return msg.describeName
+}
+
+func (msg *AdminRequestMessage) SetAttributeDescriptor(attrDesc tgdb.TGAttributeDescriptor) {
+	msg.attrDesc = attrDesc
+}
+
+func (msg *AdminRequestMessage) SetCreateEntityTypeInfo(typeInfo *CreateEntityTypeInfo) {
+	msg.typeInfo = typeInfo
+}
+
+func (msg *AdminRequestMessage) SetCreateIndexInfo(indexInfo *CreateIndexInfo) {
+	msg.indexInfo = indexInfo
+}
+
+func (msg *AdminRequestMessage) SetCreateUserInfo(userInfo *CreateUserInfo) {
+	msg.userInfo = userInfo
+}
+
+func (msg *AdminRequestMessage) SetDescribeName(name string) {
+	msg.describeName = name
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGMessage
 /////////////////////////////////////////////////////////////////
@@ -1149,42 +1316,224 @@ func (msg *AdminRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError
 
 // ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
 func (msg *AdminRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
-	// No-Op for Now
-	return nil
-}
-
-// WritePayload exports the values of the message specific payload Attributes to output stream
-func (msg *AdminRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	//startPos := os.GetPosition()
-	//logger.Log(fmt.Sprintf("Entering AdminRequest:WritePayload at output buffer position: '%d'", startPos))
-	//os.(*iostream.ProtocolDataOutputStream).WriteInt(0) // This is for the commit buffer length
-	//os.(*iostream.ProtocolDataOutputStream).WriteInt(0) // This is for the checksum for the commit buffer to be added later.  Currently not used
-
-	dataLen := 0
-	checkSum := 0
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering AdminRequestMessage:ReadPayload"))
+	}
+	// For Testing purpose only - mirrors WritePayload so that a mock server can decode the request
+	in := is.(*ProtocolDataInputStream)
+	if _, err := in.ReadInt(); err != nil { // datalength
+		return err
+	}
+	if _, err := in.ReadInt(); err != nil { // checksum
+		return err
+	}
+	command, err := in.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AdminRequestMessage:ReadPayload w/ Error in reading command from message buffer"))
+		return err
+	}
+	msg.command = AdminCommand(command)
 
 	switch msg.command {
-	case AdminCommandCreateUser:
 	case AdminCommandCreateAttrDesc:
-	case AdminCommandCreateIndex:
-	case AdminCommandCreateNodeType:
-	case AdminCommandCreateEdgeType:
-	case AdminCommandShowUsers:
-		fallthrough
-	case AdminCommandShowAttrDescs:
-		fallthrough
-	case AdminCommandShowIndices:
-		fallthrough
-	case AdminCommandShowTypes:
-		fallthrough
-	case AdminCommandShowInfo:
-		fallthrough
-	case AdminCommandShowConnections:
-		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
-		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
-		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
-	case AdminCommandDescribe:
-	case AdminCommandSetLogLevel:
+		isArray, err := in.ReadBoolean()
+		if err != nil {
+			return err
+		}
+		isEncrypted, err := in.ReadBoolean()
+		if err != nil {
+			return err
+		}
+		name, err := in.ReadUTF()
+		if err != nil {
+			return err
+		}
+		attrType, err := in.ReadInt()
+		if err != nil {
+			return err
+		}
+		attrDesc := NewAttributeDescriptorAsArray(name, attrType, isArray)
+		attrDesc.SetIsEncrypted(isEncrypted)
+		if attrType == AttributeTypeNumber {
+			precision, err := in.ReadShort()
+			if err != nil {
+				return err
+			}
+			scale, err := in.ReadShort()
+			if err != nil {
+				return err
+			}
+			attrDesc.SetPrecision(precision)
+			attrDesc.SetScale(scale)
+		}
+		msg.attrDesc = attrDesc
+	case AdminCommandCreateIndex:
+		isUnique, err := in.ReadBoolean()
+		if err != nil {
+			return err
+		}
+		name, err := in.ReadUTF()
+		if err != nil {
+			return err
+		}
+		attrs, err := readNameList(in)
+		if err != nil {
+			return err
+		}
+		typeNames, err := readNameList(in)
+		if err != nil {
+			return err
+		}
+		msg.indexInfo = NewCreateIndexInfo(name, typeNames, attrs, isUnique)
+	case AdminCommandCreateNodeType:
+		name, err := in.ReadUTF()
+		if err != nil {
+			return err
+		}
+		pageSize, err := in.ReadInt()
+		if err != nil {
+			return err
+		}
+		attrs, err := readNameList(in)
+		if err != nil {
+			return err
+		}
+		pkeys, err := readNameList(in)
+		if err != nil {
+			return err
+		}
+		msg.typeInfo = NewCreateNodeTypeInfo(name, attrs, pkeys)
+		msg.typeInfo.pageSize = pageSize
+	case AdminCommandCreateEdgeType:
+		names := make([]string, 4)
+		for i := range names {
+			if names[i], err = in.ReadUTF(); err != nil {
+				return err
+			}
+		}
+		attrs, err := readNameList(in)
+		if err != nil {
+			return err
+		}
+		pkeys, err := readNameList(in)
+		if err != nil {
+			return err
+		}
+		direction := tgdb.DirectionTypeDirected
+		switch names[3] {
+		case "undirected":
+			direction = tgdb.DirectionTypeUnDirected
+		case "bidirected":
+			direction = tgdb.DirectionTypeBiDirectional
+		}
+		msg.typeInfo = NewCreateEdgeTypeInfo(names[0], names[1], names[2], direction, attrs, pkeys)
+	case AdminCommandDescribe:
+		if msg.describeName, err = in.ReadUTF(); err != nil {
+			return err
+		}
+	default:
+		// The other commands the mock server answers carry no arguments
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning AdminRequestMessage:ReadPayload w/ command '%d'", command))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *AdminRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	//startPos := os.GetPosition()
+	//logger.Log(fmt.Sprintf("Entering AdminRequest:WritePayload at output buffer position: '%d'", startPos))
+	//os.(*iostream.ProtocolDataOutputStream).WriteInt(0) // This is for the commit buffer length
+	//os.(*iostream.ProtocolDataOutputStream).WriteInt(0) // This is for the checksum for the commit buffer to be added later.  Currently not used
+
+	dataLen := 0
+	checkSum := 0
+
+	switch msg.command {
+	case AdminCommandCreateUser:
+		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
+		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
+		if err := os.(*ProtocolDataOutputStream).WriteUTF(msg.userInfo.name); err != nil {
+			return err
+		}
+		if err := os.(*ProtocolDataOutputStream).WriteUTF(msg.userInfo.password); err != nil {
+			return err
+		}
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.userInfo.roles))
+		for _, role := range msg.userInfo.roles {
+			if err := os.(*ProtocolDataOutputStream).WriteUTF(role); err != nil {
+				return err
+			}
+		}
+	case AdminCommandCreateAttrDesc:
+		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
+		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
+		os.(*ProtocolDataOutputStream).WriteBoolean(msg.attrDesc.IsAttributeArray())
+		os.(*ProtocolDataOutputStream).WriteBoolean(msg.attrDesc.Is_Encrypted())
+		if err := os.(*ProtocolDataOutputStream).WriteUTF(msg.attrDesc.GetName()); err != nil {
+			return err
+		}
+		os.(*ProtocolDataOutputStream).WriteInt(msg.attrDesc.GetAttrType())
+		if msg.attrDesc.GetAttrType() == AttributeTypeNumber {
+			os.(*ProtocolDataOutputStream).WriteShort(int(msg.attrDesc.GetPrecision()))
+			os.(*ProtocolDataOutputStream).WriteShort(int(msg.attrDesc.GetScale()))
+		}
+	case AdminCommandCreateIndex:
+		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
+		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
+		os.(*ProtocolDataOutputStream).WriteBoolean(msg.indexInfo.isUnique)
+		if err := os.(*ProtocolDataOutputStream).WriteUTF(msg.indexInfo.name); err != nil {
+			return err
+		}
+		writeNameList(os, msg.indexInfo.attrs)
+		writeNameList(os, msg.indexInfo.typeNames)
+	case AdminCommandCreateNodeType:
+		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
+		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
+		if err := os.(*ProtocolDataOutputStream).WriteUTF(msg.typeInfo.name); err != nil {
+			return err
+		}
+		os.(*ProtocolDataOutputStream).WriteInt(msg.typeInfo.pageSize)
+		writeNameList(os, msg.typeInfo.attrs)
+		writeNameList(os, msg.typeInfo.pkeys)
+	case AdminCommandCreateEdgeType:
+		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
+		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
+		for _, str := range []string{msg.typeInfo.name, msg.typeInfo.fromNodeType, msg.typeInfo.toNodeType, msg.typeInfo.GetDirection()} {
+			if err := os.(*ProtocolDataOutputStream).WriteUTF(str); err != nil {
+				return err
+			}
+		}
+		writeNameList(os, msg.typeInfo.attrs)
+		writeNameList(os, msg.typeInfo.pkeys)
+	case AdminCommandShowUsers:
+		fallthrough
+	case AdminCommandShowAttrDescs:
+		fallthrough
+	case AdminCommandShowIndices:
+		fallthrough
+	case AdminCommandShowTypes:
+		fallthrough
+	case AdminCommandShowInfo:
+		fallthrough
+	case AdminCommandShowConnections:
+		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
+		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
+	case AdminCommandDescribe:
+		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
+		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
+		if err := os.(*ProtocolDataOutputStream).WriteUTF(msg.describeName); err != nil {
+			return err
+		}
+	case AdminCommandSetLogLevel:
 		os.(*ProtocolDataOutputStream).WriteInt(dataLen)
 		os.(*ProtocolDataOutputStream).WriteInt(checkSum)
 		os.(*ProtocolDataOutputStream).WriteInt(int(msg.command))
@@ -1267,8 +1616,12 @@ func (msg *AdminRequestMessage) UnmarshalBinary(data []byte) error {
 
 type AdminResponseMessage struct {
 	*AbstractProtocolMessage
+	command         AdminCommand
+	resultId        int
+	errorMessage    string
 	attrDescriptors []tgdb.TGAttributeDescriptor
 	connections     []tgdb.TGConnectionInfo
+	description     *AdminDescriptionImpl
 	indices         []tgdb.TGIndexInfo
 	serverInfo      *ServerInfoImpl
 	users           []tgdb.TGUserInfo
@@ -1301,10 +1654,24 @@ func NewAdminResponseMessage(authToken, sessionId int64) *AdminResponseMessage {
 // Helper functions for AdminResponseMessage
 /////////////////////////////////////////////////////////////////
 
+func (msg *AdminResponseMessage) GetDescription() *AdminDescriptionImpl {
+	return msg.description
+}
+
 func (msg *AdminResponseMessage) GetDescriptorList() []tgdb.TGAttributeDescriptor {
 	return msg.attrDescriptors
 }
 
+// GetErrorMessage returns the server's reason for a failed command, if it sent one
+func (msg *AdminResponseMessage) GetErrorMessage() string {
+	return msg.errorMessage
+}
+
+// GetResultId returns the server's result for the command - 0 on success
+func (msg *AdminResponseMessage) GetResultId() int {
+	return msg.resultId
+}
+
 func (msg *AdminResponseMessage) GetConnectionList() []tgdb.TGConnectionInfo {
 	return msg.connections
 }
@@ -1321,6 +1688,30 @@ func (msg *AdminResponseMessage) GetUserList() []tgdb.TGUserInfo {
 	return msg.users
 }
 
+// GetCommand returns the command this is the response to
+func (msg *AdminResponseMessage) GetCommand() AdminCommand {
+	return msg.command
+}
+
+// SetCommand sets the command this is the response to
+func (msg *AdminResponseMessage) SetCommand(cmd AdminCommand) {
+	msg.command = cmd
+}
+
+// SetErrorMessage sets the reason sent with a failed command
+func (msg *AdminResponseMessage) SetErrorMessage(errMsg string) {
+	msg.errorMessage = errMsg
+}
+
+// SetResultId sets the result for the command - 0 on success
+func (msg *AdminResponseMessage) SetResultId(resultId int) {
+	msg.resultId = resultId
+}
+
+func (msg *AdminResponseMessage) SetDescription(desc *AdminDescriptionImpl) {
+	msg.description = desc
+}
+
 func (msg *AdminResponseMessage) SetDescriptorList(list []tgdb.TGAttributeDescriptor) {
 	msg.attrDescriptors = list
 }
@@ -1589,6 +1980,20 @@ func (msg *AdminResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError
 		logger.Debug(fmt.Sprintf("Inside AdminResponseMessage:ReadPayload read command as '%+v'", command))
 	}
 
+	msg.command = AdminCommand(command)
+	msg.resultId = resultId
+	if resultId != 0 {
+		// A failed command carries an optional error message instead of its results
+		errMsg, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err == nil {
+			msg.errorMessage = strings.TrimSpace(errMsg)
+		}
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Returning AdminResponseMessage:ReadPayload w/ result '%d' and error message '%s'", resultId, msg.errorMessage))
+		}
+		return nil
+	}
+
 	switch AdminCommand(command) {
 	case AdminCommandCreateUser:
 	case AdminCommandCreateAttrDesc:
@@ -1632,6 +2037,12 @@ func (msg *AdminResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError
 		}
 		msg.SetConnectionList(connList)
 	case AdminCommandDescribe:
+		description, err := extractDescriptionFromInputStream(is)
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning AdminResponseMessage:ReadPayload w/ Error in reading description from message buffer"))
+			return err
+		}
+		msg.SetDescription(description)
 	case AdminCommandSetLogLevel:
 	case AdminCommandStopServer:
 	case AdminCommandCheckpointServer:
@@ -1647,7 +2058,40 @@ func (msg *AdminResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *AdminResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	// No-Op for Now
+	// Client never writes out the response - this mirrors ReadPayload for a mock server, for the
+	// results of the commands it answers
+	out := os.(*ProtocolDataOutputStream)
+	out.WriteInt(msg.resultId)
+	out.WriteInt(int(msg.command))
+	if msg.resultId != 0 {
+		return out.WriteUTF(msg.errorMessage)
+	}
+	switch msg.command {
+	case AdminCommandShowIndices:
+		out.WriteInt(len(msg.indices))
+		for _, index := range msg.indices {
+			out.WriteByte(int(index.GetType()))
+			out.WriteInt(index.GetSystemId())
+			if err := out.WriteUTF(index.GetName()); err != nil {
+				return err
+			}
+			out.WriteBoolean(index.IsUnique())
+			for _, names := range [][]string{index.GetAttributeNames(), index.GetNodeTypes()} {
+				out.WriteInt(len(names))
+				for _, name := range names {
+					if err := out.WriteUTF(name); err != nil {
+						return err
+					}
+				}
+			}
+			out.WriteInt(0) // block size
+			out.WriteLong(index.GetNumEntries())
+			if err := out.WriteBytes([]byte(index.GetStatus())); err != nil {
+				return err
+			}
+		}
+	default:
+	}
 	return nil
 }
 
@@ -1701,6 +2145,89 @@ func (msg *AdminResponseMessage) UnmarshalBinary(data []byte) error {
 
 //var logger = logging.DefaultTGLogManager().GetLogger()
 
+type AdminDescriptionImpl struct {
+	sysType      tgdb.TGSystemType
+	primary      interface{}
+	attrDescs    []tgdb.TGAttributeDescriptor
+	indices      []tgdb.TGIndexInfo
+	fromNodeType tgdb.TGNodeType
+	toNodeType   tgdb.TGNodeType
+}
+
+// Make sure that the AdminDescriptionImpl implements the TGAdminDescription interface
+var _ tgdb.TGAdminDescription = (*AdminDescriptionImpl)(nil)
+
+func DefaultAdminDescriptionImpl() *AdminDescriptionImpl {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(AdminDescriptionImpl{})
+
+	return &AdminDescriptionImpl{
+		sysType:   tgdb.SystemTypeInvalid,
+		attrDescs: make([]tgdb.TGAttributeDescriptor, 0),
+		indices:   make([]tgdb.TGIndexInfo, 0),
+	}
+}
+
+func NewAdminDescriptionImpl(sysType tgdb.TGSystemType) *AdminDescriptionImpl {
+	newDescription := DefaultAdminDescriptionImpl()
+	newDescription.sysType = sysType
+	return newDescription
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions from Interface ==> TGAdminDescription
+/////////////////////////////////////////////////////////////////
+
+func (obj *AdminDescriptionImpl) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("AdminDescriptionImpl:{")
+	buffer.WriteString(fmt.Sprintf("SysType: '%+v'", obj.sysType))
+	buffer.WriteString(fmt.Sprintf(", Primary: '%+v'", obj.primary))
+	buffer.WriteString(fmt.Sprintf(", AttrDescs: '%+v'", obj.attrDescs))
+	buffer.WriteString(fmt.Sprintf(", Indices: '%+v'", obj.indices))
+	buffer.WriteString(fmt.Sprintf(", FromNodeType: '%+v'", obj.fromNodeType))
+	buffer.WriteString(fmt.Sprintf(", ToNodeType: '%+v'", obj.toNodeType))
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGAdminDescription
+/////////////////////////////////////////////////////////////////
+
+// GetSystemType returns the type of the described system object
+func (obj *AdminDescriptionImpl) GetSystemType() tgdb.TGSystemType {
+	return obj.sysType
+}
+
+// GetPrimary returns the described object
+func (obj *AdminDescriptionImpl) GetPrimary() interface{} {
+	return obj.primary
+}
+
+// GetAttributeDescriptors returns the attributes of a described node type, edge type or index
+func (obj *AdminDescriptionImpl) GetAttributeDescriptors() []tgdb.TGAttributeDescriptor {
+	return obj.attrDescs
+}
+
+// GetIndices returns the indices of a described node type
+func (obj *AdminDescriptionImpl) GetIndices() []tgdb.TGIndexInfo {
+	return obj.indices
+}
+
+// GetFromNodeType returns the from-node type of a described edge type
+func (obj *AdminDescriptionImpl) GetFromNodeType() tgdb.TGNodeType {
+	return obj.fromNodeType
+}
+
+// GetToNodeType returns the to-node type of a described edge type
+func (obj *AdminDescriptionImpl) GetToNodeType() tgdb.TGNodeType {
+	return obj.toNodeType
+}
+
+
 type CacheStatisticsImpl struct {
 	dataCacheMaxEntries  int
 	dataCacheEntries     int
@@ -1971,64 +2498,252 @@ func (obj *ConnectionInfoImpl) UnmarshalBinary(data []byte) error {
 }
 
 
-type DatabaseStatisticsImpl struct {
-	blockSize        int
-	dataBlockSize    int
-	dataFree         int64
-	dataSize         int64
-	dataUsed         int64
-	dbSize           int64
-	indexFree        int64
-	indexSize        int64
-	indexUsed        int64
-	numDataSegments  int
-	numIndexSegments int
-}
+// DefaultEntityTypePageSize is the page size requested for new node types
+const DefaultEntityTypePageSize = 512
 
-// Make sure that the DatabaseStatisticsImpl implements the TGDatabaseStatistics interface
-var _ tgdb.TGDatabaseStatistics = (*DatabaseStatisticsImpl)(nil)
+// CreateEntityTypeInfo holds the definition of a node type or edge type to be created
+type CreateEntityTypeInfo struct {
+	name         string
+	pageSize     int
+	fromNodeType string
+	toNodeType   string
+	direction    tgdb.TGDirectionType
+	attrs        []string
+	pkeys        []string
+}
 
-func DefaultDatabaseStatisticsImpl() *DatabaseStatisticsImpl {
+func DefaultCreateEntityTypeInfo() *CreateEntityTypeInfo {
 	// We must register the concrete type for the encoder and decoder (which would
 	// normally be on a separate machine from the encoder). On each end, this tells the
 	// engine which concrete type is being sent that implements the interface.
-	gob.Register(DatabaseStatisticsImpl{})
+	gob.Register(CreateEntityTypeInfo{})
 
-	return &DatabaseStatisticsImpl{}
+	return &CreateEntityTypeInfo{
+		pageSize:  DefaultEntityTypePageSize,
+		direction: tgdb.DirectionTypeDirected,
+		attrs:     make([]string, 0),
+		pkeys:     make([]string, 0),
+	}
 }
 
-func NewDatabaseStatisticsImpl(_dbSize int64, _numDataSegments int, _dataSize, _dataUsed, _dataFree int64,
-	_dataBlockSize, _numIndexSegments int, _indexSize, _indexUsed, _indexFree int64, _blockSize int) *DatabaseStatisticsImpl {
-	newConnectionInfo := DefaultDatabaseStatisticsImpl()
-	newConnectionInfo.blockSize = _blockSize
-	newConnectionInfo.dataBlockSize = _dataBlockSize
-	newConnectionInfo.dataFree = _dataFree
-	newConnectionInfo.dataSize = _dataSize
-	newConnectionInfo.dataUsed = _dataUsed
-	newConnectionInfo.dbSize = _dbSize
-	newConnectionInfo.indexFree = _indexFree
-	newConnectionInfo.indexSize = _indexSize
-	newConnectionInfo.indexUsed = _indexUsed
-	newConnectionInfo.numDataSegments = _numDataSegments
-	newConnectionInfo.numIndexSegments = _numIndexSegments
-	return newConnectionInfo
+func NewCreateNodeTypeInfo(name string, attrs, pkeys []string) *CreateEntityTypeInfo {
+	newTypeInfo := DefaultCreateEntityTypeInfo()
+	newTypeInfo.name = name
+	newTypeInfo.attrs = append(newTypeInfo.attrs, attrs...)
+	newTypeInfo.pkeys = append(newTypeInfo.pkeys, pkeys...)
+	return newTypeInfo
 }
 
-/////////////////////////////////////////////////////////////////
-// Helper functions from Interface ==> TGDatabaseStatisticsImpl
-/////////////////////////////////////////////////////////////////
+func NewCreateEdgeTypeInfo(name, fromNodeType, toNodeType string, direction tgdb.TGDirectionType, attrs, pkeys []string) *CreateEntityTypeInfo {
+	newTypeInfo := NewCreateNodeTypeInfo(name, attrs, pkeys)
+	newTypeInfo.fromNodeType = fromNodeType
+	newTypeInfo.toNodeType = toNodeType
+	newTypeInfo.direction = direction
+	return newTypeInfo
+}
 
-func (obj *DatabaseStatisticsImpl) String() string {
-	var buffer bytes.Buffer
-	buffer.WriteString("DatabaseStatisticsImpl:{")
-	buffer.WriteString(fmt.Sprintf("BlockSize: '%d'", obj.blockSize))
-	buffer.WriteString(fmt.Sprintf(", DataBlockSize: '%d'", obj.dataBlockSize))
-	buffer.WriteString(fmt.Sprintf(", DataFree: '%d'", obj.dataFree))
-	buffer.WriteString(fmt.Sprintf(", DataSize: '%d'", obj.dataSize))
-	buffer.WriteString(fmt.Sprintf(", DataUsed: '%d'", obj.dataUsed))
-	buffer.WriteString(fmt.Sprintf(", DbSize: '%d'", obj.dbSize))
-	buffer.WriteString(fmt.Sprintf(", IndexFree: '%d'", obj.indexFree))
-	buffer.WriteString(fmt.Sprintf(", IndexSize: '%d'", obj.indexSize))
+func (obj *CreateEntityTypeInfo) GetName() string {
+	return obj.name
+}
+
+func (obj *CreateEntityTypeInfo) GetAttributes() []string {
+	return obj.attrs
+}
+
+func (obj *CreateEntityTypeInfo) GetPrimaryKeys() []string {
+	return obj.pkeys
+}
+
+func (obj *CreateEntityTypeInfo) GetFromNodeType() string {
+	return obj.fromNodeType
+}
+
+func (obj *CreateEntityTypeInfo) GetToNodeType() string {
+	return obj.toNodeType
+}
+
+// GetDirectionType returns the direction of an edge type
+func (obj *CreateEntityTypeInfo) GetDirectionType() tgdb.TGDirectionType {
+	return obj.direction
+}
+
+// GetDirection returns the direction of an edge type as the server spells it
+func (obj *CreateEntityTypeInfo) GetDirection() string {
+	switch obj.direction {
+	case tgdb.DirectionTypeUnDirected:
+		return "undirected"
+	case tgdb.DirectionTypeBiDirectional:
+		return "bidirected"
+	default:
+		return "directed"
+	}
+}
+
+func (obj *CreateEntityTypeInfo) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("CreateEntityTypeInfo:{")
+	buffer.WriteString(fmt.Sprintf("Name: '%s'", obj.name))
+	buffer.WriteString(fmt.Sprintf(", PageSize: '%d'", obj.pageSize))
+	buffer.WriteString(fmt.Sprintf(", FromNodeType: '%s'", obj.fromNodeType))
+	buffer.WriteString(fmt.Sprintf(", ToNodeType: '%s'", obj.toNodeType))
+	buffer.WriteString(fmt.Sprintf(", Direction: '%s'", obj.GetDirection()))
+	buffer.WriteString(fmt.Sprintf(", Attrs: '%+v'", obj.attrs))
+	buffer.WriteString(fmt.Sprintf(", PKeys: '%+v'", obj.pkeys))
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+// CreateIndexInfo holds the definition of an index to be created
+type CreateIndexInfo struct {
+	name      string
+	isUnique  bool
+	attrs     []string
+	typeNames []string
+}
+
+func DefaultCreateIndexInfo() *CreateIndexInfo {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(CreateIndexInfo{})
+
+	return &CreateIndexInfo{
+		attrs:     make([]string, 0),
+		typeNames: make([]string, 0),
+	}
+}
+
+func NewCreateIndexInfo(name string, typeNames, attrs []string, isUnique bool) *CreateIndexInfo {
+	newIndexInfo := DefaultCreateIndexInfo()
+	newIndexInfo.name = name
+	newIndexInfo.isUnique = isUnique
+	newIndexInfo.attrs = append(newIndexInfo.attrs, attrs...)
+	newIndexInfo.typeNames = append(newIndexInfo.typeNames, typeNames...)
+	return newIndexInfo
+}
+
+func (obj *CreateIndexInfo) GetName() string {
+	return obj.name
+}
+
+func (obj *CreateIndexInfo) GetAttributes() []string {
+	return obj.attrs
+}
+
+func (obj *CreateIndexInfo) GetNodeTypes() []string {
+	return obj.typeNames
+}
+
+func (obj *CreateIndexInfo) IsUnique() bool {
+	return obj.isUnique
+}
+
+func (obj *CreateIndexInfo) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("CreateIndexInfo:{")
+	buffer.WriteString(fmt.Sprintf("Name: '%s'", obj.name))
+	buffer.WriteString(fmt.Sprintf(", IsUnique: '%+v'", obj.isUnique))
+	buffer.WriteString(fmt.Sprintf(", Attrs: '%+v'", obj.attrs))
+	buffer.WriteString(fmt.Sprintf(", TypeNames: '%+v'", obj.typeNames))
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+// CreateUserInfo holds the definition of a user to be created
+type CreateUserInfo struct {
+	name     string
+	password string
+	roles    []string
+}
+
+func DefaultCreateUserInfo() *CreateUserInfo {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(CreateUserInfo{})
+
+	return &CreateUserInfo{
+		roles: make([]string, 0),
+	}
+}
+
+func NewCreateUserInfo(name, password string, roles []string) *CreateUserInfo {
+	newUserInfo := DefaultCreateUserInfo()
+	newUserInfo.name = name
+	newUserInfo.password = password
+	newUserInfo.roles = append(newUserInfo.roles, roles...)
+	return newUserInfo
+}
+
+// String does not include the password
+func (obj *CreateUserInfo) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("CreateUserInfo:{")
+	buffer.WriteString(fmt.Sprintf("Name: '%s'", obj.name))
+	buffer.WriteString(fmt.Sprintf(", Roles: '%+v'", obj.roles))
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+
+type DatabaseStatisticsImpl struct {
+	blockSize        int
+	dataBlockSize    int
+	dataFree         int64
+	dataSize         int64
+	dataUsed         int64
+	dbSize           int64
+	indexFree        int64
+	indexSize        int64
+	indexUsed        int64
+	numDataSegments  int
+	numIndexSegments int
+}
+
+// Make sure that the DatabaseStatisticsImpl implements the TGDatabaseStatistics interface
+var _ tgdb.TGDatabaseStatistics = (*DatabaseStatisticsImpl)(nil)
+
+func DefaultDatabaseStatisticsImpl() *DatabaseStatisticsImpl {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(DatabaseStatisticsImpl{})
+
+	return &DatabaseStatisticsImpl{}
+}
+
+func NewDatabaseStatisticsImpl(_dbSize int64, _numDataSegments int, _dataSize, _dataUsed, _dataFree int64,
+	_dataBlockSize, _numIndexSegments int, _indexSize, _indexUsed, _indexFree int64, _blockSize int) *DatabaseStatisticsImpl {
+	newConnectionInfo := DefaultDatabaseStatisticsImpl()
+	newConnectionInfo.blockSize = _blockSize
+	newConnectionInfo.dataBlockSize = _dataBlockSize
+	newConnectionInfo.dataFree = _dataFree
+	newConnectionInfo.dataSize = _dataSize
+	newConnectionInfo.dataUsed = _dataUsed
+	newConnectionInfo.dbSize = _dbSize
+	newConnectionInfo.indexFree = _indexFree
+	newConnectionInfo.indexSize = _indexSize
+	newConnectionInfo.indexUsed = _indexUsed
+	newConnectionInfo.numDataSegments = _numDataSegments
+	newConnectionInfo.numIndexSegments = _numIndexSegments
+	return newConnectionInfo
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions from Interface ==> TGDatabaseStatisticsImpl
+/////////////////////////////////////////////////////////////////
+
+func (obj *DatabaseStatisticsImpl) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("DatabaseStatisticsImpl:{")
+	buffer.WriteString(fmt.Sprintf("BlockSize: '%d'", obj.blockSize))
+	buffer.WriteString(fmt.Sprintf(", DataBlockSize: '%d'", obj.dataBlockSize))
+	buffer.WriteString(fmt.Sprintf(", DataFree: '%d'", obj.dataFree))
+	buffer.WriteString(fmt.Sprintf(", DataSize: '%d'", obj.dataSize))
+	buffer.WriteString(fmt.Sprintf(", DataUsed: '%d'", obj.dataUsed))
+	buffer.WriteString(fmt.Sprintf(", DbSize: '%d'", obj.dbSize))
+	buffer.WriteString(fmt.Sprintf(", IndexFree: '%d'", obj.indexFree))
+	buffer.WriteString(fmt.Sprintf(", IndexSize: '%d'", obj.indexSize))
 	buffer.WriteString(fmt.Sprintf(", IndexUsed: '%d'", obj.indexUsed))
 	buffer.WriteString(fmt.Sprintf(", NumDataSegments: '%d'", obj.numDataSegments))
 	buffer.WriteString(fmt.Sprintf(", NumIndexSegments: '%d'", obj.numIndexSegments))
@@ -3027,6 +3742,14 @@ func (command AdminCommand) String() string {
 	return buffer.String()
 }
 
+// ======= Admin Command Result Codes =======
+const (
+	TGAdminResultSuccess               = 0
+	TGAdminResultInvalidRole           = 328
+	TGAdminResultDuplicateSystemObject = 342
+	TGAdminResultDuplicateAttrDesc     = 343
+)
+
 
 
 
@@ -3236,3 +3959,3586 @@ func (obj *UserInfoImpl) UnmarshalBinary(data []byte) error {
 	}
 	return nil
 }
+
+
+// DefaultBulkIOBatchSize is the number of entities the server is asked to send per export batch
+const DefaultBulkIOBatchSize = 1000
+
+type BulkDescriptorImpl struct {
+	TypeName     string
+	NodeType     bool
+	NumInstances int64
+}
+
+// Make sure that the BulkDescriptorImpl implements the TGBulkDescriptor interface
+var _ tgdb.TGBulkDescriptor = (*BulkDescriptorImpl)(nil)
+
+func DefaultBulkDescriptorImpl() *BulkDescriptorImpl {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(BulkDescriptorImpl{})
+
+	return &BulkDescriptorImpl{}
+}
+
+func NewBulkDescriptorImpl(_typeName string, _isNode bool, _numInstances int64) *BulkDescriptorImpl {
+	newDesc := DefaultBulkDescriptorImpl()
+	newDesc.TypeName = _typeName
+	newDesc.NodeType = _isNode
+	newDesc.NumInstances = _numInstances
+	return newDesc
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions from Interface ==> TGBulkDescriptor
+/////////////////////////////////////////////////////////////////
+
+func (obj *BulkDescriptorImpl) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("BulkDescriptorImpl:{")
+	buffer.WriteString(fmt.Sprintf("TypeName: '%s'", obj.TypeName))
+	buffer.WriteString(fmt.Sprintf(", NodeType: '%+v'", obj.NodeType))
+	buffer.WriteString(fmt.Sprintf(", NumInstances: '%d'", obj.NumInstances))
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGBulkDescriptor
+/////////////////////////////////////////////////////////////////
+
+// GetTypeName returns the name of the entity type
+func (obj *BulkDescriptorImpl) GetTypeName() string {
+	return obj.TypeName
+}
+
+// IsNode returns true if the entity type is a node type, false for an edge type
+func (obj *BulkDescriptorImpl) IsNode() bool {
+	return obj.NodeType
+}
+
+// GetNumInstances returns the number of instances of the entity type
+func (obj *BulkDescriptorImpl) GetNumInstances() int64 {
+	return obj.NumInstances
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (obj *BulkDescriptorImpl) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, obj.TypeName, obj.NodeType, obj.NumInstances)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BulkDescriptorImpl:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+func (obj *BulkDescriptorImpl) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &obj.TypeName, &obj.NodeType, &obj.NumInstances)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BulkDescriptorImpl:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// readBulkDescriptors reads the list of (type name, is node, instance count) triplets that the server
+// sends in the bulk export and import responses
+func readBulkDescriptors(is tgdb.TGInputStream) ([]tgdb.TGBulkDescriptor, tgdb.TGError) {
+	numTypes, err := is.(*ProtocolDataInputStream).ReadLong()
+	if err != nil {
+		return nil, err
+	}
+	descList := make([]tgdb.TGBulkDescriptor, 0, numTypes)
+	for i := int64(0); i < numTypes; i++ {
+		typeName, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			return nil, err
+		}
+		isNode, err := is.(*ProtocolDataInputStream).ReadBoolean()
+		if err != nil {
+			return nil, err
+		}
+		numInstances, err := is.(*ProtocolDataInputStream).ReadLong()
+		if err != nil {
+			return nil, err
+		}
+		descList = append(descList, NewBulkDescriptorImpl(typeName, isNode, numInstances))
+	}
+	return descList, nil
+}
+
+func writeBulkDescriptors(os tgdb.TGOutputStream, descList []tgdb.TGBulkDescriptor) tgdb.TGError {
+	os.(*ProtocolDataOutputStream).WriteLong(int64(len(descList)))
+	for _, desc := range descList {
+		err := os.(*ProtocolDataOutputStream).WriteUTF(desc.GetTypeName())
+		if err != nil {
+			return err
+		}
+		os.(*ProtocolDataOutputStream).WriteBoolean(desc.IsNode())
+		os.(*ProtocolDataOutputStream).WriteLong(desc.GetNumInstances())
+	}
+	return nil
+}
+
+// readNameList reads a list of names (columns, attributes or types). Unlike WriteChars, each
+// name is sent as its length followed by one byte per character
+func readNameList(is tgdb.TGInputStream) ([]string, tgdb.TGError) {
+	numNames, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, numNames)
+	for i := 0; i < numNames; i++ {
+		nameLen, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			return nil, err
+		}
+		name := make([]byte, nameLen)
+		for j := 0; j < nameLen; j++ {
+			name[j], err = is.(*ProtocolDataInputStream).ReadByte()
+			if err != nil {
+				return nil, err
+			}
+		}
+		names = append(names, string(name))
+	}
+	return names, nil
+}
+
+// writeNameList is the counterpart of readNameList
+func writeNameList(os tgdb.TGOutputStream, names []string) {
+	os.(*ProtocolDataOutputStream).WriteInt(len(names))
+	for _, name := range names {
+		os.(*ProtocolDataOutputStream).WriteInt(len(name))
+		for i := 0; i < len(name); i++ {
+			os.(*ProtocolDataOutputStream).WriteByte(int(name[i]))
+		}
+	}
+}
+
+
+
+type BeginExportRequestMessage struct {
+	*AbstractProtocolMessage
+	isBatch bool
+	zipName string
+	maxBatchEntities int
+}
+
+func DefaultBeginExportRequestMessage() *BeginExportRequestMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(BeginExportRequestMessage{})
+
+	newMsg := BeginExportRequestMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.isBatch = true
+	newMsg.maxBatchEntities = DefaultBulkIOBatchSize
+	newMsg.verbId = VerbBeginExportRequest
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewBeginExportRequestMessage(authToken, sessionId int64) *BeginExportRequestMessage {
+	newMsg := DefaultBeginExportRequestMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for BeginExportRequestMessage
+/////////////////////////////////////////////////////////////////
+
+// GetIsBatch returns whether the export is streamed back in entity batches
+func (msg *BeginExportRequestMessage) GetIsBatch() bool {
+	return msg.isBatch
+}
+
+// GetZipName returns the name of the server side zip file for non-batch export
+func (msg *BeginExportRequestMessage) GetZipName() string {
+	return msg.zipName
+}
+
+// GetMaxBatchEntities returns the maximum number of entities per export batch
+func (msg *BeginExportRequestMessage) GetMaxBatchEntities() int {
+	return msg.maxBatchEntities
+}
+
+// SetIsBatch sets whether the export is streamed back in entity batches
+func (msg *BeginExportRequestMessage) SetIsBatch(isBatch bool) {
+	msg.isBatch = isBatch
+}
+
+// SetZipName sets the name of the server side zip file for non-batch export
+func (msg *BeginExportRequestMessage) SetZipName(zipName string) {
+	msg.zipName = zipName
+}
+
+// SetMaxBatchEntities sets the maximum number of entities per export batch
+func (msg *BeginExportRequestMessage) SetMaxBatchEntities(maxBatchEntities int) {
+	msg.maxBatchEntities = maxBatchEntities
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *BeginExportRequestMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginExportRequestMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportRequestMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportRequestMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside BeginExportRequestMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportRequestMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportRequestMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportRequestMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *BeginExportRequestMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginExportRequestMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportRequestMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportRequestMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportRequestMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportRequestMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *BeginExportRequestMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *BeginExportRequestMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *BeginExportRequestMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *BeginExportRequestMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *BeginExportRequestMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *BeginExportRequestMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *BeginExportRequestMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *BeginExportRequestMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *BeginExportRequestMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *BeginExportRequestMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *BeginExportRequestMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *BeginExportRequestMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *BeginExportRequestMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *BeginExportRequestMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *BeginExportRequestMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *BeginExportRequestMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *BeginExportRequestMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *BeginExportRequestMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("BeginExportRequestMessage:{")
+	buffer.WriteString(fmt.Sprintf("IsBatch: %+v, ", msg.isBatch))
+	buffer.WriteString(fmt.Sprintf("ZipName: %s, ", msg.zipName))
+	buffer.WriteString(fmt.Sprintf("MaxBatchEntities: %d, ", msg.maxBatchEntities))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *BeginExportRequestMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *BeginExportRequestMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *BeginExportRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *BeginExportRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginExportRequestMessage:ReadPayload"))
+	}
+	isBatch, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportRequestMessage:ReadPayload w/ Error in reading isBatch from message buffer"))
+		return err
+	}
+	msg.isBatch = isBatch
+	if isBatch {
+		maxBatchEntities, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning BeginExportRequestMessage:ReadPayload w/ Error in reading maxBatchEntities from message buffer"))
+			return err
+		}
+		msg.maxBatchEntities = maxBatchEntities
+	} else {
+		zipName, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning BeginExportRequestMessage:ReadPayload w/ Error in reading zipName from message buffer"))
+			return err
+		}
+		msg.zipName = zipName
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportRequestMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *BeginExportRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering BeginExportRequestMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteBoolean(msg.isBatch)
+	if msg.isBatch {
+		os.(*ProtocolDataOutputStream).WriteInt(msg.maxBatchEntities)
+	} else {
+		err := os.(*ProtocolDataOutputStream).WriteUTF(msg.zipName)
+		if err != nil {
+			return err
+		}
+	}
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportRequestMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *BeginExportRequestMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.isBatch, msg.zipName, msg.maxBatchEntities)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginExportRequestMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *BeginExportRequestMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.isBatch, &msg.zipName, &msg.maxBatchEntities)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginExportRequestMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type BeginExportResponseMessage struct {
+	*AbstractProtocolMessage
+	numRequests int
+	resultStatus int
+	typeList []tgdb.TGBulkDescriptor
+}
+
+func DefaultBeginExportResponseMessage() *BeginExportResponseMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(BeginExportResponseMessage{})
+
+	newMsg := BeginExportResponseMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.typeList = make([]tgdb.TGBulkDescriptor, 0)
+	newMsg.verbId = VerbBeginExportResponse
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewBeginExportResponseMessage(authToken, sessionId int64) *BeginExportResponseMessage {
+	newMsg := DefaultBeginExportResponseMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for BeginExportResponseMessage
+/////////////////////////////////////////////////////////////////
+
+// GetNumRequests returns the number of partial export requests the server expects
+func (msg *BeginExportResponseMessage) GetNumRequests() int {
+	return msg.numRequests
+}
+
+// GetResultStatus returns the status the server reported for the export, 0 on success
+func (msg *BeginExportResponseMessage) GetResultStatus() int {
+	return msg.resultStatus
+}
+
+// GetTypeList returns the entity types included in the export
+func (msg *BeginExportResponseMessage) GetTypeList() []tgdb.TGBulkDescriptor {
+	return msg.typeList
+}
+
+// SetNumRequests sets the number of partial export requests the server expects
+func (msg *BeginExportResponseMessage) SetNumRequests(numRequests int) {
+	msg.numRequests = numRequests
+}
+
+// SetResultStatus sets the status the server reported for the export, 0 on success
+func (msg *BeginExportResponseMessage) SetResultStatus(resultStatus int) {
+	msg.resultStatus = resultStatus
+}
+
+// SetTypeList sets the entity types included in the export
+func (msg *BeginExportResponseMessage) SetTypeList(typeList []tgdb.TGBulkDescriptor) {
+	msg.typeList = typeList
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *BeginExportResponseMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginExportResponseMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportResponseMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportResponseMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside BeginExportResponseMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportResponseMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportResponseMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportResponseMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *BeginExportResponseMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginExportResponseMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportResponseMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginExportResponseMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportResponseMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportResponseMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *BeginExportResponseMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *BeginExportResponseMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *BeginExportResponseMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *BeginExportResponseMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *BeginExportResponseMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *BeginExportResponseMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *BeginExportResponseMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *BeginExportResponseMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *BeginExportResponseMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *BeginExportResponseMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *BeginExportResponseMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *BeginExportResponseMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *BeginExportResponseMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *BeginExportResponseMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *BeginExportResponseMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *BeginExportResponseMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *BeginExportResponseMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *BeginExportResponseMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("BeginExportResponseMessage:{")
+	buffer.WriteString(fmt.Sprintf("NumRequests: %d, ", msg.numRequests))
+	buffer.WriteString(fmt.Sprintf("ResultStatus: %d, ", msg.resultStatus))
+	buffer.WriteString(fmt.Sprintf("TypeList: %+v, ", msg.typeList))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *BeginExportResponseMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *BeginExportResponseMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *BeginExportResponseMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *BeginExportResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginExportResponseMessage:ReadPayload"))
+	}
+	numRequests, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportResponseMessage:ReadPayload w/ Error in reading numRequests from message buffer"))
+		return err
+	}
+	resultStatus, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportResponseMessage:ReadPayload w/ Error in reading resultStatus from message buffer"))
+		return err
+	}
+	msg.numRequests = numRequests
+	msg.resultStatus = resultStatus
+	if resultStatus != 0 {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Returning BeginExportResponseMessage:ReadPayload w/ '%+v'", msg.String()))
+		}
+		return nil
+	}
+	typeList, err := readBulkDescriptors(is)
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginExportResponseMessage:ReadPayload w/ Error in reading typeList from message buffer"))
+		return err
+	}
+	msg.typeList = typeList
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportResponseMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *BeginExportResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering BeginExportResponseMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(msg.numRequests)
+	os.(*ProtocolDataOutputStream).WriteInt(msg.resultStatus)
+	if msg.resultStatus == 0 {
+		err := writeBulkDescriptors(os, msg.typeList)
+		if err != nil {
+			return err
+		}
+	}
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginExportResponseMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *BeginExportResponseMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.numRequests, msg.resultStatus)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginExportResponseMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *BeginExportResponseMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.numRequests, &msg.resultStatus)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginExportResponseMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type PartialExportRequestMessage struct {
+	*AbstractProtocolMessage
+	requestNum int
+}
+
+func DefaultPartialExportRequestMessage() *PartialExportRequestMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(PartialExportRequestMessage{})
+
+	newMsg := PartialExportRequestMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.requestNum = -1
+	newMsg.verbId = VerbPartialExportRequest
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewPartialExportRequestMessage(authToken, sessionId int64) *PartialExportRequestMessage {
+	newMsg := DefaultPartialExportRequestMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for PartialExportRequestMessage
+/////////////////////////////////////////////////////////////////
+
+// GetRequestNum returns the partial export request number, -1 for the next batch
+func (msg *PartialExportRequestMessage) GetRequestNum() int {
+	return msg.requestNum
+}
+
+// SetRequestNum sets the partial export request number, -1 for the next batch
+func (msg *PartialExportRequestMessage) SetRequestNum(requestNum int) {
+	msg.requestNum = requestNum
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *PartialExportRequestMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialExportRequestMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportRequestMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportRequestMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside PartialExportRequestMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportRequestMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportRequestMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportRequestMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *PartialExportRequestMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialExportRequestMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportRequestMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportRequestMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportRequestMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportRequestMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *PartialExportRequestMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *PartialExportRequestMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *PartialExportRequestMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *PartialExportRequestMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *PartialExportRequestMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *PartialExportRequestMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *PartialExportRequestMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *PartialExportRequestMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *PartialExportRequestMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *PartialExportRequestMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *PartialExportRequestMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *PartialExportRequestMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *PartialExportRequestMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *PartialExportRequestMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *PartialExportRequestMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *PartialExportRequestMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *PartialExportRequestMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *PartialExportRequestMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("PartialExportRequestMessage:{")
+	buffer.WriteString(fmt.Sprintf("RequestNum: %d, ", msg.requestNum))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *PartialExportRequestMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *PartialExportRequestMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *PartialExportRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *PartialExportRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialExportRequestMessage:ReadPayload"))
+	}
+	requestNum, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportRequestMessage:ReadPayload w/ Error in reading requestNum from message buffer"))
+		return err
+	}
+	msg.requestNum = requestNum
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportRequestMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *PartialExportRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering PartialExportRequestMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(msg.requestNum)
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportRequestMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *PartialExportRequestMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.requestNum)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialExportRequestMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *PartialExportRequestMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.requestNum)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialExportRequestMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type PartialExportResponseMessage struct {
+	*AbstractProtocolMessage
+	isBatch bool
+	fileName string
+	newType bool
+	typeName string
+	attrList []string
+	numEntities int
+	hasMore bool
+	checkSum int64
+	data []byte
+}
+
+func DefaultPartialExportResponseMessage() *PartialExportResponseMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(PartialExportResponseMessage{})
+
+	newMsg := PartialExportResponseMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.verbId = VerbPartialExportResponse
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewPartialExportResponseMessage(authToken, sessionId int64) *PartialExportResponseMessage {
+	newMsg := DefaultPartialExportResponseMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for PartialExportResponseMessage
+/////////////////////////////////////////////////////////////////
+
+// GetIsBatch returns whether the data is an entity batch rather than a file
+func (msg *PartialExportResponseMessage) GetIsBatch() bool {
+	return msg.isBatch
+}
+
+// GetFileName returns the name of the exported file for non-batch export
+func (msg *PartialExportResponseMessage) GetFileName() string {
+	return msg.fileName
+}
+
+// GetNewType returns whether this batch starts a new entity type
+func (msg *PartialExportResponseMessage) GetNewType() bool {
+	return msg.newType
+}
+
+// GetTypeName returns the entity type name of a batch that starts a new type
+func (msg *PartialExportResponseMessage) GetTypeName() string {
+	return msg.typeName
+}
+
+// GetAttrList returns the column names of a batch that starts a new type
+func (msg *PartialExportResponseMessage) GetAttrList() []string {
+	return msg.attrList
+}
+
+// GetNumEntities returns the number of entities in this batch
+func (msg *PartialExportResponseMessage) GetNumEntities() int {
+	return msg.numEntities
+}
+
+// GetHasMore returns whether the server has more batches to send
+func (msg *PartialExportResponseMessage) GetHasMore() bool {
+	return msg.hasMore
+}
+
+// GetData returns the exported data
+func (msg *PartialExportResponseMessage) GetData() []byte {
+	return msg.data
+}
+
+// SetIsBatch sets whether the data is an entity batch rather than a file
+func (msg *PartialExportResponseMessage) SetIsBatch(isBatch bool) {
+	msg.isBatch = isBatch
+}
+
+// SetFileName sets the name of the exported file for non-batch export
+func (msg *PartialExportResponseMessage) SetFileName(fileName string) {
+	msg.fileName = fileName
+}
+
+// SetNewType sets whether this batch starts a new entity type
+func (msg *PartialExportResponseMessage) SetNewType(newType bool) {
+	msg.newType = newType
+}
+
+// SetTypeName sets the entity type name of a batch that starts a new type
+func (msg *PartialExportResponseMessage) SetTypeName(typeName string) {
+	msg.typeName = typeName
+}
+
+// SetAttrList sets the column names of a batch that starts a new type
+func (msg *PartialExportResponseMessage) SetAttrList(attrList []string) {
+	msg.attrList = attrList
+}
+
+// SetNumEntities sets the number of entities in this batch
+func (msg *PartialExportResponseMessage) SetNumEntities(numEntities int) {
+	msg.numEntities = numEntities
+}
+
+// SetHasMore sets whether the server has more batches to send
+func (msg *PartialExportResponseMessage) SetHasMore(hasMore bool) {
+	msg.hasMore = hasMore
+}
+
+// SetData sets the exported data
+func (msg *PartialExportResponseMessage) SetData(data []byte) {
+	msg.data = data
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *PartialExportResponseMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialExportResponseMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside PartialExportResponseMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportResponseMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportResponseMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportResponseMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *PartialExportResponseMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialExportResponseMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportResponseMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialExportResponseMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportResponseMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *PartialExportResponseMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *PartialExportResponseMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *PartialExportResponseMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *PartialExportResponseMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *PartialExportResponseMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *PartialExportResponseMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *PartialExportResponseMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *PartialExportResponseMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *PartialExportResponseMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *PartialExportResponseMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *PartialExportResponseMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *PartialExportResponseMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *PartialExportResponseMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *PartialExportResponseMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *PartialExportResponseMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *PartialExportResponseMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *PartialExportResponseMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *PartialExportResponseMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("PartialExportResponseMessage:{")
+	buffer.WriteString(fmt.Sprintf("IsBatch: %+v, ", msg.isBatch))
+	buffer.WriteString(fmt.Sprintf("FileName: %s, ", msg.fileName))
+	buffer.WriteString(fmt.Sprintf("NewType: %+v, ", msg.newType))
+	buffer.WriteString(fmt.Sprintf("TypeName: %s, ", msg.typeName))
+	buffer.WriteString(fmt.Sprintf("AttrList: %+v, ", msg.attrList))
+	buffer.WriteString(fmt.Sprintf("NumEntities: %d, ", msg.numEntities))
+	buffer.WriteString(fmt.Sprintf("HasMore: %+v, ", msg.hasMore))
+	buffer.WriteString(fmt.Sprintf("CheckSum: %d, ", msg.checkSum))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *PartialExportResponseMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *PartialExportResponseMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *PartialExportResponseMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *PartialExportResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialExportResponseMessage:ReadPayload"))
+	}
+	isBatch, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading isBatch from message buffer"))
+		return err
+	}
+	msg.isBatch = isBatch
+	if !isBatch {
+		fileName, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading fileName from message buffer"))
+			return err
+		}
+		msg.fileName = fileName
+	} else {
+		newType, err := is.(*ProtocolDataInputStream).ReadBoolean()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading newType from message buffer"))
+			return err
+		}
+		msg.newType = newType
+		if newType {
+			typeName, err := is.(*ProtocolDataInputStream).ReadUTF()
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading typeName from message buffer"))
+				return err
+			}
+			attrList, err := readNameList(is)
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading attrList from message buffer"))
+				return err
+			}
+			msg.typeName = typeName
+			msg.attrList = attrList
+		}
+		numEntities, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading numEntities from message buffer"))
+			return err
+		}
+		hasMore, err := is.(*ProtocolDataInputStream).ReadBoolean()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading hasMore from message buffer"))
+			return err
+		}
+		msg.numEntities = numEntities
+		msg.hasMore = hasMore
+	}
+	// Buffer length is repeated by the byte array that follows the checksum
+	_, err = is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading buffer length from message buffer"))
+		return err
+	}
+	checkSum, err := is.(*ProtocolDataInputStream).ReadLong()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading checkSum from message buffer"))
+		return err
+	}
+	data, err := is.(*ProtocolDataInputStream).ReadBytes()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialExportResponseMessage:ReadPayload w/ Error in reading data from message buffer"))
+		return err
+	}
+	msg.checkSum = checkSum
+	msg.data = data
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportResponseMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *PartialExportResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering PartialExportResponseMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteBoolean(msg.isBatch)
+	if !msg.isBatch {
+		err := os.(*ProtocolDataOutputStream).WriteUTF(msg.fileName)
+		if err != nil {
+			return err
+		}
+	} else {
+		os.(*ProtocolDataOutputStream).WriteBoolean(msg.newType)
+		if msg.newType {
+			err := os.(*ProtocolDataOutputStream).WriteUTF(msg.typeName)
+			if err != nil {
+				return err
+			}
+			writeNameList(os, msg.attrList)
+		}
+		os.(*ProtocolDataOutputStream).WriteInt(msg.numEntities)
+		os.(*ProtocolDataOutputStream).WriteBoolean(msg.hasMore)
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(len(msg.data))
+	os.(*ProtocolDataOutputStream).WriteLong(tgCheckSum64(msg.data))
+	err := os.(*ProtocolDataOutputStream).WriteBytes(msg.data)
+	if err != nil {
+		return err
+	}
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialExportResponseMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *PartialExportResponseMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.isBatch, msg.fileName, msg.newType, msg.typeName, msg.numEntities, msg.hasMore, msg.checkSum)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialExportResponseMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *PartialExportResponseMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.isBatch, &msg.fileName, &msg.newType, &msg.typeName, &msg.numEntities, &msg.hasMore, &msg.checkSum)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialExportResponseMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type CancelExportRequestMessage struct {
+	*AbstractProtocolMessage
+}
+
+func DefaultCancelExportRequestMessage() *CancelExportRequestMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(CancelExportRequestMessage{})
+
+	newMsg := CancelExportRequestMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.verbId = VerbCancelExportRequest
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewCancelExportRequestMessage(authToken, sessionId int64) *CancelExportRequestMessage {
+	newMsg := DefaultCancelExportRequestMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *CancelExportRequestMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering CancelExportRequestMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning CancelExportRequestMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning CancelExportRequestMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside CancelExportRequestMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside CancelExportRequestMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside CancelExportRequestMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning CancelExportRequestMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *CancelExportRequestMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering CancelExportRequestMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside CancelExportRequestMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside CancelExportRequestMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning CancelExportRequestMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning CancelExportRequestMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *CancelExportRequestMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *CancelExportRequestMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *CancelExportRequestMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *CancelExportRequestMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *CancelExportRequestMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *CancelExportRequestMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *CancelExportRequestMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *CancelExportRequestMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *CancelExportRequestMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *CancelExportRequestMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *CancelExportRequestMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *CancelExportRequestMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *CancelExportRequestMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *CancelExportRequestMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *CancelExportRequestMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *CancelExportRequestMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *CancelExportRequestMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *CancelExportRequestMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("CancelExportRequestMessage:{")
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *CancelExportRequestMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *CancelExportRequestMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *CancelExportRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *CancelExportRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	// No-op - Cancel export does not carry any payload
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *CancelExportRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	// No-op - Cancel export does not carry any payload
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *CancelExportRequestMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning CancelExportRequestMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *CancelExportRequestMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning CancelExportRequestMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type BeginImportRequestMessage struct {
+	*AbstractProtocolMessage
+	isBatch bool
+	loadOption string
+	errorOption string
+	dateFormat string
+	totalRequests int
+	numFiles int
+}
+
+func DefaultBeginImportRequestMessage() *BeginImportRequestMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(BeginImportRequestMessage{})
+
+	newMsg := BeginImportRequestMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.isBatch = true
+	newMsg.loadOption = tgdb.LoadOptionInsert
+	newMsg.errorOption = tgdb.ErrorOptionStop
+	newMsg.dateFormat = tgdb.DateFormatYMD
+	newMsg.verbId = VerbBeginImportRequest
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewBeginImportRequestMessage(authToken, sessionId int64) *BeginImportRequestMessage {
+	newMsg := DefaultBeginImportRequestMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for BeginImportRequestMessage
+/////////////////////////////////////////////////////////////////
+
+// GetIsBatch returns whether the import is sent as entity batches rather than files
+func (msg *BeginImportRequestMessage) GetIsBatch() bool {
+	return msg.isBatch
+}
+
+// GetLoadOption returns how imported entities are loaded, insert or upsert
+func (msg *BeginImportRequestMessage) GetLoadOption() string {
+	return msg.loadOption
+}
+
+// GetErrorOption returns whether the server stops or ignores import errors
+func (msg *BeginImportRequestMessage) GetErrorOption() string {
+	return msg.errorOption
+}
+
+// GetDateFormat returns the date format of the imported data
+func (msg *BeginImportRequestMessage) GetDateFormat() string {
+	return msg.dateFormat
+}
+
+// GetTotalRequests returns the total number of partial import requests for non-batch import
+func (msg *BeginImportRequestMessage) GetTotalRequests() int {
+	return msg.totalRequests
+}
+
+// GetNumFiles returns the number of files for non-batch import
+func (msg *BeginImportRequestMessage) GetNumFiles() int {
+	return msg.numFiles
+}
+
+// SetIsBatch sets whether the import is sent as entity batches rather than files
+func (msg *BeginImportRequestMessage) SetIsBatch(isBatch bool) {
+	msg.isBatch = isBatch
+}
+
+// SetLoadOption sets how imported entities are loaded, insert or upsert
+func (msg *BeginImportRequestMessage) SetLoadOption(loadOption string) {
+	msg.loadOption = loadOption
+}
+
+// SetErrorOption sets whether the server stops or ignores import errors
+func (msg *BeginImportRequestMessage) SetErrorOption(errorOption string) {
+	msg.errorOption = errorOption
+}
+
+// SetDateFormat sets the date format of the imported data
+func (msg *BeginImportRequestMessage) SetDateFormat(dateFormat string) {
+	msg.dateFormat = dateFormat
+}
+
+// SetTotalRequests sets the total number of partial import requests for non-batch import
+func (msg *BeginImportRequestMessage) SetTotalRequests(totalRequests int) {
+	msg.totalRequests = totalRequests
+}
+
+// SetNumFiles sets the number of files for non-batch import
+func (msg *BeginImportRequestMessage) SetNumFiles(numFiles int) {
+	msg.numFiles = numFiles
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *BeginImportRequestMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginImportRequestMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside BeginImportRequestMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportRequestMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportRequestMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportRequestMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *BeginImportRequestMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginImportRequestMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportRequestMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportRequestMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportRequestMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *BeginImportRequestMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *BeginImportRequestMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *BeginImportRequestMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *BeginImportRequestMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *BeginImportRequestMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *BeginImportRequestMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *BeginImportRequestMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *BeginImportRequestMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *BeginImportRequestMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *BeginImportRequestMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *BeginImportRequestMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *BeginImportRequestMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *BeginImportRequestMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *BeginImportRequestMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *BeginImportRequestMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *BeginImportRequestMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *BeginImportRequestMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *BeginImportRequestMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("BeginImportRequestMessage:{")
+	buffer.WriteString(fmt.Sprintf("IsBatch: %+v, ", msg.isBatch))
+	buffer.WriteString(fmt.Sprintf("LoadOption: %s, ", msg.loadOption))
+	buffer.WriteString(fmt.Sprintf("ErrorOption: %s, ", msg.errorOption))
+	buffer.WriteString(fmt.Sprintf("DateFormat: %s, ", msg.dateFormat))
+	buffer.WriteString(fmt.Sprintf("TotalRequests: %d, ", msg.totalRequests))
+	buffer.WriteString(fmt.Sprintf("NumFiles: %d, ", msg.numFiles))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *BeginImportRequestMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *BeginImportRequestMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *BeginImportRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *BeginImportRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginImportRequestMessage:ReadPayload"))
+	}
+	isBatch, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:ReadPayload w/ Error in reading isBatch from message buffer"))
+		return err
+	}
+	msg.isBatch = isBatch
+	if isBatch {
+		errorOption, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:ReadPayload w/ Error in reading errorOption from message buffer"))
+			return err
+		}
+		loadOption, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:ReadPayload w/ Error in reading loadOption from message buffer"))
+			return err
+		}
+		dateFormat, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:ReadPayload w/ Error in reading dateFormat from message buffer"))
+			return err
+		}
+		msg.errorOption = errorOption
+		msg.loadOption = loadOption
+		msg.dateFormat = dateFormat
+	} else {
+		totalRequests, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:ReadPayload w/ Error in reading totalRequests from message buffer"))
+			return err
+		}
+		numFiles, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning BeginImportRequestMessage:ReadPayload w/ Error in reading numFiles from message buffer"))
+			return err
+		}
+		msg.totalRequests = totalRequests
+		msg.numFiles = numFiles
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportRequestMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *BeginImportRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering BeginImportRequestMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteBoolean(msg.isBatch)
+	if msg.isBatch {
+		for _, opt := range []string{msg.errorOption, msg.loadOption, msg.dateFormat} {
+			err := os.(*ProtocolDataOutputStream).WriteUTF(opt)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		os.(*ProtocolDataOutputStream).WriteInt(msg.totalRequests)
+		os.(*ProtocolDataOutputStream).WriteInt(msg.numFiles)
+	}
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportRequestMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *BeginImportRequestMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.isBatch, msg.loadOption, msg.errorOption, msg.dateFormat, msg.totalRequests, msg.numFiles)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginImportRequestMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *BeginImportRequestMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.isBatch, &msg.loadOption, &msg.errorOption, &msg.dateFormat, &msg.totalRequests, &msg.numFiles)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginImportRequestMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type BeginImportResponseMessage struct {
+	*AbstractProtocolMessage
+	resultStatus int
+}
+
+func DefaultBeginImportResponseMessage() *BeginImportResponseMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(BeginImportResponseMessage{})
+
+	newMsg := BeginImportResponseMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.verbId = VerbBeginImportResponse
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewBeginImportResponseMessage(authToken, sessionId int64) *BeginImportResponseMessage {
+	newMsg := DefaultBeginImportResponseMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for BeginImportResponseMessage
+/////////////////////////////////////////////////////////////////
+
+// GetResultStatus returns the status the server reported for the import, 0 on success
+func (msg *BeginImportResponseMessage) GetResultStatus() int {
+	return msg.resultStatus
+}
+
+// SetResultStatus sets the status the server reported for the import, 0 on success
+func (msg *BeginImportResponseMessage) SetResultStatus(resultStatus int) {
+	msg.resultStatus = resultStatus
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *BeginImportResponseMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginImportResponseMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportResponseMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportResponseMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside BeginImportResponseMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportResponseMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportResponseMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportResponseMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *BeginImportResponseMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginImportResponseMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportResponseMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside BeginImportResponseMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportResponseMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportResponseMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *BeginImportResponseMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *BeginImportResponseMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *BeginImportResponseMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *BeginImportResponseMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *BeginImportResponseMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *BeginImportResponseMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *BeginImportResponseMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *BeginImportResponseMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *BeginImportResponseMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *BeginImportResponseMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *BeginImportResponseMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *BeginImportResponseMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *BeginImportResponseMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *BeginImportResponseMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *BeginImportResponseMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *BeginImportResponseMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *BeginImportResponseMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *BeginImportResponseMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("BeginImportResponseMessage:{")
+	buffer.WriteString(fmt.Sprintf("ResultStatus: %d, ", msg.resultStatus))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *BeginImportResponseMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *BeginImportResponseMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *BeginImportResponseMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *BeginImportResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering BeginImportResponseMessage:ReadPayload"))
+	}
+	resultStatus, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning BeginImportResponseMessage:ReadPayload w/ Error in reading resultStatus from message buffer"))
+		return err
+	}
+	msg.resultStatus = resultStatus
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportResponseMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *BeginImportResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering BeginImportResponseMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(msg.resultStatus)
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning BeginImportResponseMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *BeginImportResponseMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.resultStatus)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginImportResponseMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *BeginImportResponseMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.resultStatus)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning BeginImportResponseMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type PartialImportRequestMessage struct {
+	*AbstractProtocolMessage
+	isBatch bool
+	typeName string
+	reqIdx int
+	totalRequestsForType int
+	attrList []string
+	fileName string
+	fileTotalReq int
+	fileReqIdx int
+	data []byte
+}
+
+func DefaultPartialImportRequestMessage() *PartialImportRequestMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(PartialImportRequestMessage{})
+
+	newMsg := PartialImportRequestMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.isBatch = true
+	newMsg.verbId = VerbPartialImportRequest
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewPartialImportRequestMessage(authToken, sessionId int64) *PartialImportRequestMessage {
+	newMsg := DefaultPartialImportRequestMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for PartialImportRequestMessage
+/////////////////////////////////////////////////////////////////
+
+// GetIsBatch returns whether the data is an entity batch rather than a file
+func (msg *PartialImportRequestMessage) GetIsBatch() bool {
+	return msg.isBatch
+}
+
+// GetTypeName returns the entity type name of the batch
+func (msg *PartialImportRequestMessage) GetTypeName() string {
+	return msg.typeName
+}
+
+// GetReqIdx returns the index of this batch within its entity type
+func (msg *PartialImportRequestMessage) GetReqIdx() int {
+	return msg.reqIdx
+}
+
+// GetTotalRequestsForType returns the number of batches sent for the entity type
+func (msg *PartialImportRequestMessage) GetTotalRequestsForType() int {
+	return msg.totalRequestsForType
+}
+
+// GetAttrList returns the column names, sent with the first batch of a type
+func (msg *PartialImportRequestMessage) GetAttrList() []string {
+	return msg.attrList
+}
+
+// GetFileName returns the name of the imported file for non-batch import
+func (msg *PartialImportRequestMessage) GetFileName() string {
+	return msg.fileName
+}
+
+// GetFileTotalReq returns the number of requests sent for the file
+func (msg *PartialImportRequestMessage) GetFileTotalReq() int {
+	return msg.fileTotalReq
+}
+
+// GetFileReqIdx returns the index of this request within its file
+func (msg *PartialImportRequestMessage) GetFileReqIdx() int {
+	return msg.fileReqIdx
+}
+
+// GetData returns the data to be imported
+func (msg *PartialImportRequestMessage) GetData() []byte {
+	return msg.data
+}
+
+// SetIsBatch sets whether the data is an entity batch rather than a file
+func (msg *PartialImportRequestMessage) SetIsBatch(isBatch bool) {
+	msg.isBatch = isBatch
+}
+
+// SetTypeName sets the entity type name of the batch
+func (msg *PartialImportRequestMessage) SetTypeName(typeName string) {
+	msg.typeName = typeName
+}
+
+// SetReqIdx sets the index of this batch within its entity type
+func (msg *PartialImportRequestMessage) SetReqIdx(reqIdx int) {
+	msg.reqIdx = reqIdx
+}
+
+// SetTotalRequestsForType sets the number of batches sent for the entity type
+func (msg *PartialImportRequestMessage) SetTotalRequestsForType(totalRequestsForType int) {
+	msg.totalRequestsForType = totalRequestsForType
+}
+
+// SetAttrList sets the column names, sent with the first batch of a type
+func (msg *PartialImportRequestMessage) SetAttrList(attrList []string) {
+	msg.attrList = attrList
+}
+
+// SetFileName sets the name of the imported file for non-batch import
+func (msg *PartialImportRequestMessage) SetFileName(fileName string) {
+	msg.fileName = fileName
+}
+
+// SetFileTotalReq sets the number of requests sent for the file
+func (msg *PartialImportRequestMessage) SetFileTotalReq(fileTotalReq int) {
+	msg.fileTotalReq = fileTotalReq
+}
+
+// SetFileReqIdx sets the index of this request within its file
+func (msg *PartialImportRequestMessage) SetFileReqIdx(fileReqIdx int) {
+	msg.fileReqIdx = fileReqIdx
+}
+
+// SetData sets the data to be imported
+func (msg *PartialImportRequestMessage) SetData(data []byte) {
+	msg.data = data
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *PartialImportRequestMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialImportRequestMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside PartialImportRequestMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportRequestMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportRequestMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportRequestMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *PartialImportRequestMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialImportRequestMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportRequestMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportRequestMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportRequestMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *PartialImportRequestMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *PartialImportRequestMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *PartialImportRequestMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *PartialImportRequestMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *PartialImportRequestMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *PartialImportRequestMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *PartialImportRequestMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *PartialImportRequestMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *PartialImportRequestMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *PartialImportRequestMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *PartialImportRequestMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *PartialImportRequestMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *PartialImportRequestMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *PartialImportRequestMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *PartialImportRequestMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *PartialImportRequestMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *PartialImportRequestMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *PartialImportRequestMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("PartialImportRequestMessage:{")
+	buffer.WriteString(fmt.Sprintf("IsBatch: %+v, ", msg.isBatch))
+	buffer.WriteString(fmt.Sprintf("TypeName: %s, ", msg.typeName))
+	buffer.WriteString(fmt.Sprintf("ReqIdx: %d, ", msg.reqIdx))
+	buffer.WriteString(fmt.Sprintf("TotalRequestsForType: %d, ", msg.totalRequestsForType))
+	buffer.WriteString(fmt.Sprintf("AttrList: %+v, ", msg.attrList))
+	buffer.WriteString(fmt.Sprintf("FileName: %s, ", msg.fileName))
+	buffer.WriteString(fmt.Sprintf("FileTotalReq: %d, ", msg.fileTotalReq))
+	buffer.WriteString(fmt.Sprintf("FileReqIdx: %d, ", msg.fileReqIdx))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *PartialImportRequestMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *PartialImportRequestMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *PartialImportRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *PartialImportRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialImportRequestMessage:ReadPayload"))
+	}
+	isBatch, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading isBatch from message buffer"))
+		return err
+	}
+	_, err = is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading buffer length from message buffer"))
+		return err
+	}
+	_, err = is.(*ProtocolDataInputStream).ReadLong()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading checkSum from message buffer"))
+		return err
+	}
+	msg.isBatch = isBatch
+	if isBatch {
+		typeName, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading typeName from message buffer"))
+			return err
+		}
+		reqIdx, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading reqIdx from message buffer"))
+			return err
+		}
+		totalRequestsForType, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading totalRequestsForType from message buffer"))
+			return err
+		}
+		msg.typeName = typeName
+		msg.reqIdx = reqIdx
+		msg.totalRequestsForType = totalRequestsForType
+		if reqIdx == 0 {
+			attrList, err := readNameList(is)
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading attrList from message buffer"))
+				return err
+			}
+			msg.attrList = attrList
+		}
+	} else {
+		fileName, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading fileName from message buffer"))
+			return err
+		}
+		fileTotalReq, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading fileTotalReq from message buffer"))
+			return err
+		}
+		fileReqIdx, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading fileReqIdx from message buffer"))
+			return err
+		}
+		msg.fileName = fileName
+		msg.fileTotalReq = fileTotalReq
+		msg.fileReqIdx = fileReqIdx
+	}
+	dataLen, err := is.(*ProtocolDataInputStream).ReadUnsignedShort()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading data length from message buffer"))
+		return err
+	}
+	msg.data = make([]byte, int(dataLen))
+	_, err = is.(*ProtocolDataInputStream).ReadIntoBuffer(msg.data)
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportRequestMessage:ReadPayload w/ Error in reading data from message buffer"))
+		return err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportRequestMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *PartialImportRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering PartialImportRequestMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteBoolean(msg.isBatch)
+	os.(*ProtocolDataOutputStream).WriteInt(len(msg.data))
+	// Checksum of the data is filled in once the data has been written
+	csPos := os.GetPosition()
+	os.(*ProtocolDataOutputStream).WriteLong(0)
+	if msg.isBatch {
+		err := os.(*ProtocolDataOutputStream).WriteUTF(msg.typeName)
+		if err != nil {
+			return err
+		}
+		os.(*ProtocolDataOutputStream).WriteInt(msg.reqIdx)
+		os.(*ProtocolDataOutputStream).WriteInt(msg.totalRequestsForType)
+		if msg.reqIdx == 0 {
+			writeNameList(os, msg.attrList)
+		}
+	} else {
+		err := os.(*ProtocolDataOutputStream).WriteUTF(msg.fileName)
+		if err != nil {
+			return err
+		}
+		os.(*ProtocolDataOutputStream).WriteInt(msg.fileTotalReq)
+		os.(*ProtocolDataOutputStream).WriteInt(msg.fileReqIdx)
+	}
+	if len(msg.data) > math.MaxUint16 {
+		errMsg := fmt.Sprintf("Import data of %d bytes exceeds the maximum of %d bytes per request", len(msg.data), math.MaxUint16)
+		return GetErrorByType(TGErrorIOException, TGDB_BULKIO_ERROR, errMsg, "")
+	}
+	os.(*ProtocolDataOutputStream).WriteShort(len(msg.data))
+	err := os.(*ProtocolDataOutputStream).WriteBytesFromPos(msg.data, 0, len(msg.data))
+	if err != nil {
+		return err
+	}
+	_, err = os.(*ProtocolDataOutputStream).WriteLongAt(csPos, tgCheckSum64(msg.data))
+	if err != nil {
+		return err
+	}
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportRequestMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *PartialImportRequestMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.isBatch, msg.typeName, msg.reqIdx, msg.totalRequestsForType, msg.fileName, msg.fileTotalReq, msg.fileReqIdx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialImportRequestMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *PartialImportRequestMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.isBatch, &msg.typeName, &msg.reqIdx, &msg.totalRequestsForType, &msg.fileName, &msg.fileTotalReq, &msg.fileReqIdx)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialImportRequestMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
+
+type PartialImportResponseMessage struct {
+	*AbstractProtocolMessage
+	resultStatus int
+	resultList []tgdb.TGBulkDescriptor
+}
+
+func DefaultPartialImportResponseMessage() *PartialImportResponseMessage {
+	// We must register the concrete type for the encoder and decoder (which would
+	// normally be on a separate machine from the encoder). On each end, this tells the
+	// engine which concrete type is being sent that implements the interface.
+	gob.Register(PartialImportResponseMessage{})
+
+	newMsg := PartialImportResponseMessage{
+		AbstractProtocolMessage: DefaultAbstractProtocolMessage(),
+	}
+	newMsg.isUpdatable = false
+	newMsg.resultList = make([]tgdb.TGBulkDescriptor, 0)
+	newMsg.verbId = VerbPartialImportResponse
+	newMsg.BufLength = int(reflect.TypeOf(newMsg).Size())
+	return &newMsg
+}
+
+// Create New Message Instance
+func NewPartialImportResponseMessage(authToken, sessionId int64) *PartialImportResponseMessage {
+	newMsg := DefaultPartialImportResponseMessage()
+	newMsg.authToken = authToken
+	newMsg.sessionId = sessionId
+	newMsg.BufLength = int(reflect.TypeOf(*newMsg).Size())
+	return newMsg
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for PartialImportResponseMessage
+/////////////////////////////////////////////////////////////////
+
+// GetResultStatus returns the status the server reported for the batch, 0 on success
+func (msg *PartialImportResponseMessage) GetResultStatus() int {
+	return msg.resultStatus
+}
+
+// GetResultList returns the number of instances imported so far per entity type
+func (msg *PartialImportResponseMessage) GetResultList() []tgdb.TGBulkDescriptor {
+	return msg.resultList
+}
+
+// SetResultStatus sets the status the server reported for the batch, 0 on success
+func (msg *PartialImportResponseMessage) SetResultStatus(resultStatus int) {
+	msg.resultStatus = resultStatus
+}
+
+// SetResultList sets the number of instances imported so far per entity type
+func (msg *PartialImportResponseMessage) SetResultList(resultList []tgdb.TGBulkDescriptor) {
+	msg.resultList = resultList
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGMessage
+/////////////////////////////////////////////////////////////////
+
+// FromBytes constructs a message object from the input buffer in the byte format
+func (msg *PartialImportResponseMessage) FromBytes(buffer []byte) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialImportResponseMessage:FromBytes"))
+	}
+	if len(buffer) < 0 {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportResponseMessage:FromBytes w/ Error: Invalid Message Buffer"))
+		return nil, CreateExceptionByType(TGErrorInvalidMessageLength)
+	}
+
+	is := NewProtocolDataInputStream(buffer)
+
+	// First member attribute / element of message header is BufLength
+	bufLen, err := is.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportResponseMessage:FromBytes w/ Error in reading buffer length from message buffer"))
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside PartialImportResponseMessage:FromBytes read bufLen as '%+v'", bufLen))
+	}
+	if bufLen != len(buffer) {
+		errMsg := fmt.Sprint("Buffer length mismatch")
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportResponseMessage:FromBytes - about to APMReadHeader"))
+	}
+	err = APMReadHeader(msg, is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportResponseMessage:FromBytes - about to ReadPayload"))
+	}
+	err = msg.ReadPayload(is)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to recreate message from '%+v' in byte format", buffer)
+		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportResponseMessage::FromBytes resulted in '%+v'", msg))
+	}
+	return msg, nil
+}
+
+// ToBytes converts a message object into byte format to be sent over the network to TGDB server
+func (msg *PartialImportResponseMessage) ToBytes() ([]byte, int, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialImportResponseMessage:ToBytes"))
+	}
+	os := DefaultProtocolDataOutputStream()
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportResponseMessage:ToBytes - about to APMWriteHeader"))
+	}
+	err := APMWriteHeader(msg, os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside PartialImportResponseMessage:ToBytes - about to WritePayload"))
+	}
+	err = msg.WritePayload(os)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to export message '%+v' in byte format", msg)
+		return nil, -1, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	_, err = os.WriteIntAt(0, os.GetLength())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportResponseMessage:ToBytes w/ Error in writing buffer length"))
+		return nil, -1, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportResponseMessage::ToBytes results bytes-on-the-wire in '%+v'", os.GetBuffer()))
+	}
+	return os.GetBuffer(), os.GetLength(), nil
+}
+
+// GetAuthToken gets the authToken
+func (msg *PartialImportResponseMessage) GetAuthToken() int64 {
+	return msg.HeaderGetAuthToken()
+}
+
+// GetIsUpdatable checks whether this message updatable or not
+func (msg *PartialImportResponseMessage) GetIsUpdatable() bool {
+	return msg.GetUpdatableFlag()
+}
+
+// GetMessageByteBufLength gets the MessageByteBufLength. This method is called after the toBytes() is executed.
+func (msg *PartialImportResponseMessage) GetMessageByteBufLength() int {
+	return msg.HeaderGetMessageByteBufLength()
+}
+
+// GetRequestId gets the requestId for the message. This will be used as the CorrelationId
+func (msg *PartialImportResponseMessage) GetRequestId() int64 {
+	return msg.HeaderGetRequestId()
+}
+
+// GetSequenceNo gets the sequenceNo of the message
+func (msg *PartialImportResponseMessage) GetSequenceNo() int64 {
+	return msg.HeaderGetSequenceNo()
+}
+
+// GetSessionId gets the session id
+func (msg *PartialImportResponseMessage) GetSessionId() int64 {
+	return msg.HeaderGetSessionId()
+}
+
+// GetTimestamp gets the Timestamp
+func (msg *PartialImportResponseMessage) GetTimestamp() int64 {
+	return msg.HeaderGetTimestamp()
+}
+
+// GetVerbId gets verbId of the message
+func (msg *PartialImportResponseMessage) GetVerbId() int {
+	return msg.HeaderGetVerbId()
+}
+
+// SetAuthToken sets the authToken
+func (msg *PartialImportResponseMessage) SetAuthToken(authToken int64) {
+	msg.HeaderSetAuthToken(authToken)
+}
+
+// SetDataOffset sets the offset at which data starts in the payload
+func (msg *PartialImportResponseMessage) SetDataOffset(dataOffset int16) {
+	msg.HeaderSetDataOffset(dataOffset)
+}
+
+// SetIsUpdatable sets the updatable flag
+func (msg *PartialImportResponseMessage) SetIsUpdatable(updateFlag bool) {
+	msg.SetUpdatableFlag(updateFlag)
+}
+
+// SetMessageByteBufLength sets the message buffer length
+func (msg *PartialImportResponseMessage) SetMessageByteBufLength(bufLength int) {
+	msg.HeaderSetMessageByteBufLength(bufLength)
+}
+
+// SetRequestId sets the request id
+func (msg *PartialImportResponseMessage) SetRequestId(requestId int64) {
+	msg.HeaderSetRequestId(requestId)
+}
+
+// SetSequenceNo sets the sequenceNo
+func (msg *PartialImportResponseMessage) SetSequenceNo(sequenceNo int64) {
+	msg.HeaderSetSequenceNo(sequenceNo)
+}
+
+// SetSessionId sets the session id
+func (msg *PartialImportResponseMessage) SetSessionId(sessionId int64) {
+	msg.HeaderSetSessionId(sessionId)
+}
+
+// SetTimestamp sets the timestamp
+func (msg *PartialImportResponseMessage) SetTimestamp(timestamp int64) tgdb.TGError {
+	if !(msg.isUpdatable || timestamp != -1) {
+		logger.Error(fmt.Sprint("ERROR: Returning APMReadHeader:setTimestamp as !msg.IsUpdatable && timestamp != -1"))
+		errMsg := fmt.Sprintf("Mutating a readonly message '%s'", GetVerb(msg.verbId).name)
+		return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	msg.HeaderSetTimestamp(timestamp)
+	return nil
+}
+
+// SetVerbId sets verbId of the message
+func (msg *PartialImportResponseMessage) SetVerbId(verbId int) {
+	msg.HeaderSetVerbId(verbId)
+}
+
+func (msg *PartialImportResponseMessage) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("PartialImportResponseMessage:{")
+	buffer.WriteString(fmt.Sprintf("ResultStatus: %d, ", msg.resultStatus))
+	buffer.WriteString(fmt.Sprintf("ResultList: %+v, ", msg.resultList))
+	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	strArray := []string{buffer.String(), msg.APMMessageToString() + "}"}
+	msgStr := strings.Join(strArray, ", ")
+	return msgStr
+}
+
+// UpdateSequenceAndTimeStamp updates the SequenceAndTimeStamp, if message is mutable
+// @param timestamp
+// @return TGMessage on success, error on failure
+func (msg *PartialImportResponseMessage) UpdateSequenceAndTimeStamp(timestamp int64) tgdb.TGError {
+	return msg.SetSequenceAndTimeStamp(timestamp)
+}
+
+// ReadHeader reads the bytes from input stream and constructs a common header of network packet
+func (msg *PartialImportResponseMessage) ReadHeader(is tgdb.TGInputStream) tgdb.TGError {
+	return APMReadHeader(msg, is)
+}
+
+// WriteHeader exports the values of the common message header Attributes to output stream
+func (msg *PartialImportResponseMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError {
+	return APMWriteHeader(msg, os)
+}
+
+// ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
+func (msg *PartialImportResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering PartialImportResponseMessage:ReadPayload"))
+	}
+	resultStatus, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportResponseMessage:ReadPayload w/ Error in reading resultStatus from message buffer"))
+		return err
+	}
+	msg.resultStatus = resultStatus
+	if resultStatus != 0 {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Returning PartialImportResponseMessage:ReadPayload w/ '%+v'", msg.String()))
+		}
+		return nil
+	}
+	resultList, err := readBulkDescriptors(is)
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning PartialImportResponseMessage:ReadPayload w/ Error in reading resultList from message buffer"))
+		return err
+	}
+	msg.resultList = resultList
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportResponseMessage:ReadPayload w/ '%+v'", msg.String()))
+	}
+	return nil
+}
+
+// WritePayload exports the values of the message specific payload Attributes to output stream
+func (msg *PartialImportResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering PartialImportResponseMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(msg.resultStatus)
+	if msg.resultStatus == 0 {
+		err := writeBulkDescriptors(os, msg.resultList)
+		if err != nil {
+			return err
+		}
+	}
+	currPos := os.GetPosition()
+	length := currPos - startPos
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning PartialImportResponseMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, length))
+	}
+	return nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryMarshaller
+/////////////////////////////////////////////////////////////////
+
+func (msg *PartialImportResponseMessage) MarshalBinary() ([]byte, error) {
+	// A simple encoding: plain text.
+	var b bytes.Buffer
+	_, err := fmt.Fprintln(&b, msg.BufLength, msg.verbId, msg.sequenceNo, msg.timestamp,
+		msg.requestId, msg.dataOffset, msg.authToken, msg.sessionId, msg.isUpdatable, msg.resultStatus)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialImportResponseMessage:MarshalBinary w/ Error: '%+v'", err.Error()))
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> encoding/BinaryUnmarshaller
+/////////////////////////////////////////////////////////////////
+
+// UnmarshalBinary modifies the receiver so it must take a pointer receiver.
+func (msg *PartialImportResponseMessage) UnmarshalBinary(data []byte) error {
+	// A simple encoding: plain text.
+	b := bytes.NewBuffer(data)
+	_, err := fmt.Fscanln(b, &msg.BufLength, &msg.verbId, &msg.sequenceNo,
+		&msg.timestamp, &msg.requestId, &msg.dataOffset, &msg.authToken, &msg.sessionId, &msg.isUpdatable, &msg.resultStatus)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning PartialImportResponseMessage:UnmarshalBinary w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	return nil
+}
@@ -160,7 +160,8 @@ func DefaultAttributeDescriptor() *AttributeDescriptor {
 		Precision:   0,
 		Scale:       0,
 	}
-	newAttributeDescriptor.attributeId = atomic.AddInt64(&LocalAttributeId, 1)
+	// Locally created descriptors carry a negative id until commit assigns the real one
+	newAttributeDescriptor.attributeId = atomic.AddInt64(&LocalAttributeId, -1)
 	return &newAttributeDescriptor
 }
 
@@ -566,12 +567,20 @@ func (obj *AbstractAttribute) attributeToString() string {
 	buffer.WriteString("AbstractAttribute:{")
 	//buffer.WriteString(fmt.Sprintf("owner: %+v ", obj.owner))
 	buffer.WriteString(fmt.Sprintf("AttrDesc: %s", obj.AttrDesc.String()))
-	buffer.WriteString(fmt.Sprintf(", AttrValue: %+v", obj.AttrValue))
+	buffer.WriteString(fmt.Sprintf(", AttrValue: %+v", obj.redactedValue(obj.AttrValue)))
 	buffer.WriteString(fmt.Sprintf(", IsModified: %+v", obj.IsModified))
 	buffer.WriteString("}")
 	return buffer.String()
 }
 
+// redactedValue returns a value of the attribute as it may be shown, which is masked when the attribute is encrypted
+func (obj *AbstractAttribute) redactedValue(value interface{}) interface{} {
+	if obj.AttrDesc != nil && obj.AttrDesc.Is_Encrypted() {
+		return redactValue(value)
+	}
+	return value
+}
+
 /////////////////////////////////////////////////////////////////
 // Helper functions for AbstractAttribute
 /////////////////////////////////////////////////////////////////
@@ -713,7 +722,10 @@ func AbstractAttributeWriteExternal(obj tgdb.TGAttribute, os tgdb.TGOutputStream
 	if obj.IsNull() {
 		return nil
 	}
-	if obj.GetAttributeDescriptor().Is_Encrypted() {
+	// Blob and Clob values follow a header written by their own WriteValue, which also encrypts them
+	if obj.GetAttributeDescriptor().Is_Encrypted() &&
+		obj.GetAttributeDescriptor().GetAttrType() != AttributeTypeBlob &&
+		obj.GetAttributeDescriptor().GetAttrType() != AttributeTypeClob {
 		return AbstractAttributeWriteEncrypted(obj, os)
 	}
 	if logger.IsDebug() {
@@ -969,7 +981,7 @@ func (obj *BooleanAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 		return err
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("BooleanAttribute::ReadValue - read value: '%+v'", value))
+		logger.Debug(fmt.Sprintf("BooleanAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 	}
 	obj.AttrValue = value
 	return nil
@@ -1467,7 +1479,7 @@ func (obj *ByteAttribute) SetOwner(ownerEntity tgdb.TGEntity) {
 // If the object is Null, then the object is explicitly set, but no value is provided.
 func (obj *ByteAttribute) SetValue(value interface{}) tgdb.TGError {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering ByteAttribute::SetValue trying to set attribute value '%+v' of type '%+v'", value, reflect.TypeOf(value).Kind()))
+		logger.Debug(fmt.Sprintf("Entering ByteAttribute::SetValue trying to set attribute value '%+v' of type '%+v'", obj.redactedValue(value), reflect.TypeOf(value).Kind()))
 	}
 	if value == nil {
 		//errMsg := fmt.Sprintf("Attribute value is required")
@@ -1530,7 +1542,7 @@ func (obj *ByteAttribute) SetValue(value interface{}) tgdb.TGError {
 	} else {
 		v := uint8(reflect.ValueOf(value).Uint())
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning CharAttribute::SetValue - trying to set attribute value '%+v' of type '%+v'", v, reflect.TypeOf(v).Kind()))
+			logger.Debug(fmt.Sprintf("Returning CharAttribute::SetValue - trying to set attribute value '%+v' of type '%+v'", obj.redactedValue(v), reflect.TypeOf(v).Kind()))
 		}
 		obj.SetByte(v)
 	}
@@ -1546,7 +1558,7 @@ func (obj *ByteAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 		return err
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning ByteAttribute::ReadValue - read value: '%+v'", value))
+		logger.Debug(fmt.Sprintf("Returning ByteAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 	}
 	obj.AttrValue = value
 	return nil
@@ -1723,7 +1735,7 @@ func (obj *CharAttribute) SetOwner(ownerEntity tgdb.TGEntity) {
 // If the object is Null, then the object is explicitly set, but no value is provided.
 func (obj *CharAttribute) SetValue(value interface{}) tgdb.TGError {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering CharAttribute::SetValue trying to set attribute value '%+v' of type '%+v'", value, reflect.TypeOf(value).Kind()))
+		logger.Debug(fmt.Sprintf("Entering CharAttribute::SetValue trying to set attribute value '%+v' of type '%+v'", obj.redactedValue(value), reflect.TypeOf(value).Kind()))
 	}
 	if value == nil {
 		//errMsg := fmt.Sprintf("Attribute value is required")
@@ -1771,7 +1783,7 @@ func (obj *CharAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 		return err
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning CharAttribute::ReadValue - read value: '%+v'", value))
+		logger.Debug(fmt.Sprintf("Returning CharAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 	}
 	obj.AttrValue = value
 	return nil
@@ -1780,7 +1792,7 @@ func (obj *CharAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 // WriteValue writes the value to output stream
 func (obj *CharAttribute) WriteValue(os tgdb.TGOutputStream) tgdb.TGError {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering CharAttribute::WriteValue trying to write attribute value '%+v'", obj.AttrValue))
+		logger.Debug(fmt.Sprintf("Entering CharAttribute::WriteValue trying to write attribute value '%+v'", obj.redactedValue(obj.AttrValue)))
 	}
 	iValue := reflect.ValueOf(obj.AttrValue).Int()
 	os.(*ProtocolDataOutputStream).WriteChar(int(iValue))
@@ -1996,7 +2008,7 @@ func (obj *DoubleAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 			return err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("DoubleAttribute::ReadValue - read value: '%+v'", value))
+			logger.Debug(fmt.Sprintf("DoubleAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 		}
 		obj.AttrValue = value
 	}
@@ -2229,7 +2241,7 @@ func (obj *FloatAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 			return err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning FloatAttribute::ReadValue - read value: '%+v'", value))
+			logger.Debug(fmt.Sprintf("Returning FloatAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 		}
 		obj.AttrValue = value
 	}
@@ -2472,7 +2484,7 @@ func (obj *IntegerAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 			return err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning IntegerAttribute::ReadValue - read value: '%+v'", value))
+			logger.Debug(fmt.Sprintf("Returning IntegerAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 		}
 		obj.AttrValue = value
 	}
@@ -2719,7 +2731,7 @@ func (obj *LongAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 			return err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning LongAttribute::ReadValue - read value: '%+v'", value))
+			logger.Debug(fmt.Sprintf("Returning LongAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 		}
 		obj.AttrValue = value
 	}
@@ -2839,7 +2851,7 @@ func NewNumberAttributeWithDesc(ownerEntity tgdb.TGEntity, attrDesc *AttributeDe
 
 func (obj *NumberAttribute) SetDecimal(b TGDecimal, precision, scale int) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetDecimal about to set value '%+v' w/ Precision:Scale %d/%d", b, precision, scale))
+		logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetDecimal about to set value '%+v' w/ Precision:Scale %d/%d", obj.redactedValue(b), precision, scale))
 	}
 	if !obj.IsNull() && obj.AttrValue == b {
 		return
@@ -2848,7 +2860,7 @@ func (obj *NumberAttribute) SetDecimal(b TGDecimal, precision, scale int) {
 	obj.GetAttributeDescriptor().SetScale(int16(scale))
 	obj.AttrValue = b.String() //strings.Replace(b.String(), ".", "", -1)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetDecimal AttrValue is '%+v'", obj.AttrValue))
+		logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetDecimal AttrValue is '%+v'", obj.redactedValue(obj.AttrValue)))
 	}
 	obj.setIsModified(true)
 }
@@ -2901,7 +2913,7 @@ func (obj *NumberAttribute) SetOwner(ownerEntity tgdb.TGEntity) {
 // If the object is Null, then the object is explicitly set, but no value is provided.
 func (obj *NumberAttribute) SetValue(value interface{}) tgdb.TGError {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetValue about to set value '%+v' of kind '%+v'", value, reflect.TypeOf(value).Kind()))
+		logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetValue about to set value '%+v' of kind '%+v'", obj.redactedValue(value), reflect.TypeOf(value).Kind()))
 	}
 	if value == nil {
 		obj.AttrValue = value
@@ -2956,7 +2968,7 @@ func (obj *NumberAttribute) SetValue(value interface{}) tgdb.TGError {
 		v1 := value.(float64)
 		v2 := fmt.Sprintf("%f", v1)
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetValue - read v1: '%f' and v2: '%s'", v1, v2))
+			logger.Debug(fmt.Sprintf("Inside NumberAttribute::SetValue - read v1: '%+v' and v2: '%+v'", obj.redactedValue(v1), obj.redactedValue(v2)))
 		}
 		parts := strings.Split(v2, ".")
 		if len(parts) == 1 {
@@ -3018,7 +3030,7 @@ func (obj *NumberAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 		return err
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside NumberAttribute::ReadValue - read bdStr: '%+v'", bdStr))
+		logger.Debug(fmt.Sprintf("Inside NumberAttribute::ReadValue - read bdStr: '%+v'", obj.redactedValue(bdStr)))
 	}
 	obj.AttrValue, _ = NewTGDecimalFromString(bdStr)
 	return nil
@@ -3039,7 +3051,7 @@ func (obj *NumberAttribute) WriteValue(os tgdb.TGOutputStream) tgdb.TGError {
 	os.(*ProtocolDataOutputStream).WriteShort(int(obj.GetAttributeDescriptor().GetPrecision()))
 	os.(*ProtocolDataOutputStream).WriteShort(int(obj.GetAttributeDescriptor().GetScale()))
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside NumberAttribute::WriteValue AttrValue is '%+v'", obj.AttrValue))
+		logger.Debug(fmt.Sprintf("Inside NumberAttribute::WriteValue AttrValue is '%+v'", obj.redactedValue(obj.AttrValue)))
 	}
 	//dValue := reflect.ValueOf(obj.AttrValue).Float()
 	//strValue := strconv.FormatFloat(dValue, 'f', int(obj.GetAttributeDescriptor().GetPrecision()), 64)
@@ -3047,7 +3059,7 @@ func (obj *NumberAttribute) WriteValue(os tgdb.TGOutputStream) tgdb.TGError {
 	dValue := obj.AttrValue.(string)
 	newStr := dValue	//strings.Replace(dValue, ".", "", -1)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside NumberAttribute::WriteValue newStr is '%+v'", newStr))
+		logger.Debug(fmt.Sprintf("Inside NumberAttribute::WriteValue newStr is '%+v'", obj.redactedValue(newStr)))
 	}
 	return os.(*ProtocolDataOutputStream).WriteUTF(newStr)
 }
@@ -3271,7 +3283,7 @@ func (obj *ShortAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 			return err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("ShortAttribute::ReadValue - read value: '%+v'", value))
+			logger.Debug(fmt.Sprintf("ShortAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 		}
 		obj.AttrValue = value
 	}
@@ -3486,7 +3498,7 @@ func (obj *StringAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 			return err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("StringAttribute::ReadValue - read value: '%+v'", value))
+			logger.Debug(fmt.Sprintf("StringAttribute::ReadValue - read value: '%+v'", obj.redactedValue(value)))
 		}
 		obj.AttrValue = value
 	}
@@ -3666,7 +3678,12 @@ func (obj *TimestampAttribute) SetValue(value interface{}) tgdb.TGError {
 	}
 
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering TimestampAttribute:SetValue w/ Input value '%+v' is of type: '%+v'\n", value, reflect.TypeOf(value).Kind()))
+		logger.Debug(fmt.Sprintf("Entering TimestampAttribute:SetValue w/ Input value '%+v' is of type: '%+v'\n", obj.redactedValue(value), reflect.TypeOf(value).Kind()))
+	}
+	// Decrypted values come back as time.Time
+	if v, ok := value.(time.Time); ok {
+		obj.SetCalendar(v)
+		return nil
 	}
 	if reflect.TypeOf(value).Kind() != reflect.Int32 &&
 		reflect.TypeOf(value).Kind() != reflect.Int64 &&
@@ -3684,7 +3701,7 @@ func (obj *TimestampAttribute) SetValue(value interface{}) tgdb.TGError {
 			return GetErrorByType(TGErrorTypeCoercionNotSupported, INTERNAL_SERVER_ERROR, errMsg, "")
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside TimestampAttribute:SetValue - Transformed value '%+v' is of type: '%+v'\n", v, reflect.TypeOf(v).Kind()))
+			logger.Debug(fmt.Sprintf("Inside TimestampAttribute:SetValue - Transformed value '%+v' is of type: '%+v'\n", obj.redactedValue(v), reflect.TypeOf(v).Kind()))
 		}
 		obj.SetCalendar(v)
 		return nil
@@ -3692,7 +3709,7 @@ func (obj *TimestampAttribute) SetValue(value interface{}) tgdb.TGError {
 		reflect.TypeOf(value).Kind() == reflect.Int64 {
 		v := LongToCalendar(value.(int64))
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside TimestampAttribute:SetValue - Transformed value '%+v' is of type: '%+v'\n", v, reflect.TypeOf(v).Kind()))
+			logger.Debug(fmt.Sprintf("Inside TimestampAttribute:SetValue - Transformed value '%+v' is of type: '%+v'\n", obj.redactedValue(v), reflect.TypeOf(v).Kind()))
 		}
 		obj.SetCalendar(v)
 		return nil
@@ -3725,7 +3742,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 		return err
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read era: '%+v'", era))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read era: '%+v'", obj.redactedValue(era)))
 	}
 
 	yr, err := is.(*ProtocolDataInputStream).ReadShort()
@@ -3735,7 +3752,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	year = int(yr)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read year: '%+v'", year))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read year: '%+v'", obj.redactedValue(year)))
 	}
 
 	mth, err := is.(*ProtocolDataInputStream).ReadByte()
@@ -3745,7 +3762,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	mon = int(mth)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read mth: '%+v'", mth))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read mth: '%+v'", obj.redactedValue(mth)))
 	}
 
 	day, err := is.(*ProtocolDataInputStream).ReadByte()
@@ -3755,7 +3772,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	dom = int(day)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read dom: '%+v'", dom))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read dom: '%+v'", obj.redactedValue(dom)))
 	}
 
 	hour, err := is.(*ProtocolDataInputStream).ReadByte()
@@ -3765,7 +3782,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	hr = int(hour)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read hr: '%+v'", hr))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read hr: '%+v'", obj.redactedValue(hr)))
 	}
 
 	mts, err := is.(*ProtocolDataInputStream).ReadByte()
@@ -3775,7 +3792,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	min = int(mts)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read min: '%+v'", min))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read min: '%+v'", obj.redactedValue(min)))
 	}
 
 	secs, err := is.(*ProtocolDataInputStream).ReadByte()
@@ -3785,7 +3802,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	sec = int(secs)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read sec: '%+v'", sec))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read sec: '%+v'", obj.redactedValue(sec)))
 	}
 
 	mSec, err := is.(*ProtocolDataInputStream).ReadUnsignedShort()
@@ -3795,7 +3812,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	ms = int(mSec)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read ms: '%+v'", ms))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read ms: '%+v'", obj.redactedValue(ms)))
 	}
 
 	tz, err := is.(*ProtocolDataInputStream).ReadByte()
@@ -3805,7 +3822,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 	}
 	tzType = int(tz)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read tz: '%+v' and tzType: '%+v'", tz, tzType))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read tz: '%+v' and tzType: '%+v'", obj.redactedValue(tz), tzType))
 	}
 
 	if tzType != -1 && tzType != 255 {
@@ -3815,7 +3832,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 			return err
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read tzId: '%+v'", tzId))
+			logger.Debug(fmt.Sprintf("Inside TimestampAttribute::ReadValue - read tzId: '%+v'", obj.redactedValue(tzId)))
 		}
 	}
 
@@ -3837,7 +3854,7 @@ func (obj *TimestampAttribute) ReadValue(is tgdb.TGInputStream) tgdb.TGError {
 		return GetErrorByType(TGErrorIOException, "TGErrorIOException", errMsg, "")
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning TimestampAttribute::ReadValue - read v: '%+v'", v))
+		logger.Debug(fmt.Sprintf("Returning TimestampAttribute::ReadValue - read v: '%+v'", obj.redactedValue(v)))
 	}
 
 	//obj.AttrValue = v.In(loc)		// TODO: Revisit later to use this once location/zone information is available
@@ -3862,7 +3879,7 @@ func (obj *TimestampAttribute) WriteValue(os tgdb.TGOutputStream) tgdb.TGError {
 
 	era := true // Corresponding to GregorianCalendar.AD = 1
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::WriteValue - Object value '%+v' is of type: '%+v'\n", obj.GetValue(), reflect.TypeOf(obj.GetValue()).Kind()))
+		logger.Debug(fmt.Sprintf("Inside TimestampAttribute::WriteValue - Object value '%+v' is of type: '%+v'\n", obj.redactedValue(obj.GetValue()), reflect.TypeOf(obj.GetValue()).Kind()))
 	}
 	v := obj.GetValue().(time.Time)
 	yr, mth, day := v.Date()
@@ -4437,6 +4454,15 @@ func (obj *ClobAttribute) WriteValue(os tgdb.TGOutputStream) tgdb.TGError {
 		os.(*ProtocolDataOutputStream).WriteBoolean(false)
 	} else {
 		os.(*ProtocolDataOutputStream).WriteBoolean(true)
+		if obj.GetAttributeDescriptor().Is_Encrypted() {
+			err := AbstractAttributeWriteEncrypted(obj, os)
+			if err != nil {
+				logger.Error(fmt.Sprintf("ERROR: Returning ClobAttribute:WriteValue - Unable to AbstractAttributeWriteEncrypted() w/ Error: '%s'", err.Error()))
+				errMsg := "ClobAttribute::WriteValue - Unable to AbstractAttributeWriteEncrypted()"
+				return GetErrorByType(TGErrorIOException, "TGErrorIOException", errMsg, err.GetErrorDetails())
+			}
+			return nil
+		}
 		v, err := obj.getValueAsBytes()
 		if err != nil {
 			logger.Error(fmt.Sprintf("ERROR: Returning ClobAttribute:WriteValue - Unable to decode attribute value w/ Error: '%s'", err.Error()))
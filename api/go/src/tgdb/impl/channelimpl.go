@@ -23,16 +23,18 @@ package impl
 
 import (
 	"bytes"
+	"context"
 	"crypto"
 	"encoding/binary"
 
 	//	"crypto/aes"
 //	"crypto/cipher"
 	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
 	"crypto/x509"
-	"crypto/x509/pkix"
 	"encoding/gob"
+	"encoding/pem"
 	"fmt"
 //	"golang.org/x/crypto/blowfish"
 	"io/ioutil"
@@ -43,7 +45,6 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
-	"syscall"
 	"tgdb"
 	"time"
 )
@@ -436,7 +437,7 @@ func (obj *LinkUrl) GetUrlAsString() string {
 		}
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning LinkUrl:GetUrlAsString w/ URL string as '%s'", obj.urlStr))
+		logger.Debug(fmt.Sprintf("Returning LinkUrl:GetUrlAsString w/ URL string as '%s'", redactUrl(obj.urlStr)))
 	}
 	return obj.urlStr
 }
@@ -469,7 +470,7 @@ func (obj *LinkUrl) String() string {
 	buffer.WriteString(fmt.Sprintf(", IsIPv6: %+v", obj.isIPv6))
 	buffer.WriteString(fmt.Sprintf(", UrlPort: %d", obj.urlPort))
 	buffer.WriteString(fmt.Sprintf(", Protocol: %+v", obj.protocol))
-	buffer.WriteString(fmt.Sprintf(", UrlStr: %s", obj.urlStr))
+	buffer.WriteString(fmt.Sprintf(", UrlStr: %s", redactUrl(obj.urlStr)))
 	buffer.WriteString(fmt.Sprintf(", UrlProps: %+v", obj.urlProps))
 	buffer.WriteString(fmt.Sprintf(", UrlUser: %s", obj.urlUser))
 	buffer.WriteString("}")
@@ -520,27 +521,29 @@ var ConnectionsToChannel int32
 
 type AbstractChannel struct {
 	authToken         int64
-	channelLinkState  tgdb.LinkState
+	channelLinkState  int32 // A tgdb.LinkState - set by the sender and read by the reader, hence atomic
 	channelProperties *SortedProperties
 	channelUrl        *LinkUrl
 	clientId          string
 	connectionIndex   int
+	credentials       tgdb.TGCredentialProvider // Consulted each time the channel authenticates
 	cryptographer     tgdb.TGDataCryptoGrapher
 	inboxAddress      string
-	needsPing         bool
+	needsPing         int32 // 1 when the channel pings the server - set by the sender and the reader, hence atomic
 	numOfConnections  int32
 	lastActiveTime    time.Time
 	primaryUrl        *LinkUrl
 	reader            *ChannelReader
 	requestId         int64
 	responses         map[int64]tgdb.TGChannelResponse
+	responseLock      sync.Mutex    // guards responses, which requests from every connection sharing the channel update
 	sessionId         int64
 	exceptionLock     sync.Mutex    // reentrant-lock for synchronizing sending/receiving messages over the wire
 	exceptionCond     *sync.Cond    // Condition for lock
+	exceptionListener tgdb.TGChannelExceptionListener // Notified on failover / reconnect
 	sendLock          sync.Mutex    // reentrant-lock for synchronizing sending/receiving messages over the wire
 	tracer            tgdb.TGTracer // Used for tracing the information flow during the execution
 	user			string
-	pw				[]byte
 }
 
 func DefaultAbstractChannel() *AbstractChannel {
@@ -552,10 +555,10 @@ func DefaultAbstractChannel() *AbstractChannel {
 	newChannel := AbstractChannel{
 		authToken:        -1,
 		connectionIndex:  0,
-		needsPing:        false,
+		needsPing:        0,
 		numOfConnections: 0,
 		lastActiveTime:   time.Now(),
-		channelLinkState: tgdb.LinkNotConnected,
+		channelLinkState: int32(tgdb.LinkNotConnected),
 		channelUrl:       DefaultLinkUrl(),
 		primaryUrl:       DefaultLinkUrl(),
 		responses:        make(map[int64]tgdb.TGChannelResponse, 0),
@@ -579,7 +582,8 @@ func NewAbstractChannel(linkUrl *LinkUrl, props *SortedProperties) *AbstractChan
 		traceDir := newChannel.channelProperties.GetProperty(GetConfigFromKey(ConnectionTraceDir), ".")
 		clientId := newChannel.channelProperties.GetProperty(GetConfigFromKey(ChannelClientId), "")
 		newChannel.tracer = NewChannelTracer(clientId, traceDir)
-		//newChannel.tracer.Start()
+		// Start right away so that the handshake and authentication are captured too
+		newChannel.tracer.Start()
 	}
 	return newChannel
 }
@@ -629,20 +633,20 @@ func (obj *AbstractChannel) SetAuthToken (token int64) {
 func (obj *AbstractChannel) channelToString() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("AbstractChannel:{")
-	buffer.WriteString(fmt.Sprintf("AuthToken: %d", obj.authToken))
+	buffer.WriteString(fmt.Sprintf("AuthToken: %s", redactToken(obj.authToken)))
 	//buffer.WriteString(fmt.Sprintf(", ChannelProperties: %+v", obj.ChannelProperties))
 	buffer.WriteString(fmt.Sprintf(", ClientId: %s", obj.clientId))
 	buffer.WriteString(fmt.Sprintf(", ConnectionIndex: %d", obj.connectionIndex))
 	//buffer.WriteString(fmt.Sprintf(", DataCryptoGrapher: %+v", obj.cryptoGrapher))
 	buffer.WriteString(fmt.Sprintf(", InboxAddress: %s", obj.inboxAddress))
-	buffer.WriteString(fmt.Sprintf(", NeedsPing: %+v", obj.needsPing))
+	buffer.WriteString(fmt.Sprintf(", NeedsPing: %+v", atomic.LoadInt32(&obj.needsPing) == 1))
 	buffer.WriteString(fmt.Sprintf(", NumOfConnections: %d", obj.numOfConnections))
 	buffer.WriteString(fmt.Sprintf(", LastActiveTime: %+v", obj.lastActiveTime))
-	buffer.WriteString(fmt.Sprintf(", LinkState: %s", obj.channelLinkState.String()))
+	buffer.WriteString(fmt.Sprintf(", LinkState: %s", tgdb.LinkState(atomic.LoadInt32(&obj.channelLinkState)).String()))
 	buffer.WriteString(fmt.Sprintf(", ChannelUrl: %s", obj.channelUrl.String()))
 	buffer.WriteString(fmt.Sprintf(", PrimaryUrl: %s", obj.primaryUrl.String()))
 	buffer.WriteString(fmt.Sprintf(", RequestId: %d", obj.requestId))
-	buffer.WriteString(fmt.Sprintf(", Responses: %+v", obj.responses))
+	buffer.WriteString(fmt.Sprintf(", Responses: %+v", obj.GetResponses()))
 	buffer.WriteString(fmt.Sprintf(", SessionId: %d", obj.sessionId))
 	//buffer.WriteString(fmt.Sprintf(", Reader: %s", obj.GetReader().String()))
 	//buffer.WriteString(fmt.Sprintf(", Tracer: %s", obj.GetTracer().String()))
@@ -651,19 +655,34 @@ func (obj *AbstractChannel) channelToString() string {
 	return buffer.String()
 }
 
+// Deprecated: GetChannelPassword returns the password the channel's credential provider currently hands out
 func (obj *AbstractChannel) GetChannelPassword() []byte {
-	if len(obj.pw) > 0 {
-		return obj.pw
-	}
-	pwd := ""
-	if len(obj.channelProperties.GetAllProperties()) > 0 {
-		pwd = obj.channelProperties.GetProperty(GetConfigFromKey(ChannelPassword), "")
+	_, pwd, err := obj.getChannelCredentials(obj.credentials)
+	if err != nil {
+		return nil
 	}
-	return []byte(pwd)
+	return pwd
 }
 
+// Deprecated: SetChannelPassword makes the channel authenticate with a static credential provider of the password
 func (obj *AbstractChannel) SetChannelPassword(pword []byte) {
-	obj.pw = pword
+	obj.credentials = NewStaticCredentialProvider("", pword)
+}
+
+// getChannelCredentials asks the credential provider for the user name and password to authenticate with
+func (obj *AbstractChannel) getChannelCredentials(credentials tgdb.TGCredentialProvider) (string, []byte, tgdb.TGError) {
+	if credentials == nil {
+		return obj.GetChannelUserName(), nil, nil
+	}
+	user, pwd, err := credentials.GetCredentials()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning AbstractChannel:getChannelCredentials - credential provider failed w/ '%+v'", err.Error()))
+		return "", nil, err
+	}
+	if user == "" {
+		user = obj.GetChannelUserName()
+	}
+	return user, pwd, nil
 }
 
 func (obj *AbstractChannel) getDatabaseName() string {
@@ -690,7 +709,7 @@ func (obj *AbstractChannel) SetChannelUserName(uname string) {
 }
 
 func (obj *AbstractChannel) isChannelPingable() bool {
-	return obj.needsPing
+	return atomic.LoadInt32(&obj.needsPing) == 1
 }
 
 func (obj *AbstractChannel) setChannelAuthToken(authToken int64) {
@@ -778,7 +797,6 @@ func channelDisConnect(obj tgdb.TGChannel) tgdb.TGError {
 }
 
 func channelHandleException(obj tgdb.TGChannel, ex tgdb.TGError, bReconnect bool) *ExceptionHandleResult {
-	bReconnect = false
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AbstractChannel:channelHandleException w/ Error: '%+v' and Reconnect Flag: '%+v'", ex, bReconnect))
 	}
@@ -800,6 +818,14 @@ func channelHandleException(obj tgdb.TGChannel, ex tgdb.TGError, bReconnect bool
 		return RethrowException.ChannelException()
 	}
 
+	// Channel is being closed by the application - there is nothing to fail over
+	if obj.IsClosed() {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprint("Returning AbstractChannel:channelHandleException w/ DisconnectedException as channel is closed"))
+		}
+		return Disconnected.ChannelException()
+	}
+
 	connectionOpTimeout := obj.GetProperties().GetPropertyAsInt(GetConfigFromKey(ConnectionOperationTimeoutSeconds))
 
 	for {
@@ -813,11 +839,12 @@ func channelHandleException(obj tgdb.TGChannel, ex tgdb.TGError, bReconnect bool
 			break
 		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprint("Inside AbstractChannel:channelHandleException Infinite Loop about to obj.exceptionCond.Wait()"))
+			logger.Debug(fmt.Sprint("Inside AbstractChannel:channelHandleException Infinite Loop waiting for the reader to reconnect"))
 		}
-		//obj.GetExceptionCondition().Wait()
+		// Only the channel reader drives the reconnect - release the exception lock so that it can do so
+		obj.ExceptionUnlock()
 		time.Sleep(time.Duration(connectionOpTimeout) * time.Second)
-		//obj.GetExceptionCondition().Broadcast()
+		obj.ExceptionLock()
 		if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside AbstractChannel:channelHandleException Infinite Loop about to check isChannelConnected()"))
 		}
@@ -842,12 +869,19 @@ func channelHandleException(obj tgdb.TGChannel, ex tgdb.TGError, bReconnect bool
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelHandleException about to obj.channelReconnect()"))
 	}
+	listener := obj.GetExceptionListener()
 	if channelReconnect(obj) {
+		if listener != nil {
+			listener.OnReconnect(obj.GetChannelURL())
+		}
 		if logger.IsDebug() {
-			logger.Debug(fmt.Sprint("Returning AbstractChannel:channelHandleException w/ RetryOperation as failure in channelReconnect()"))
+			logger.Debug(fmt.Sprint("Returning AbstractChannel:channelHandleException w/ RetryOperation after successful channelReconnect()"))
 		}
 		return RetryOperation.ChannelException()
 	}
+	if listener != nil {
+		listener.OnException(ex, false)
+	}
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Returning AbstractChannel:channelHandleException w/ DisconnectedException for input exception: '%+v' and Reconnect Flag: '%+v'", ex, bReconnect))
 	}
@@ -860,8 +894,7 @@ func channelProcessMessage(obj tgdb.TGChannel, msg tgdb.TGMessage) tgdb.TGError
 		logger.Debug(fmt.Sprint("Entering AbstractChannel:channelProcessMessage"))
 	}
 	reqId := msg.GetRequestId()
-	channelResponseMap := obj.GetResponses()
-	channelResponse := channelResponseMap[reqId]
+	channelResponse := obj.GetResponse(reqId)
 
 	if channelResponse == nil {
 		errMsg := fmt.Sprintf("AbstractChannel:channelProcessMessage - Received no response message for corresponding request :%d", reqId)
@@ -885,11 +918,9 @@ func channelReconnect(obj tgdb.TGChannel) bool {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AbstractChannel:channelReconnect"))
 	}
-	cn1 := GetConfigFromKey(ChannelFTHosts)
-	ftHosts := obj.GetProperties().GetProperty(cn1, "")
-	if len(ftHosts) <= 0 {
-		logger.Warning(fmt.Sprint("WARNING: Returning AbstractChannel:channelReconnect - There are no FT host URLs configured for this channel"))
-		return false
+	// Even w/o any FT hosts configured, the primary URL is retried so that a server restart does not break the channel
+	if len(obj.GetPrimaryURL().GetFTUrls()) == 0 {
+		logger.Warning(fmt.Sprint("WARNING: Inside AbstractChannel:channelReconnect - There are no FT host URLs configured for this channel, retrying primary URL only"))
 	}
 
 	// This is needed here to avoid a FD leak
@@ -952,23 +983,14 @@ func channelRequestReply(obj tgdb.TGChannel, request tgdb.TGMessage) (tgdb.TGMes
 			err := obj.Send(request)
 			if err != nil {
 				logger.Error(fmt.Sprintf("ERROR: AbstractChannel:channelRequestReply obj.Send failed w/ '%+v'", err.Error()))
-				ehResult := channelHandleException(obj, err, true)
-				if ehResult.ExceptionType == RethrowException {
-					logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelRequestReply - Failed to send message"))
-					if err.GetErrorType() == TGErrorGeneralException {
-						return nil, NewTGGeneralExceptionWithMsg(err.Error())
-					}
-					errMsg := fmt.Sprintf("AbstractChannel:channelRequestReply - %s w/ error: %s", TGDB_SEND_ERROR, err.Error())
-					return nil, BuildException(tgdb.TGTransactionStatus(err.GetErrorType()), errMsg)
-				} else if ehResult.ExceptionType == Disconnected {
-					logger.Error(fmt.Sprint("Returning AbstractChannel:channelRequestReply - channel got disconnected"))
-					return nil, NewTGChannelDisconnected(err.GetErrorCode(), err.GetErrorType(), err.GetErrorMsg(), err.GetErrorDetails())
-				} else {
-					// TODO: Revisit later - Should we not throw an error?
-					logger.Warning(fmt.Sprintf("WARNING: Inside AbstractChannel:channelRequestReply in obj.Send retrying to send message on url: '%s'", obj.GetChannelURL().GetUrlAsString()))
-					//continue
-					return nil, nil
+				// Request-Reply is only used for handshake and authentication while (re)connecting.
+				// Let channelTryRepeatConnect decide whether to retry or move on to the next FT url.
+				logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelRequestReply - Failed to send message"))
+				if err.GetErrorType() == TGErrorGeneralException {
+					return nil, NewTGGeneralExceptionWithMsg(err.Error())
 				}
+				errMsg := fmt.Sprintf("AbstractChannel:channelRequestReply - %s w/ error: %s", TGDB_SEND_ERROR, err.Error())
+				return nil, GetErrorByType(err.GetErrorType(), err.GetErrorCode(), errMsg, err.GetErrorDetails())
 			}
 
 			if logger.IsDebug() {
@@ -978,23 +1000,14 @@ func channelRequestReply(obj tgdb.TGChannel, request tgdb.TGMessage) (tgdb.TGMes
 			msg, err := obj.ReadWireMsg()
 			if err != nil {
 				logger.Error(fmt.Sprintf("ERROR: AbstractChannel:channelRequestReply obj.ReadWireMsg failed w/ '%+v'", err.Error()))
-				ehResult := channelHandleException(obj, err, true)
-				if ehResult.ExceptionType == RethrowException {
-					logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelRequestReply - Failed to read message"))
-					if err.GetErrorType() == TGErrorGeneralException {
-						return nil, NewTGGeneralExceptionWithMsg(err.Error())
-					}
-					errMsg := fmt.Sprintf("AbstractChannel:channelRequestReply - %s w/ error: %s", TGDB_SEND_ERROR, err.Error())
-					return nil, BuildException(tgdb.TGTransactionStatus(err.GetErrorType()), errMsg)
-				} else if ehResult.ExceptionType == Disconnected {
-					logger.Error(fmt.Sprint("Returning AbstractChannel:channelRequestReply - channel got disconnected"))
-					return nil, NewTGChannelDisconnected(err.GetErrorCode(), err.GetErrorType(), err.GetErrorMsg(), err.GetErrorDetails())
-				} else {
-					// TODO: Revisit later - Should we not throw an error?
-					logger.Warning(fmt.Sprintf("WARNING: Inside AbstractChannel:channelRequestReply in obj.ReadWireMsg retrying to send message on url: '%s'", obj.GetChannelURL().GetUrlAsString()))
-					//continue
-					return nil, nil
+				// Request-Reply is only used for handshake and authentication while (re)connecting.
+				// Let channelTryRepeatConnect decide whether to retry or move on to the next FT url.
+				logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelRequestReply - Failed to read message"))
+				if err.GetErrorType() == TGErrorGeneralException {
+					return nil, NewTGGeneralExceptionWithMsg(err.Error())
 				}
+				errMsg := fmt.Sprintf("AbstractChannel:channelRequestReply - %s w/ error: %s", TGDB_SEND_ERROR, err.Error())
+				return nil, GetErrorByType(err.GetErrorType(), err.GetErrorCode(), errMsg, err.GetErrorDetails())
 			}
 
 			//obj.ChannelUnlock()
@@ -1047,14 +1060,18 @@ func channelSendMessage(obj tgdb.TGChannel, msg tgdb.TGMessage, resendFlag bool)
 		}
 		contFlag, err := func() (bool, tgdb.TGError) {
 			obj.ChannelLock()
-			defer obj.ChannelUnlock()
+			locked := true
+			defer func() {
+				if locked {
+					obj.ChannelUnlock()
+				}
+			} ()
 
 			if !isChannelConnected(obj) {
 				logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelSendMessage - channel is closed"))
 				errMsg := fmt.Sprint("AbstractChannel:channelSendMessage - channel is closed")
 				return false, GetErrorByType(TGErrorGeneralException, TGDB_CHANNEL_ERROR, errMsg, "")
 			}
-			obj.ChannelLock()
 
 			if logger.IsDebug() {
 				logger.Debug(fmt.Sprint("Inside AbstractChannel:channelSendMessage Infinite Loop about to obj.Send()"))
@@ -1063,27 +1080,30 @@ func channelSendMessage(obj tgdb.TGChannel, msg tgdb.TGMessage, resendFlag bool)
 			err := obj.Send(msg)
 			if err != nil {
 				logger.Error(fmt.Sprintf("ERROR: AbstractChannel:channelSendMessage obj.Send failed w/ '%+v'", err.Error()))
+				// Reconnect needs the channel lock for the handshake - do not hold it while waiting
+				obj.ChannelUnlock()
+				locked = false
 				ehResult := channelHandleException(obj, err, false)
-				if ehResult.ExceptionType == RethrowException {
+				if ehResult.ExceptionType == TGErrorGeneralException {
 					logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelSendMessage - Failed to send message"))
 					if err.GetErrorType() == TGErrorGeneralException {
 						return false, NewTGGeneralExceptionWithMsg(err.Error())
 					}
 					errMsg := fmt.Sprintf("AbstractChannel:channelSendMessage - %s w/ error: %s", TGDB_SEND_ERROR, err.Error())
-					return false, BuildException(tgdb.TGTransactionStatus(err.GetErrorType()), errMsg)
-				} else if ehResult.ExceptionType == Disconnected {
+					return false, GetErrorByType(err.GetErrorType(), err.GetErrorCode(), errMsg, err.GetErrorDetails())
+				} else if ehResult.ExceptionType == TGErrorChannelDisconnected {
 					logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelSendMessage - channel got disconnected"))
 					return false, NewTGChannelDisconnected(err.GetErrorCode(), err.GetErrorType(), err.GetErrorMsg(), err.GetErrorDetails())
 				} else {
 					// TODO: Revisit later - Should we not throw an error?
-					logger.Warning(fmt.Sprintf("WARNING: AbstractChannel:channelSendMessage Retrying to send message on url: '%s'", obj.GetChannelURL().GetUrlAsString()))
+					logger.Warning(fmt.Sprintf("WARNING: AbstractChannel:channelSendMessage Retrying to send message on url: '%s'", redactUrl(obj.GetChannelURL().GetUrlAsString())))
 					//continue
 					//return true, nil
 					count++
 					if count > 5 {
 						return false, nil
 					}
-
+					return true, nil
 				}
 			}
 			return false, nil
@@ -1104,12 +1124,52 @@ func channelSendMessage(obj tgdb.TGChannel, msg tgdb.TGMessage, resendFlag bool)
 	return error
 }
 
-func channelSendRequest(obj tgdb.TGChannel, msg tgdb.TGMessage, channelResponse tgdb.TGChannelResponse, resendFlag bool) (tgdb.TGMessage, tgdb.TGError) {
+var requestObservers struct {
+	sync.RWMutex
+	observers []tgdb.TGRequestObserver
+}
+
+// AddRequestObserver registers an observer that is notified of every request completed on any channel
+func AddRequestObserver(observer tgdb.TGRequestObserver) {
+	requestObservers.Lock()
+	defer requestObservers.Unlock()
+	requestObservers.observers = append(requestObservers.observers, observer)
+}
+
+// RemoveRequestObserver unregisters an observer added with AddRequestObserver
+func RemoveRequestObserver(observer tgdb.TGRequestObserver) {
+	requestObservers.Lock()
+	defer requestObservers.Unlock()
+	for i, o := range requestObservers.observers {
+		if o == observer {
+			requestObservers.observers = append(requestObservers.observers[:i:i], requestObservers.observers[i+1:]...)
+			return
+		}
+	}
+}
+
+func notifyRequestCompleted(msg tgdb.TGMessage, start time.Time, err tgdb.TGError) {
+	requestObservers.RLock()
+	observers := requestObservers.observers
+	requestObservers.RUnlock()
+	if len(observers) == 0 {
+		return
+	}
+	verb := GetVerb(msg.GetVerbId()).GetName()
+	latency := time.Since(start)
+	for _, observer := range observers {
+		observer.OnRequestCompleted(verb, latency, err)
+	}
+}
+
+func channelSendRequest(ctx context.Context, obj tgdb.TGChannel, msg tgdb.TGMessage, channelResponse tgdb.TGChannelResponse, resendFlag bool) (tgdb.TGMessage, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AbstractChannel:channelSendRequest w/ Message type: '%+v' ChannelResponse: '%+v'", msg.GetVerbId(), channelResponse))
 	}
 	reqId := channelResponse.GetRequestId()
 	msg.SetRequestId(reqId)
+	start := time.Now()
+	ctx, span := startRequestSpan(ctx, msg)
 
 	var respMessage tgdb.TGMessage
 	var resendMode tgdb.ResendMode
@@ -1122,23 +1182,36 @@ func channelSendRequest(obj tgdb.TGChannel, msg tgdb.TGMessage, channelResponse
 		logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelSendRequest using '%s'", resendMode.String()))
 	}
 
+	resend := false
+	resends := 0
+	maxResends := channelResendLimit(obj)
 	for {
 		if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelSendRequest Infinite Loop"))
 		}
 		resp, err := func() (tgdb.TGMessage, tgdb.TGError)  {
+			if ctx.Err() != nil {
+				return nil, channelContextError(ctx, fmt.Sprintf("AbstractChannel:channelSendRequest - request '%d' abandoned before it was sent", reqId))
+			}
 			obj.ChannelLock()
-			defer obj.ChannelUnlock()
+			locked := true
+			defer func() {
+				if locked {
+					obj.ChannelUnlock()
+				}
+			} ()
 
 			if !isChannelConnected(obj) {
 				errMsg := fmt.Sprintf("AbstractChannel:channelSendRequest - channel is closed")
 				logger.Error(fmt.Sprintf("ERROR: Returning %s", errMsg))
 				return nil, GetErrorByType(TGErrorGeneralException, TGDB_CHANNEL_ERROR, errMsg, "")
 			}
-			// TODO: Uncomment once Trace functionality is implemented and tested
-			//if obj.GetTracer() != nil {
-			//	obj.GetTracer().Trace(msg)
-			//}
+			if resend {
+				// The channel may have failed over and re-authenticated - pick up the new session before resending
+				msg.SetAuthToken(obj.GetAuthToken())
+				msg.SetSessionId(obj.GetSessionId())
+				channelResponse.Reset()
+			}
 			//obj.ChannelLock()
 			if logger.IsDebug() {
 				logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelSendRequest about to set channel response '%+v' in map '%+v'", channelResponse, obj.GetResponses()))
@@ -1155,21 +1228,24 @@ func channelSendRequest(obj tgdb.TGChannel, msg tgdb.TGMessage, channelResponse
 			}
 			if err != nil {
 				logger.Error(fmt.Sprintf("ERROR: AbstractChannel:channelSendRequest obj.Send failed w/ '%+v'", err.Error()))
-				//obj.ChannelUnlock()
+				obj.RemoveResponse(reqId)
+				// Reconnect needs the channel lock for the handshake - do not hold it while waiting
+				obj.ChannelUnlock()
+				locked = false
 				ehResult := channelHandleException(obj, err, false)
-				if ehResult.ExceptionType == RethrowException {
+				if ehResult.ExceptionType == TGErrorGeneralException {
 					logger.Error(fmt.Sprint("ERROR: Returning AbstractChannel:channelSendRequest - Failed to send message"))
 					if err.GetErrorType() == TGErrorGeneralException {
 						return nil, NewTGGeneralExceptionWithMsg(err.Error())
 					}
 					errMsg := fmt.Sprintf("AbstractChannel:channelSendRequest - %s w/ error: %s", TGDB_SEND_ERROR, err.Error())
-					return nil, BuildException(tgdb.TGTransactionStatus(err.GetErrorType()), errMsg)
-				} else if ehResult.ExceptionType == Disconnected {
+					return nil, GetErrorByType(err.GetErrorType(), err.GetErrorCode(), errMsg, err.GetErrorDetails())
+				} else if ehResult.ExceptionType == TGErrorChannelDisconnected {
 					logger.Error(fmt.Sprint("Returning AbstractChannel:channelSendRequest - channel got disconnected"))
 					return nil, NewTGChannelDisconnected(err.GetErrorCode(), err.GetErrorType(), err.GetErrorMsg(), err.GetErrorDetails())
 				} else {
 					// TODO: Revisit later - Should we not throw an error?
-					logger.Warning(fmt.Sprintf("WARNING: Inside AbstractChannel:channelSendRequest Infinite Loop retrying to send message on url: '%s'", obj.GetChannelURL().GetUrlAsString()))
+					logger.Warning(fmt.Sprintf("WARNING: Inside AbstractChannel:channelSendRequest Infinite Loop retrying to send message on url: '%s'", redactUrl(obj.GetChannelURL().GetUrlAsString())))
 					//continue
 					return nil, nil
 				}
@@ -1183,8 +1259,15 @@ func channelSendRequest(obj tgdb.TGChannel, msg tgdb.TGMessage, channelResponse
 			if logger.IsDebug() {
 				logger.Debug(fmt.Sprint("Inside AbstractChannel:channelSendRequest Infinite Loop about to channelResponse.Await()"))
 			}
-			channelResponse.Await(channelResponse.(*BlockingChannelResponse))
-			delete(obj.GetResponses(), reqId)
+			// Release the channel lock while waiting so that the reader can re-handshake on a failover
+			obj.ChannelUnlock()
+			locked = false
+			awaitErr := channelResponse.AwaitContext(ctx, channelResponse.(*BlockingChannelResponse))
+			obj.RemoveResponse(reqId)
+			if awaitErr != nil {
+				logger.Error(fmt.Sprintf("ERROR: Returning AbstractChannel:channelSendRequest - stopped waiting for request '%d' w/ error: '%s'", reqId, awaitErr.Error()))
+				return nil, awaitErr
+			}
 			if logger.IsDebug() {
 				logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelSendRequest Infinite Loop about to channelResponse.GetReply()"))
 			}
@@ -1194,22 +1277,39 @@ func channelSendRequest(obj tgdb.TGChannel, msg tgdb.TGMessage, channelResponse
 				//obj.ChannelUnlock()
 				exMsg := msgResponse.(*ExceptionMessage)
 				if exMsg.GetExceptionType() == TGErrorRetryIOException {
+					logger.Warning(fmt.Sprintf("WARNING: Inside AbstractChannel:channelSendRequest channel reconnected, resending message on url: '%s'", redactUrl(obj.GetChannelURL().GetUrlAsString())))
 					//continue
 					return nil, nil
 				}
 				logger.Error(fmt.Sprintf("ERROR: Returning AbstractChannel:channelSendRequest Breaking Loop for VerbExceptionMessage w/ msgRespbnse: '%+v'", msgResponse.String()))
+				if exMsg.GetExceptionType() == TGErrorChannelDisconnected {
+					return nil, NewTGChannelDisconnectedWithMsg(exMsg.GetExceptionMsg())
+				}
 				return nil, NewTGGeneralExceptionWithMsg(exMsg.GetExceptionMsg())
 			}
 			//obj.ChannelUnlock()
 			return msgResponse, nil
 		} ()
 		if resp == nil && err == nil {
+			resends++
+			if resends > maxResends {
+				// Every resend failed over again - leave it to the retry policy of the caller to back off and retry
+				errMsg := fmt.Sprintf("AbstractChannel:channelSendRequest - gave up on request '%d' after resending it %d times", reqId, maxResends)
+				logger.Error(fmt.Sprintf("ERROR: Returning %s", errMsg))
+				err = GetErrorByType(TGErrorRetryIOException, TGDB_SEND_ERROR, errMsg, "")
+				endRequestSpan(ctx, span, msg, err)
+				notifyRequestCompleted(msg, start, err)
+				return nil, err
+			}
+			resend = true
 			continue
 		} else if err != nil {
 			if err.GetErrorType() == TGSuccess {
 				respMessage = nil
 				break
 			}
+			endRequestSpan(ctx, span, msg, err)
+			notifyRequestCompleted(msg, start, err)
 			return nil, err
 		} else {
 			if logger.IsDebug() {
@@ -1222,9 +1322,30 @@ func channelSendRequest(obj tgdb.TGChannel, msg tgdb.TGMessage, channelResponse
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Returning AbstractChannel:channelSendRequest w/ %+v", respMessage))
 	}
+	endRequestSpan(ctx, span, msg, nil)
+	notifyRequestCompleted(msg, start, nil)
 	return respMessage, nil
 }
 
+// channelResendLimit returns how many times a request is resent after the channel failed over, which is
+// tgdb.channel.ftRetryCount
+func channelResendLimit(obj tgdb.TGChannel) int {
+	cn := GetConfigFromKey(ChannelFTRetryCount)
+	limit, err := strconv.Atoi(obj.GetProperties().GetProperty(cn, cn.GetDefaultValue()))
+	if err != nil || limit < 0 {
+		limit, _ = strconv.Atoi(cn.GetDefaultValue())
+	}
+	return limit
+}
+
+// channelContextError maps a done context to the typed TGError returned to the caller
+func channelContextError(ctx context.Context, errMsg string) tgdb.TGError {
+	if ctx.Err() == context.DeadlineExceeded {
+		return GetErrorByType(TGErrorConnectionTimeout, TGDB_CHANNEL_ERROR, errMsg, ctx.Err().Error())
+	}
+	return GetErrorByType(TGErrorOperationCancelled, TGDB_CHANNEL_ERROR, errMsg, ctx.Err().Error())
+}
+
 func channelStart(obj tgdb.TGChannel) tgdb.TGError {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Entering AbstractChannel:channelStart"))
@@ -1239,9 +1360,12 @@ func channelStart(obj tgdb.TGChannel) tgdb.TGError {
 		logger.Debug(fmt.Sprint("Inside AbstractChannel:channelStart about to start channel Reader"))
 	}
 	go obj.GetReader().Start()
-	// TODO: Uncomment once Trace functionality is implemented and tested
-	//logger.Debug(fmt.Sprint("Inside AbstractChannel:channelStart about to start channel Tracer"))
-	//go obj.GetTracer().Start()
+	if tracer := obj.GetTracer(); tracer != nil {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprint("Inside AbstractChannel:channelStart about to start channel Tracer"))
+		}
+		tracer.Start()
+	}
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Returning AbstractChannel:channelStart"))
 	}
@@ -1275,9 +1399,12 @@ func channelStop(obj tgdb.TGChannel, bForcefully bool) {
 			logger.Debug(fmt.Sprint("Inside AbstractChannel:channelStop about to stop channel Reader"))
 		}
 		obj.GetReader().Stop()
-		// TODO: Uncomment once Trace functionality is implemented and tested
-		//logger.Debug(fmt.Sprint("Inside AbstractChannel:channelStop about to stop channel Tracer"))
-		//obj.GetTracer().Stop()
+		if tracer := obj.GetTracer(); tracer != nil {
+			if logger.IsDebug() {
+				logger.Debug(fmt.Sprint("Inside AbstractChannel:channelStop about to stop channel Tracer"))
+			}
+			tracer.Stop()
+		}
 
 		if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside AbstractChannel:channelStop about to CreateMessageForVerb()"))
@@ -1337,6 +1464,19 @@ func channelTerminated(obj tgdb.TGChannel, killMsg string) {
 	return
 }
 
+// channelUrlsToTry returns the primary URL followed by its FT URLs, skipping any duplicates of the primary
+func channelUrlsToTry(obj tgdb.TGChannel) []tgdb.TGChannelUrl {
+	primaryUrl := obj.GetPrimaryURL()
+	urls := []tgdb.TGChannelUrl{primaryUrl}
+	for _, ftUrl := range primaryUrl.GetFTUrls() {
+		if ftUrl == nil || ftUrl.GetUrlAsString() == primaryUrl.GetUrlAsString() {
+			continue
+		}
+		urls = append(urls, ftUrl)
+	}
+	return urls
+}
+
 func channelTryRepeatConnect(obj tgdb.TGChannel, sleepOnFirstInvocation bool) tgdb.TGError {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Entering AbstractChannel:channelTryRepeatConnect"))
@@ -1347,25 +1487,31 @@ func channelTryRepeatConnect(obj tgdb.TGChannel, sleepOnFirstInvocation bool) tg
 	cn = GetConfigFromKey(ChannelFTRetryCount)
 	//logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect config for ChannelFTRetryCount is '%+v", cn))
 	retryCount := obj.GetProperties().GetPropertyAsInt(cn)
+	if retryCount <= 0 {
+		retryCount = 1
+	}
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect Trying to connnect %d times at interval of %d seconds to FTUrls", retryCount, connectInterval))
 	}
 
+	// When reconnecting, every attempt is spaced by the FT retry interval; the initial connect does not wait
+	reconnecting := sleepOnFirstInvocation
 	reconnected := false
-	ftUrls := obj.GetPrimaryURL().GetFTUrls()
-	urlCount := len(ftUrls)
+	urls := channelUrlsToTry(obj)
+	urlCount := len(urls)
 	index := obj.GetConnectionIndex()
+	if index < 0 || index >= urlCount {
+		index = 0
+	}
+	startIndex := index
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect current object's primary url '%s' has FTUrls as '%+v'", obj.GetPrimaryURL().GetUrlAsString(), ftUrls))
+		logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect current object's primary url '%s' has FTUrls as '%+v'", redactUrl(obj.GetPrimaryURL().GetUrlAsString()), obj.GetPrimaryURL().GetFTUrls()))
 	}
 
 	for {
-		if urlCount > 0 {
-			url := ftUrls[index]
-			obj.SetChannelURL(url.(*LinkUrl))
-		}
-		// From here onwards, object's primary Attributes will be used such as PrimaryUrl, LinkUrl etc.
-		urlStr := obj.GetPrimaryURL().GetUrlAsString()
+		obj.SetChannelURL(urls[index].(*LinkUrl))
+		// From here onwards, object's current channel URL will be used to create the socket
+		urlStr := redactUrl(obj.GetChannelURL().GetUrlAsString())
 		if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect Infinite Loop to create a socket for URL: '%s'", urlStr))
 		}
@@ -1374,7 +1520,7 @@ func channelTryRepeatConnect(obj tgdb.TGChannel, sleepOnFirstInvocation bool) tg
 			if logger.IsDebug() {
 				logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect Attempt:%d to connect to URL:%s", i, urlStr))
 			}
-			if sleepOnFirstInvocation {
+			if sleepOnFirstInvocation || (reconnecting && i > 0) {
 				time.Sleep(time.Duration(connectInterval) * time.Second)
 				sleepOnFirstInvocation = false
 			}
@@ -1394,7 +1540,7 @@ func channelTryRepeatConnect(obj tgdb.TGChannel, sleepOnFirstInvocation bool) tg
 			if logger.IsDebug() {
 				logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect about to OnConnect() on attempt:%d to URL:%s", i, urlStr))
 			}
-			// Execute Derived channel's method
+			// Execute Derived channel's method - this performs the handshake and (re)authenticates the session
 			err = obj.OnConnect()
 			if err != nil {
 				logger.Warning(fmt.Sprintf("WARNING: Inside AbstractChannel:channelTryRepeatConnect Failed to execute channel specific OnConnect w/ '%+v'", err.Error()))
@@ -1410,22 +1556,22 @@ func channelTryRepeatConnect(obj tgdb.TGChannel, sleepOnFirstInvocation bool) tg
 			break
 		} // End of for loop for Retry Attempts
 
-		if urlCount > 0 {
-			index = (index + 1) % urlCount
-		} else {
-			index += 1
+		if reconnected {
+			break
 		}
-
-		if index != obj.GetConnectionIndex() || reconnected {
+		index = (index + 1) % urlCount
+		if index == startIndex {
 			if logger.IsDebug() {
-				logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect breaking from Infinite Loop"))
+				logger.Debug(fmt.Sprintf("Inside AbstractChannel:channelTryRepeatConnect breaking from Infinite Loop after trying all the URLs"))
 			}
 			break
 		}
+		// Moving on to the next FT url - space it out like any other retry
+		sleepOnFirstInvocation = reconnecting
 	} // End of Outer Infinite For loop
 
 	if !reconnected {
-		errMsg := fmt.Sprintf("AbstractChannel:channelTryRepeatConnect %s - failed %d attempts to connect to TGDB Server.", "TGDB-CONNECT-ERR", retryCount)
+		errMsg := fmt.Sprintf("AbstractChannel:channelTryRepeatConnect %s - failed %d attempts to connect to each of the %d TGDB Server URLs.", "TGDB-CONNECT-ERR", retryCount, urlCount)
 		logger.Error(fmt.Sprintf("ERROR: Returning '%s'", errMsg))
 		return NewTGConnectionTimeoutWithMsg(errMsg)
 	}
@@ -1456,7 +1602,7 @@ func (obj *AbstractChannel) Connect() tgdb.TGError {
 
 // DisablePing disables the pinging ability to the channel
 func (obj *AbstractChannel) DisablePing() {
-	obj.needsPing = false
+	atomic.StoreInt32(&obj.needsPing, 0)
 }
 
 // Disconnect disconnects the channel from its URL end point
@@ -1466,7 +1612,7 @@ func (obj *AbstractChannel) Disconnect() tgdb.TGError {
 
 // EnablePing enables the pinging ability to the channel
 func (obj *AbstractChannel) EnablePing() {
-	obj.needsPing = true
+	atomic.StoreInt32(&obj.needsPing, 1)
 }
 
 // ExceptionLock locks the communication channel between TGDB client and server in case of business exceptions
@@ -1499,6 +1645,11 @@ func (obj *AbstractChannel) GetConnectionIndex() int {
 	return obj.connectionIndex
 }
 
+// GetCredentialProvider gets the provider of the credentials the channel authenticates with
+func (obj *AbstractChannel) GetCredentialProvider() tgdb.TGCredentialProvider {
+	return obj.credentials
+}
+
 // GetDataCryptoGrapher gets the data cryptographer handle
 func (obj *AbstractChannel) GetDataCryptoGrapher() tgdb.TGDataCryptoGrapher {
 	return obj.cryptographer
@@ -1509,9 +1660,14 @@ func (obj *AbstractChannel) GetExceptionCondition() *sync.Cond {
 	return obj.exceptionCond
 }
 
+// GetExceptionListener gets the listener notified of channel failures and reconnects
+func (obj *AbstractChannel) GetExceptionListener() tgdb.TGChannelExceptionListener {
+	return obj.exceptionListener
+}
+
 // GetLinkState gets the Link/channel State
 func (obj *AbstractChannel) GetLinkState() tgdb.LinkState {
-	return obj.channelLinkState
+	return tgdb.LinkState(atomic.LoadInt32(&obj.channelLinkState))
 }
 
 // GetNoOfConnections gets number of connections this channel has
@@ -1536,7 +1692,27 @@ func (obj *AbstractChannel) GetReader() tgdb.TGChannelReader {
 
 // GetResponses gets the channel Response Map
 func (obj *AbstractChannel) GetResponses() map[int64]tgdb.TGChannelResponse {
-	return obj.responses
+	obj.responseLock.Lock()
+	defer obj.responseLock.Unlock()
+	responses := make(map[int64]tgdb.TGChannelResponse, len(obj.responses))
+	for reqId, response := range obj.responses {
+		responses[reqId] = response
+	}
+	return responses
+}
+
+// GetResponse gets the Channel Response registered for a request, or nil
+func (obj *AbstractChannel) GetResponse(reqId int64) tgdb.TGChannelResponse {
+	obj.responseLock.Lock()
+	defer obj.responseLock.Unlock()
+	return obj.responses[reqId]
+}
+
+// RemoveResponse removes the Channel Response registered for a request
+func (obj *AbstractChannel) RemoveResponse(reqId int64) {
+	obj.responseLock.Lock()
+	defer obj.responseLock.Unlock()
+	delete(obj.responses, reqId)
 }
 
 // GetSessionId gets Session id
@@ -1551,7 +1727,7 @@ func (obj *AbstractChannel) GetTracer() tgdb.TGTracer {
 
 // IsChannelPingable checks whether the channel is pingable or not
 func (obj *AbstractChannel) IsChannelPingable() bool {
-	return obj.needsPing
+	return atomic.LoadInt32(&obj.needsPing) == 1
 }
 
 // IsClosed checks whether channel is open or closed
@@ -1566,12 +1742,17 @@ func (obj *AbstractChannel) SendMessage(msg tgdb.TGMessage) tgdb.TGError {
 
 // SendRequest sends a Message, waits for a response in the message format, and blocks the thread till it gets the response
 func (obj *AbstractChannel) SendRequest(msg tgdb.TGMessage, response tgdb.TGChannelResponse) (tgdb.TGMessage, tgdb.TGError) {
-	return channelSendRequest(obj, msg, response, true)
+	return channelSendRequest(context.Background(), obj, msg, response, true)
+}
+
+// SendRequestContext sends a Message and waits for its response until the context is cancelled or expires
+func (obj *AbstractChannel) SendRequestContext(ctx context.Context, msg tgdb.TGMessage, response tgdb.TGChannelResponse) (tgdb.TGMessage, tgdb.TGError) {
+	return channelSendRequest(ctx, obj, msg, response, true)
 }
 
 // SetChannelLinkState sets the Link/channel State
 func (obj *AbstractChannel) SetChannelLinkState(state tgdb.LinkState) {
-	obj.channelLinkState = state
+	atomic.StoreInt32(&obj.channelLinkState, int32(state))
 }
 
 // SetChannelURL sets the channel URL
@@ -1584,6 +1765,16 @@ func (obj *AbstractChannel) SetConnectionIndex(index int) {
 	obj.connectionIndex = index
 }
 
+// SetCredentialProvider sets the provider of the credentials the channel authenticates with
+func (obj *AbstractChannel) SetCredentialProvider(credentials tgdb.TGCredentialProvider) {
+	obj.credentials = credentials
+}
+
+// SetExceptionListener sets the listener notified of channel failures and reconnects
+func (obj *AbstractChannel) SetExceptionListener(listener tgdb.TGChannelExceptionListener) {
+	obj.exceptionListener = listener
+}
+
 // SetNoOfConnections sets number of connections
 func (obj *AbstractChannel) SetNoOfConnections(count int32) {
 	obj.numOfConnections = count
@@ -1591,6 +1782,8 @@ func (obj *AbstractChannel) SetNoOfConnections(count int32) {
 
 // SetResponse sets the ChannelResponse Map
 func (obj *AbstractChannel) SetResponse(reqId int64, response tgdb.TGChannelResponse) {
+	obj.responseLock.Lock()
+	defer obj.responseLock.Unlock()
 	obj.responses[reqId] = response
 }
 
@@ -1649,7 +1842,6 @@ type ChannelTracer struct {
 	msgQueue  *SimpleQueue
 	msgTracer *ChannelMessageTracer
 	clientId  string
-	isRunning bool
 }
 
 func DefaultChannelTracer() *ChannelTracer {
@@ -1659,9 +1851,8 @@ func DefaultChannelTracer() *ChannelTracer {
 	gob.Register(ChannelTracer{})
 
 	newChannelTracer := ChannelTracer{
-		msgQueue:  NewSimpleQueue(),
-		clientId:  "",
-		isRunning: false,
+		msgQueue: NewSimpleQueue(),
+		clientId: "",
 	}
 
 	return &newChannelTracer
@@ -1679,6 +1870,40 @@ func NewChannelTracer(client, traceDir string) *ChannelTracer {
 // Private functions for ChannelTracer
 /////////////////////////////////////////////////////////////////
 
+// channelTraceWire hands a PDU to the tracer of the channel, if the channel is traced
+func channelTraceWire(obj tgdb.TGChannel, direction WireDirection, pdu []byte) {
+	if tracer, ok := obj.GetTracer().(*ChannelTracer); ok && tracer != nil {
+		tracer.TraceWire(direction, pdu)
+	}
+}
+
+// wireCapturePdu returns the PDU of msg as it may be captured. Captures never hold credentials, so the password
+// of an authenticate request is zeroed in a copy of the PDU. Its length is kept, so that the PDU still decodes
+func wireCapturePdu(msg tgdb.TGMessage, pdu []byte) []byte {
+	auth, ok := msg.(*AuthenticateRequestMessage)
+	if !ok || len(auth.GetPassword()) == 0 {
+		return pdu
+	}
+	password := auth.GetPassword()
+	field := make([]byte, 4+len(password))
+	binary.BigEndian.PutUint32(field, uint32(len(password)))
+	copy(field[4:], password)
+
+	redacted := make([]byte, len(pdu))
+	copy(redacted, pdu)
+	for start := 0; ; {
+		i := bytes.Index(redacted[start:], field)
+		if i == -1 {
+			break
+		}
+		start += i + len(field)
+		for j := start - len(password); j < start; j++ {
+			redacted[j] = 0
+		}
+	}
+	return redacted
+}
+
 func (obj *ChannelTracer) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("ChannelTracer:{")
@@ -1689,18 +1914,23 @@ func (obj *ChannelTracer) String() string {
 	return buffer.String()
 }
 
+// TraceWire records a PDU as it went over the wire in the given direction
+func (obj *ChannelTracer) TraceWire(direction WireDirection, pdu []byte) {
+	if !obj.msgTracer.IsRunning() {
+		return
+	}
+	record := &WireCaptureRecord{Timestamp: time.Now(), Direction: direction, Pdu: make([]byte, len(pdu))}
+	copy(record.Pdu, pdu)
+	obj.msgQueue.Enqueue(record)
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions for TGTracer
 /////////////////////////////////////////////////////////////////
 
 // Start starts the channel tracer
 func (obj *ChannelTracer) Start() {
-	//logger.Log(fmt.Sprint("Entering ChannelTracer:Start ..."))
-	if !obj.isRunning {
-		obj.msgTracer.Start()
-		obj.isRunning = true
-	}
-	//logger.Log(fmt.Sprint("Returning ChannelTracer:Start ..."))
+	obj.msgTracer.Start()
 }
 
 // Stop stops the channel tracer
@@ -1708,11 +1938,8 @@ func (obj *ChannelTracer) Stop() {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Entering ChannelTracer:Stop ..."))
 	}
-	if obj.isRunning {
-		// Finish / Flush any remaining processing
-		obj.msgTracer.Stop()
-		obj.isRunning = false
-	}
+	// Finish / Flush any remaining processing
+	obj.msgTracer.Stop()
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Returning ChannelTracer:Stop ..."))
 	}
@@ -1720,17 +1947,27 @@ func (obj *ChannelTracer) Stop() {
 
 // Trace traces the path the message has taken
 func (obj *ChannelTracer) Trace(msg tgdb.TGMessage) {
-	//logger.Log(fmt.Sprint("Entering ChannelTracer:Trace"))
-	obj.msgQueue.Enqueue(msg)
-	//logger.Log(fmt.Sprintf("Returning ChannelTracer:Trace ..."))
+	msgBuf, msgLen, err := msg.ToBytes()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Inside ChannelTracer:Trace Error in msg.ToBytes() w/ '%+v'", err.Error()))
+		return
+	}
+	obj.TraceWire(WireSent, msgBuf[:msgLen])
 }
 
-
+// ChannelMessageTracer writes the PDUs queued by a ChannelTracer to rolling wire capture files
+// named <traceDir>/<clientId>.trace.<n>. See NewWireCaptureReader for reading them back.
 type ChannelMessageTracer struct {
 	currentSuffix int
 	traceFile     *os.File
+	capture       *WireCaptureWriter
+	writtenBytes  int64
 	isRunning     bool
+	lock          *sync.Mutex
+	stop          chan bool
+	stopped       chan bool
 	msgQueue      *SimpleQueue
+	clientId      string
 	traceFileName string
 }
 
@@ -1743,8 +1980,9 @@ func DefaultChannelMessageTracer() *ChannelMessageTracer {
 	gob.Register(ChannelMessageTracer{})
 
 	newChannelMessageTracer := ChannelMessageTracer{
-		currentSuffix: 0,
+		currentSuffix: -1,
 		isRunning:     false,
+		lock:          &sync.Mutex{},
 		msgQueue:      NewSimpleQueue(),
 		traceFileName: "",
 	}
@@ -1755,8 +1993,8 @@ func DefaultChannelMessageTracer() *ChannelMessageTracer {
 func NewChannelMessageTracer(queue *SimpleQueue, client, traceDir string) *ChannelMessageTracer {
 	newChannelMessageTracer := DefaultChannelMessageTracer()
 	newChannelMessageTracer.msgQueue = queue
+	newChannelMessageTracer.clientId = client
 	newChannelMessageTracer.traceFileName = filepath.FromSlash(fmt.Sprint(traceDir, "/", client, ".trace"))
-	newChannelMessageTracer.createTraceFile(0, newChannelMessageTracer.currentSuffix)
 	return newChannelMessageTracer
 }
 
@@ -1764,132 +2002,95 @@ func NewChannelMessageTracer(queue *SimpleQueue, client, traceDir string) *Chann
 // Private functions for ChannelMessageTracer
 /////////////////////////////////////////////////////////////////
 
-// exists returns whether the given file or directory exists, and whether it is a directory or not
-func exists(path string) (bool, bool, error) {
-	info, err := os.Stat(path)
-	if err != nil {
-		return false, false, err
-	}
-	if os.IsNotExist(err) {
-		return false, false, nil
-	}
-	if info.IsDir() {
-		return true, true, nil
-	}
-	return true, false, err
-}
-
 // isFileReadyForRollover checks if the file needs to be rolled over with incremented suffix
-func (obj *ChannelMessageTracer) isFileReadyForRollover(suffix, msgBufLen int) bool {
-	if obj == nil {
+func (obj *ChannelMessageTracer) isFileReadyForRollover(msgBufLen int) bool {
+	if obj == nil || obj.traceFile == nil {
 		return false
 	}
-
-	fileWithSuffix := fmt.Sprintf("%s%d", obj.traceFileName, suffix)
-	fileInfo, err := os.Stat(fileWithSuffix)
-	if err != nil {
-		return false
-	}
-
-	if fileInfo.Size()+int64(msgBufLen) < MaxFileSize {
-		return false
-	}
-	return true
+	return obj.writtenBytes+int64(msgBufLen) >= MaxFileSize
 }
 
-// createTraceFile creates a new trace file with new suffix
-func (obj *ChannelMessageTracer) createTraceFile(oldSuffix, newSuffix int) bool {
+// createTraceFile closes the current trace file, if any, and starts a new capture in the file with the next suffix
+func (obj *ChannelMessageTracer) createTraceFile() bool {
 	if obj == nil {
 		return false
 	}
+	obj.closeTraceFile()
 
-	// First check the existence of file with old suffix and close it for writing
-	traceFileWithOldSuffix := fmt.Sprintf("%s.%d", obj.traceFileName, oldSuffix)
-	oFlag, _, err := exists(traceFileWithOldSuffix)
-	if err != nil {
-		return false
-	}
-
-	if !oFlag {
-		return false
-	} else {
-		_ = obj.traceFile.Sync()  // Flush
-		_ = obj.traceFile.Close() // Close FD
-		obj.traceFile = nil
-	}
-
-	// Next check the existence of file with new suffix and open it for writing
+	newSuffix := obj.currentSuffix + 1
 	traceFileWithNewSuffix := fmt.Sprintf("%s.%d", obj.traceFileName, newSuffix)
-	nFlag, _, err := exists(traceFileWithNewSuffix)
+	fp, err := os.OpenFile(traceFileWithNewSuffix, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
 	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Inside ChannelMessageTracer:createTraceFile unable to create '%s' w/ '%+v'", traceFileWithNewSuffix, err.Error()))
 		return false
 	}
-
-	if nFlag {
-		return false
-	}
-
-	fp, err := os.OpenFile(traceFileWithNewSuffix, syscall.O_RDWR, 0644)
-	if err != nil {
+	capture, cErr := NewWireCaptureWriter(fp, obj.clientId)
+	if cErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Inside ChannelMessageTracer:createTraceFile unable to start the capture in '%s' w/ '%+v'", traceFileWithNewSuffix, cErr.Error()))
+		_ = fp.Close()
 		return false
 	}
 	obj.traceFile = fp
+	obj.capture = capture
+	obj.writtenBytes = 0
 	obj.currentSuffix = newSuffix
 	return true
 }
 
-// extractAndTraceMessage reads a message from the message queue and traces it in the trace file
-func (obj *ChannelMessageTracer) extractAndTraceMessage() {
-	if obj == nil {
+// closeTraceFile flushes and closes the current trace file
+func (obj *ChannelMessageTracer) closeTraceFile() {
+	if obj.traceFile == nil {
 		return
 	}
+	_ = obj.capture.Flush()
+	_ = obj.traceFile.Sync()  // Flush
+	_ = obj.traceFile.Close() // Close FD
+	obj.traceFile = nil
+	obj.capture = nil
+}
 
-	//logger.Log(fmt.Sprintf("Entering ChannelMessageTracer:extractAndTraceMessage w/ Message Tracer object: '%+v'", obj.String()))
+// extractAndTraceMessage writes the records from the message queue to the trace file until it is stopped and
+// the queue has been drained
+func (obj *ChannelMessageTracer) extractAndTraceMessage(stop, stopped chan bool) {
+	defer close(stopped)
+	stopping := false
 	for {
-		if !obj.isRunning {
-			if logger.IsDebug() {
-				logger.Debug(fmt.Sprintf("Breaking ChannelMessageTracer:extractAndTraceMessage loop since message tracer is not running '%+v'", obj.isRunning))
-			}
-			break
-		}
-
 		// At this point, the trace file with suffix is expected to be ready for writing contents in it
-		msg := obj.msgQueue.Dequeue()
-		if msg != nil {
-			msgBuf, msgLen, err := msg.(tgdb.TGMessage).ToBytes()
-			if err != nil {
-				logger.Error(fmt.Sprintf("ERROR: Inside ChannelMessageTracer:extractAndTraceMessage Error in msg.ToBytes() w/ '%+v'", err.Error()))
-				time.Sleep(1000 * time.Millisecond)
-				continue
+		record := obj.msgQueue.Dequeue()
+		if record == nil {
+			if obj.capture != nil {
+				_ = obj.capture.Flush()
 			}
-
-			// Check if the file is about to exceed the limit after appending current message buffer
-			if obj.isFileReadyForRollover(obj.currentSuffix, msgLen) {
-				// Create a new file with incremented suffix
-				cFlag := obj.createTraceFile(obj.currentSuffix, obj.currentSuffix+1)
-				if !cFlag {
-					logger.Error(fmt.Sprint("ERROR: Inside ChannelMessageTracer:extractAndTraceMessage Error in obj.createTraceFile()"))
-					time.Sleep(1000 * time.Millisecond)
-					continue
+			select {
+			case <-stop:
+				if stopping {
+					obj.closeTraceFile()
+					return
 				}
+				// Go round once more to write the records queued while waiting
+				stopping = true
+			case <-time.After(100 * time.Millisecond):
 			}
+			continue
+		}
+		wireRecord := record.(*WireCaptureRecord)
 
-			_, err1 := obj.traceFile.Write(msgBuf)
-			if err1 != nil {
-				logger.Error(fmt.Sprintf("ERROR: Inside ChannelMessageTracer:extractAndTraceMessage Error in obj.traceFile.Write() w/ '%+v'", err1.Error()))
-				time.Sleep(1000 * time.Millisecond)
+		// Check if the file is about to exceed the limit after appending current message buffer
+		if obj.isFileReadyForRollover(len(wireRecord.Pdu)) || obj.traceFile == nil {
+			// Create a new file with incremented suffix
+			if !obj.createTraceFile() {
+				logger.Error(fmt.Sprint("ERROR: Inside ChannelMessageTracer:extractAndTraceMessage Error in obj.createTraceFile() - dropping the record"))
 				continue
 			}
-		} else {
-			if logger.IsDebug() {
-				logger.Debug(fmt.Sprint("Inside ChannelMessageTracer:extractAndTraceMessage - No pending messages in the queue"))
-			}
-			time.Sleep(1000 * time.Millisecond)
+		}
+
+		err := obj.capture.WriteRecord(wireRecord)
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Inside ChannelMessageTracer:extractAndTraceMessage Error in obj.capture.WriteRecord() w/ '%+v'", err.Error()))
 			continue
 		}
+		obj.writtenBytes += int64(13 + len(wireRecord.Pdu))
 	} // End of Infinite Loop
-	obj.isRunning = false
-	//logger.Log(fmt.Sprintf("Returning ChannelMessageTracer:extractAndTraceMessage w/ Message Tracer object: '%+v'", obj.String()))
 }
 
 func (obj *ChannelMessageTracer) String() string {
@@ -1901,36 +2102,39 @@ func (obj *ChannelMessageTracer) String() string {
 	return buffer.String()
 }
 
+// IsRunning reports whether the channel message tracer has been started and not stopped since
+func (obj *ChannelMessageTracer) IsRunning() bool {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.isRunning
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions for TGTracer
 /////////////////////////////////////////////////////////////////
 
-// Start starts the channel message tracer
+// Start starts writing the queued records to a new trace file
 func (obj *ChannelMessageTracer) Start() {
-	//logger.Log(fmt.Sprint("Entering ChannelMessageTracer:Start ..."))
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	if !obj.isRunning {
 		obj.isRunning = true
-		// Start reading and processing messages from the wire
-		obj.extractAndTraceMessage()
+		obj.stop = make(chan bool)
+		obj.stopped = make(chan bool)
+		go obj.extractAndTraceMessage(obj.stop, obj.stopped)
 	}
-	//logger.Log(fmt.Sprint("Returning ChannelMessageTracer:Start ..."))
 }
 
-// Stop stops the channel message tracer
+// Stop writes the remaining queued records, closes the trace file and stops the channel message tracer
 func (obj *ChannelMessageTracer) Stop() {
-	//logger.Log(fmt.Sprint("Entering ChannelMessageTracer:Stop ..."))
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	if obj.isRunning {
 		// Finish / Flush any remaining processing
-		traceFileWithSuffix := fmt.Sprintf("%s.%d", obj.traceFileName, obj.currentSuffix)
-		flag, _, _ := exists(traceFileWithSuffix)
-		if flag {
-			_ = obj.traceFile.Sync()  // Flush
-			_ = obj.traceFile.Close() // Close FD
-			obj.traceFile = nil
-		}
+		close(obj.stop)
+		<-obj.stopped
 		obj.isRunning = false
 	}
-	//logger.Log(fmt.Sprint("Returning ChannelMessageTracer:Stop ..."))
 }
 
 
@@ -1941,6 +2145,7 @@ type BlockingChannelResponse struct {
 	reply     tgdb.TGMessage
 	lock      sync.Mutex // reentrant-lock for synchronizing sending/receiving messages over the wire
 	cond      *sync.Cond // Condition for lock
+	done      chan struct{} // Closed once the status is no longer Waiting
 }
 
 func DefaultBlockingChannelResponse(reqId int64) *BlockingChannelResponse {
@@ -1953,6 +2158,7 @@ func DefaultBlockingChannelResponse(reqId int64) *BlockingChannelResponse {
 		requestId: reqId,
 		status:    tgdb.Waiting,
 		timeout:   -1,
+		done:      make(chan struct{}),
 	}
 	newBlockingChannelResponse.cond = sync.NewCond(&newBlockingChannelResponse.lock) // Condition for lock
 
@@ -1969,50 +2175,62 @@ func NewBlockingChannelResponse(reqId, rTimeout int64) *BlockingChannelResponse
 // Private functions for BlockingChannelResponse
 /////////////////////////////////////////////////////////////////
 
+// getDone returns the channel that is closed once the current request is answered or given up
+func (obj *BlockingChannelResponse) getDone() chan struct{} {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.done
+}
+
+// closeDone wakes up the waiters, and must be called with the lock held
+func (obj *BlockingChannelResponse) closeDone() {
+	select {
+	case <-obj.done:
+	default:
+		close(obj.done)
+	}
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions for TGChannelResponse
 /////////////////////////////////////////////////////////////////
 
 // Await waits (loops) till the channel response receives reply message from the server
 func (obj *BlockingChannelResponse) Await(tester tgdb.StatusTester) {
+	_ = obj.AwaitContext(context.Background(), tester)
+}
+
+// AwaitContext waits till the channel response receives reply message from the server, or the context is done
+func (obj *BlockingChannelResponse) AwaitContext(ctx context.Context, tester tgdb.StatusTester) tgdb.TGError {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering BlockingChannelResponse:Await - %d", obj.status))
+		logger.Debug(fmt.Sprintf("Entering BlockingChannelResponse:AwaitContext - %d", obj.GetStatus()))
 	}
-	//obj.lock.Lock()
-	//defer obj.lock.Unlock()
-	//defer func() {
-	//	obj.cond.Signal()
-	//	obj.lock.Unlock()
-	//} ()
-
-	//go func() {
-	count := 0
 	for {
-		//obj.cond.Wait()
-		time.Sleep(time.Duration(obj.timeout) * time.Millisecond)
-		//obj.cond.Signal()
+		// Take the channel before testing the status, so that a reply set in between is not missed
+		done := obj.getDone()
 		// Terminating Condition for this Infinite Loop is:
 		// 	(a) Break if the channel response object status is NOT WAITING - Status is set via SetReply()/Signal() execution
-		//logger.Log(fmt.Sprintf("Inside BlockingChannelResponse:Await Loop) - abou to Test obj.status - %d", obj.status))
-		if !tester.Test(obj.status) {
+		if !tester.Test(obj.GetStatus()) {
 			if logger.IsDebug() {
-				logger.Debug(fmt.Sprintf("Breaking out from BlockingChannelResponse:Await w/ contents as '%+v'", obj.String()))
+				logger.Debug(fmt.Sprintf("Breaking out from BlockingChannelResponse:AwaitContext w/ contents as '%+v'", obj.String()))
 			}
 			break
 		}
-		// TODO: Remove this block once testing is over
-		count++
-		if (count%10000) == 0 {
-			if logger.IsDebug() {
-				logger.Debug(fmt.Sprintf("Inside BlockingChannelResponse:Await(%d) ... BlockingChannelResponse - %d", count, obj.status))
-			}
+		select {
+		case <-ctx.Done():
+			// Nobody is waiting for the reply any more - a late reply from the server will simply be dropped
+			obj.Signal(tgdb.Closed)
+			errMsg := fmt.Sprintf("BlockingChannelResponse:AwaitContext - gave up waiting for reply to request '%d'", obj.requestId)
+			logger.Warning(fmt.Sprintf("WARNING: Returning %s w/ '%s'", errMsg, ctx.Err().Error()))
+			return channelContextError(ctx, errMsg)
+		case <-done:
 		}
 	}
-	//}()
 
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning BlockingChannelResponse:Await ..."))
+		logger.Debug(fmt.Sprintf("Returning BlockingChannelResponse:AwaitContext ..."))
 	}
+	return nil
 }
 
 // GetCallback gets a Callback object
@@ -2054,6 +2272,7 @@ func (obj *BlockingChannelResponse) Reset() {
 	//logger.Log(fmt.Sprint("Entering BlockingChannelResponse:Reset ..."))
 	obj.status = tgdb.Waiting
 	obj.reply = nil
+	obj.done = make(chan struct{})
 	//logger.Log(fmt.Sprint("Returning BlockingChannelResponse:Reset ..."))
 }
 
@@ -2065,6 +2284,7 @@ func (obj *BlockingChannelResponse) SetReply(msg tgdb.TGMessage) {
 	obj.reply = msg
 	obj.status = tgdb.Ok
 	obj.cond.Broadcast()
+	obj.closeDone()
 	//logger.Log(fmt.Sprintf("Returning BlockingChannelResponse:SetReply %d", obj.status))
 }
 
@@ -2084,6 +2304,9 @@ func (obj *BlockingChannelResponse) Signal(cStatus tgdb.ChannelResponseStatus) {
 	//logger.Log(fmt.Sprint("Entering BlockingChannelResponse:Signal ..."))
 	obj.status = cStatus
 	obj.cond.Broadcast()
+	if cStatus != tgdb.Waiting {
+		obj.closeDone()
+	}
 	//logger.Log(fmt.Sprint("Returning BlockingChannelResponse:Signal ..."))
 }
 
@@ -2118,7 +2341,7 @@ var gReaders int64
 
 type ChannelReader struct {
 	channel   tgdb.TGChannel
-	isRunning bool
+	isRunning int32 // 1 while the reader loop runs - Stop is called from other go routines, hence atomic
 	name      string
 	readerNum int64
 }
@@ -2130,7 +2353,7 @@ func DefaultChannelReader() *ChannelReader {
 	gob.Register(ChannelReader{})
 
 	newChannelReader := ChannelReader{
-		isRunning: false,
+		isRunning: 0,
 		readerNum: atomic.AddInt64(&gReaders, 1),
 	}
 
@@ -2148,6 +2371,11 @@ func NewChannelReader(rChannel tgdb.TGChannel) *ChannelReader {
 // Helper functions for ChannelReader
 /////////////////////////////////////////////////////////////////
 
+// running reports whether the reader loop is to keep reading
+func (obj *ChannelReader) running() bool {
+	return atomic.LoadInt32(&obj.isRunning) == 1
+}
+
 // readAndProcessLoop reads a message from the network and processes it
 func (obj *ChannelReader) readAndProcessLoop() {
 	if obj == nil {
@@ -2167,9 +2395,9 @@ func (obj *ChannelReader) readAndProcessLoop() {
 		// 	(c) Continue if the message on the wire is ANYTHING else after Processing the message
 		// 	(d) Continue - in case of ERROR - if the exceptionResult is ANYTHING OTHER THAN RetryOperation after setting the reply on channelResponse
 
-		if !obj.isRunning {
+		if !obj.running() {
 			if logger.IsDebug() {
-				logger.Debug(fmt.Sprintf("WARNING: Breaking ChannelReader:readAndProcessLoop loop since reader is not running '%+v'", obj.isRunning))
+				logger.Debug(fmt.Sprintf("WARNING: Breaking ChannelReader:readAndProcessLoop loop since reader is not running '%+v'", obj.running()))
 			}
 			break
 		}
@@ -2185,8 +2413,8 @@ func (obj *ChannelReader) readAndProcessLoop() {
 		msg, err := obj.channel.ReadWireMsg()
 		if err != nil {
 			logger.Error(fmt.Sprintf("ERROR: Inside ChannelReader:readAndProcessLoop obj.channel.ReadWireMsg failed w/ '%+v'", err.Error()))
-			if !obj.isRunning {
-				logger.Info(fmt.Sprintf("INFO: Breaking ChannelReader:readAndProcessLoop reader is not running (2) '%+v'", obj.isRunning))
+			if !obj.running() {
+				logger.Info(fmt.Sprintf("INFO: Breaking ChannelReader:readAndProcessLoop reader is not running (2) '%+v'", obj.running()))
 				break
 			}
 			exceptionResult := channelHandleException(obj.channel, err, true)
@@ -2197,7 +2425,7 @@ func (obj *ChannelReader) readAndProcessLoop() {
 				}
 				resp.SetReply(NewExceptionMessageWithType(int(exceptionResult.ExceptionType), exceptionResult.ExceptionMessage))
 			}
-			if exceptionResult.ExceptionType != RetryOperation {
+			if exceptionResult.ExceptionType != TGErrorRetryIOException {
 				logger.Error(fmt.Sprintf("ERROR: Breaking ChannelReader:readAndProcessLoop loop since Reader thread returned w/o Retrying due to error - exceptionResult '%+v'", exceptionResult))
 				break
 			}
@@ -2233,7 +2461,7 @@ func (obj *ChannelReader) readAndProcessLoop() {
 				logger.Debug(fmt.Sprintf("Breaking ChannelReader:readAndProcessLoop loop w/ Forceful Termination Message is '%+v'", msg.String()))
 			}
 			channelTerminated(obj.channel, msg.(*SessionForcefullyTerminatedMessage).GetKillString())
-			obj.isRunning = false
+			atomic.StoreInt32(&obj.isRunning, 0)
 			break
 		}
 
@@ -2243,8 +2471,8 @@ func (obj *ChannelReader) readAndProcessLoop() {
 		err = channelProcessMessage(obj.channel, msg)
 		if err != nil {
 			logger.Error(fmt.Sprintf("ERROR: Inside ChannelReader:readAndProcessLoop channelProcessMessage() failed w/ '%+v'", err.Error()))
-			if !obj.isRunning {
-				logger.Info(fmt.Sprintf("INFO: Inside ChannelReader:readAndProcessLoop reader is not running (3) '%+v'", obj.isRunning))
+			if !obj.running() {
+				logger.Info(fmt.Sprintf("INFO: Inside ChannelReader:readAndProcessLoop reader is not running (3) '%+v'", obj.running()))
 				break
 			}
 			exceptionResult := channelHandleException(obj.channel, err, true)
@@ -2255,7 +2483,7 @@ func (obj *ChannelReader) readAndProcessLoop() {
 				}
 				resp.SetReply(NewExceptionMessageWithType(int(exceptionResult.ExceptionType), exceptionResult.ExceptionMessage))
 			}
-			if exceptionResult.ExceptionType != RetryOperation {
+			if exceptionResult.ExceptionType != TGErrorRetryIOException {
 				logger.Error(fmt.Sprintf("ERROR: Breaking ChannelReader:readAndProcessLoop loop since Reader thread returned w/o Retrying due to error (2) - exceptionResult '%+v'", exceptionResult))
 				break
 			}
@@ -2268,7 +2496,7 @@ func (obj *ChannelReader) readAndProcessLoop() {
 			break
 		}
 	} // End of Infinite Loop
-	obj.isRunning = false
+	atomic.StoreInt32(&obj.isRunning, 0)
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Returning ChannelReader:readAndProcessLoop w/ Reader object: '%+v'", obj.String()))
 	}
@@ -2281,8 +2509,7 @@ func (obj *ChannelReader) readAndProcessLoop() {
 // Start starts the channel reader
 func (obj *ChannelReader) Start() {
 	//logger.Log(fmt.Sprint("Entering ChannelReader:Start ..."))
-	if !obj.isRunning {
-		obj.isRunning = true
+	if atomic.CompareAndSwapInt32(&obj.isRunning, 0, 1) {
 		// Start reading and processing messages from the wire
 		//obj.readAndProcessLoop()
 		go obj.readAndProcessLoop()
@@ -2293,11 +2520,11 @@ func (obj *ChannelReader) Start() {
 // Stop stops the channel reader
 func (obj *ChannelReader) Stop() {
 	//logger.Log(fmt.Sprint("Entering ChannelReader:Stop ..."))
-	if obj.isRunning {
+	if obj.running() {
 		// Finish / Flush any remaining processing
 		//obj.readAndProcessLoop()
 		go obj.readAndProcessLoop()
-		obj.isRunning = false
+		atomic.StoreInt32(&obj.isRunning, 0)
 	}
 	//logger.Log(fmt.Sprint("Returning ChannelReader:Stop ..."))
 }
@@ -2306,7 +2533,7 @@ func (obj *ChannelReader) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("ChannelReader:{")
 	buffer.WriteString(fmt.Sprintf("Name: %+v", obj.name))
-	buffer.WriteString(fmt.Sprintf(", IsRunning: %+v", obj.isRunning))
+	buffer.WriteString(fmt.Sprintf(", IsRunning: %+v", obj.running()))
 	buffer.WriteString(fmt.Sprintf(", ReaderNum: %d", obj.readerNum))
 	buffer.WriteString(fmt.Sprintf(", Channel: %s", obj.channel.String()))
 	buffer.WriteString("}")
@@ -2314,11 +2541,12 @@ func (obj *ChannelReader) String() string {
 }
 
 
+// DataCryptoGrapher encrypts attribute values with the public key of the certificate the server sends
+// during authentication, and decodes the values the server returns for encrypted attributes
 type DataCryptoGrapher struct {
-	sessionId      int64
-	remoteCert     *x509.Certificate
-	pubKey         crypto.PublicKey
-	//algoParameters *pkix.AlgorithmIdentifier
+	sessionId  int64
+	remoteCert *x509.Certificate
+	pubKey     crypto.PublicKey
 }
 
 func DefaultDataCryptoGrapher() *DataCryptoGrapher {
@@ -2334,40 +2562,36 @@ func DefaultDataCryptoGrapher() *DataCryptoGrapher {
 	return &newChannelUrl
 }
 
+// NewDataCryptoGrapher parses the server certificate (DER or PEM) received in the authenticate response.
+// A server that does not send a certificate yields a cryptographer that can only decrypt.
 func NewDataCryptoGrapher(sessionId int64, serverCertBytes []byte) (*DataCryptoGrapher, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering NewDataCryptoGrapher() w/ serverCertBytes as '%+v'", serverCertBytes))
+		logger.Debug(fmt.Sprintf("Entering NewDataCryptoGrapher() w/ '%d' bytes of server certificate", len(serverCertBytes)))
 	}
 	newCryptoGrapher := DefaultDataCryptoGrapher()
 	newCryptoGrapher.sessionId = sessionId
+	if len(serverCertBytes) == 0 {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprint("Returning NewDataCryptoGrapher() - server did not send a certificate, encryption is unavailable"))
+		}
+		return newCryptoGrapher, nil
+	}
 
-	// TODO: Uncomment once DataCryptoGrapher is implemented
-	//logger.Log(fmt.Sprintf("Inside NewDataCryptoGrapher - about to x509.ParseCertificate(()"))
-	//cert, err := x509.ParseCertificate(serverCertBytes)
-	//if err != nil {
-	//	errMsg := fmt.Sprint("NewDataCryptoGrapher -- Unable to parse CERTIFICATE from the certificate buffer")
-	//	return nil, exception.GetErrorByType(types.TGErrorIOException, types.INTERNAL_SERVER_ERROR, errMsg, err.Error())
-	//}
-	//newCryptoGrapher.remoteCert = cert
-	//logger.Log(fmt.Sprintf("Inside NewDataCryptoGrapher - parsed certificate as'%+v'", cert))
-	//
-	//logger.Log(fmt.Sprint("Inside NewDataCryptoGrapher - about to x509.ParsePKIXPublicKey()"))
-	//pubKey, err := x509.ParsePKIXPublicKey(serverCertBytes)
-	//if err != nil {
-	//	errMsg := fmt.Sprint("NewDataCryptoGrapher -- Unable to parse PUBLIC KEY from the certificate buffer")
-	//	return nil, exception.GetErrorByType(types.TGErrorIOException, types.INTERNAL_SERVER_ERROR, errMsg, err.Error())
-	//}
-	//newCryptoGrapher.pubKey = pubKey
-	//logger.Log(fmt.Sprintf("Inside NewDataCryptoGrapher - parsed public key as'%+v'", pubKey))
-
-	/**
-	algoParams, err1 := getAlgorithmParameters(newCryptoGrapher.pubKey)
-	if err1 != nil {
+	certBytes := serverCertBytes
+	if block, _ := pem.Decode(serverCertBytes); block != nil {
+		certBytes = block.Bytes
+	}
+	cert, err := x509.ParseCertificate(certBytes)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning NewDataCryptoGrapher - unable to parse server certificate w/ '%+v'", err.Error()))
 		errMsg := fmt.Sprint("NewDataCryptoGrapher -- Unable to parse CERTIFICATE from the certificate buffer")
-		return nil, exception.GetErrorByType(types.TGErrorIOException, types.INTERNAL_SERVER_ERROR, errMsg, err1.Error())
+		return nil, GetErrorByType(TGErrorSecurityException, INTERNAL_SERVER_ERROR, errMsg, err.Error())
+	}
+	newCryptoGrapher.remoteCert = cert
+	newCryptoGrapher.pubKey = cert.PublicKey
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning NewDataCryptoGrapher() w/ server certificate '%s' and '%s' public key", cert.Subject.String(), cert.PublicKeyAlgorithm.String()))
 	}
-	newCryptoGrapher.algoParameters = algoParams
-	*/
 	return newCryptoGrapher, nil
 }
 
@@ -2379,181 +2603,102 @@ func (obj *DataCryptoGrapher) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("DataCryptoGrapher:{")
 	buffer.WriteString(fmt.Sprintf("SessionId: %d", obj.sessionId))
-	buffer.WriteString(fmt.Sprintf(", RemoteCert: %+v", obj.remoteCert))
-	buffer.WriteString(fmt.Sprintf(", PubKey: %+v", obj.pubKey))
-	//buffer.WriteString(fmt.Sprintf(", AlgoParameters: %d", obj.algoParameters))
+	if obj.remoteCert != nil {
+		buffer.WriteString(fmt.Sprintf(", RemoteCert: %s", obj.remoteCert.Subject.String()))
+		buffer.WriteString(fmt.Sprintf(", PubKeyAlgorithm: %s", obj.remoteCert.PublicKeyAlgorithm.String()))
+	}
 	buffer.WriteString("}")
 	return buffer.String()
 }
 
-/////////////////////////////////////////////////////////////////
-// Private functions for DataCryptoGrapher
-/////////////////////////////////////////////////////////////////
-
-func getAlgorithmParameters(pubKey crypto.PublicKey) (*pkix.AlgorithmIdentifier, tgdb.TGError) {
-	// TODO: Uncomment once DataCryptoGrapher is implemented
-	/**
-	if (publicKey == null) return null;
-
-	if (publicKey instanceof DSAPublicKey) {
-		AlgorithmParameters algparams = AlgorithmParameters.getInstance(publicKey.getAlgorithm());
-		DSAPublicKey dsakey = (DSAPublicKey) publicKey;
-		DSAParameterSpec dsaParams = (DSAParameterSpec) dsakey.getParams();
-		algparams.init(dsaParams);
-		return algparams;
-	}
-
-	if (publicKey instanceof ECPublicKey) {
-		AlgorithmParameters algparams = AlgorithmParameters.getInstance(publicKey.getAlgorithm());
-		ECPublicKey eckey = (ECPublicKey) publicKey;
-		ECParameterSpec ecParams = (ECParameterSpec) eckey.getParams();
-		algparams.init(ecParams);
-		return algparams;
-	}
-
-	if (publicKey instanceof DHPublicKey) {
-		AlgorithmParameters algparams = AlgorithmParameters.getInstance(publicKey.getAlgorithm());
-		DHPublicKey dhkey = (DHPublicKey) publicKey;
-		DHParameterSpec dhParams = (DHParameterSpec) dhkey.getParams();
-		algparams.init(dhParams);
-		return algparams;
-	}
-
-	return null;  //RSA doesn't have
-	*/
-	// No-op for Now!
-	return nil, nil
-}
-
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGDataCryptoGrapher
 /////////////////////////////////////////////////////////////////
 
-// Decrypt decrypts the buffer
-//func (obj *DataCryptoGrapher) Decrypt(encBuffer []byte) ([]byte, types.TGError) {
+// Decrypt decodes an encrypted attribute value as sent by the server: a random 64-bit mask and the data
+// length, followed by the data XOR-ed with the mask one long at a time and any trailing bytes in clear. The
+// server reads those longs from its data in little-endian order, so they are written back the same way
 func (obj *DataCryptoGrapher) Decrypt(is tgdb.TGInputStream) ([]byte, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Entering DataCryptoGrapher:Decrypt()"))
 	}
 	out := DefaultProtocolDataOutputStream()
-	buf := make([]byte, 0)
 
-	rand, err := is.(*ProtocolDataInputStream).ReadLong()
+	mask, err := is.(*ProtocolDataInputStream).ReadLong()
 	if err != nil {
-		logger.Error(fmt.Sprint("ERROR: Returning DataCryptoGrapher:Decrypt w/ Error in reading rand from message buffer"))
+		logger.Error(fmt.Sprint("ERROR: Returning DataCryptoGrapher:Decrypt w/ Error in reading mask from message buffer"))
 		return nil, err
 	}
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside DataCryptoGrapher:Decrypt read resultId as '%+v'", rand))
-	}
 
-	len, err := is.(*ProtocolDataInputStream).ReadLong()
+	dataLen, err := is.(*ProtocolDataInputStream).ReadLong()
 	if err != nil {
 		logger.Error(fmt.Sprint("ERROR: Returning DataCryptoGrapher:Decrypt w/ Error in reading len from message buffer"))
 		return nil, err
 	}
+	if dataLen < 0 {
+		logger.Error(fmt.Sprintf("ERROR: Returning DataCryptoGrapher:Decrypt - invalid encrypted data length '%d'", dataLen))
+		errMsg := fmt.Sprintf("Invalid encrypted data length '%d'", dataLen)
+		return nil, GetErrorByType(TGErrorSecurityException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside DataCryptoGrapher:Decrypt read resultId as '%+v'", len))
+		logger.Debug(fmt.Sprintf("Inside DataCryptoGrapher:Decrypt read encrypted data length as '%d'", dataLen))
 	}
 
-	cnt := len / 8
-	rem := len % 8
+	cnt := dataLen / 8
+	rem := dataLen % 8
 
-	for i:=0; i<int(cnt); i++ {
+	for i := 0; i < int(cnt); i++ {
 		val, err := is.(*ProtocolDataInputStream).ReadLong()
 		if err != nil {
 			logger.Error(fmt.Sprint("ERROR: Returning DataCryptoGrapher:Decrypt w/ Error in reading val from message buffer"))
 			return nil, err
 		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside DataCryptoGrapher:Decrypt read resultId as '%+v'", val))
-		}
-
-		org := val ^ rand
-		_ = out.WriteLongAsBytes(org)
+		_ = out.WriteLongAsBytes(val ^ mask)
 	}
 
-	for i:=0; i<int(rem); i++ {
+	for i := 0; i < int(rem); i++ {
 		val, err := is.(*ProtocolDataInputStream).ReadByte()
 		if err != nil {
 			logger.Error(fmt.Sprint("ERROR: Returning DataCryptoGrapher:Decrypt w/ Error in reading val from message buffer"))
 			return nil, err
 		}
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside DataCryptoGrapher:Decrypt read resultId as '%+v'", val))
-		}
-
 		out.WriteByte(int(val))
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning DataCryptoGrapher:Decrypt() w/ decrypted buffer as '%+v'", buf))
+		logger.Debug(fmt.Sprintf("Returning DataCryptoGrapher:Decrypt() w/ '%d' decrypted bytes", out.GetLength()))
 	}
 	return out.ToByteArray()
 }
 
-// Encrypt encrypts the buffer
+// Encrypt encrypts the buffer with the server's RSA public key using PKCS #1 v1.5 padding,
+// so the buffer can be at most the key size in bytes less 11
 func (obj *DataCryptoGrapher) Encrypt(rawBuf []byte) ([]byte, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering DataCryptoGrapher:Encrypt() w/ raw buffer as '%+v'", rawBuf))
-	}
-	// TODO: Uncomment once DataCryptoGrapher is implemented
-	/**
-	try {
-		Cipher cipher = Cipher.getInstance(publicKey.getAlgorithm());
-		cipher.init(Cipher.ENCRYPT_MODE, publicKey, algparams);
-		return cipher.doFinal(data);
+		logger.Debug(fmt.Sprintf("Entering DataCryptoGrapher:Encrypt() w/ '%d' bytes of raw buffer", len(rawBuf)))
 	}
-	catch (Exception e) {
-		throw new TGException(e);
+	if obj.pubKey == nil {
+		logger.Error(fmt.Sprint("ERROR: Returning DataCryptoGrapher:Encrypt - server did not provide a certificate for encryption"))
+		errMsg := fmt.Sprint("Unable to encrypt data - server did not provide a certificate for encryption")
+		return nil, GetErrorByType(TGErrorSecurityException, INTERNAL_SERVER_ERROR, errMsg, "")
 	}
-
-	block, err := blowfish.NewCipher(key)
-	if err != nil {
-		panic(err.Error())
+	rsaKey, ok := obj.pubKey.(*rsa.PublicKey)
+	if !ok {
+		logger.Error(fmt.Sprintf("ERROR: Returning DataCryptoGrapher:Encrypt - unsupported public key algorithm '%s'", obj.remoteCert.PublicKeyAlgorithm.String()))
+		errMsg := fmt.Sprintf("Unable to encrypt data with a '%s' public key, only RSA is supported", obj.remoteCert.PublicKeyAlgorithm.String())
+		return nil, GetErrorByType(TGErrorSecurityException, INTERNAL_SERVER_ERROR, errMsg, "")
 	}
-	mode := ecb.NewECBEncrypter(block)
-	padder := padding.NewPkcs5Padding()
-	pt, err = padder.Pad(pt) // padd last block of plaintext if block size less than block cipher size
+	encryptedBuf, err := rsa.EncryptPKCS1v15(rand.Reader, rsaKey, rawBuf)
 	if err != nil {
-		panic(err.Error())
+		logger.Error(fmt.Sprintf("ERROR: Returning DataCryptoGrapher:Encrypt - unable to encrypt '%d' bytes w/ '%+v'", len(rawBuf), err.Error()))
+		errMsg := fmt.Sprintf("Unable to encrypt '%d' bytes of data w/ a '%d' bit RSA key", len(rawBuf), rsaKey.Size()*8)
+		return nil, GetErrorByType(TGErrorSecurityException, INTERNAL_SERVER_ERROR, errMsg, err.Error())
 	}
-	ct := make([]byte, len(pt))
-	mode.CryptBlocks(ct, pt)
-	return ct
-	
-
-	block, err := blowfish.NewCipher(obj.remoteCert.RawSubjectPublicKeyInfo)
-	if err != nil {
-		return nil, GetErrorByType(TGErrorSecurityException, INTERNAL_SERVER_ERROR, err.Error(), "")
-	}
-	encryptedBuf := make([]byte, aes.BlockSize+len(rawBuf))
-	iv := encryptedBuf[:aes.BlockSize]
-	mode := cipher.NewCBCEncrypter(block, iv)
-	mode.CryptBlocks(encryptedBuf[aes.BlockSize:], rawBuf)
-	fmt.Printf("%x\n", encryptedBuf)
-	
-	block := blowfish.NewCipher(obj.remoteCert.RawSubjectPublicKeyInfo)
-	encryptedBuf := make([]byte, aes.BlockSize+len(decBuffer))
-	iv := encryptedBuf[:aes.BlockSize]
-
-	algo := obj.remoteCert.PublicKeyAlgorithm
-	switch algo {
-	case x509.RSA:
-	case x509.DSA:
-		block, err := des.NewCipher(rawBuf)
-		if err != nil {
-			return nil, exception.GetErrorByType(types.TGErrorSecurityException, types.INTERNAL_SERVER_ERROR, err.Error(), "")
-		}
-		mode := cipher.NewCBCEncrypter(block, iv)
-		mode.CryptBlocks(encryptedBuf[aes.BlockSize:], rawBuf)
-	case x509.ECDSA:
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning DataCryptoGrapher:Encrypt() w/ '%d' encrypted bytes", len(encryptedBuf)))
 	}
-	*/
-	//logger.Log(fmt.Sprintf("Returning DataCryptoGrapher:Decrypt() w/ encrypted buffer as '%+v'", encryptedBuf))
-	return nil, nil
+	return encryptedBuf, nil
 }
 
-
 const (
 	dataBufferSize = 32 * 1024 // 32 KB
 )
@@ -2601,7 +2746,8 @@ func NewTCPChannel(linkUrl *LinkUrl, props *SortedProperties) *TCPChannel {
 /////////////////////////////////////////////////////////////////
 
 
-func (obj *TCPChannel) DoAuthenticateForRESTConsumer() tgdb.TGError {
+// DoAuthenticateForRESTConsumer authenticates a REST gateway user on this channel with the given credentials
+func (obj *TCPChannel) DoAuthenticateForRESTConsumer(credentials tgdb.TGCredentialProvider) tgdb.TGError {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("======> Entering TCPChannel:DoAuthenticateForRESTConsumer"))
 	}
@@ -2616,10 +2762,14 @@ func (obj *TCPChannel) DoAuthenticateForRESTConsumer() tgdb.TGError {
 
 	msgRequest.(*AuthenticateRequestMessage).SetClientId(obj.clientId)
 	msgRequest.(*AuthenticateRequestMessage).SetInboxAddr(obj.inboxAddress)
-	msgRequest.(*AuthenticateRequestMessage).SetUserName(obj.GetChannelUserName())
-	msgRequest.(*AuthenticateRequestMessage).SetPassword(obj.GetChannelPassword())
+	user, pwd, err := obj.getChannelCredentials(credentials)
+	if err != nil {
+		return err
+	}
+	msgRequest.(*AuthenticateRequestMessage).SetUserName(user)
+	msgRequest.(*AuthenticateRequestMessage).SetPassword(pwd)
 	msgRequest.(*AuthenticateRequestMessage).SetDatabaseName(obj.getDatabaseName())
-	msgResponse, err := channelSendRequest(obj, msgRequest, channelResponse, true)
+	msgResponse, err := channelSendRequest(context.Background(), obj, msgRequest, channelResponse, true)
 	if err != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TCPChannel::DoAuthenticateForRESTConsumer channelSendRequest failed w/ '%+v'", err.Error()))
 		return err
@@ -2639,7 +2789,7 @@ func (obj *TCPChannel) DoAuthenticateForRESTConsumer() tgdb.TGError {
 	}
 	obj.setDataCryptoGrapher(cryptoDataGrapher)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("======> Returning TCPChannel:DoAuthenticateForRESTConsumer Successfully authenticated for user: '%s'", obj.GetChannelUserName()))
+		logger.Debug(fmt.Sprintf("======> Returning TCPChannel:DoAuthenticateForRESTConsumer Successfully authenticated for user: '%s'", user))
 	}
 	return nil
 }
@@ -2658,8 +2808,12 @@ func (obj *TCPChannel) DoAuthenticate() tgdb.TGError {
 
 	msgRequest.(*AuthenticateRequestMessage).SetClientId(obj.clientId)
 	msgRequest.(*AuthenticateRequestMessage).SetInboxAddr(obj.inboxAddress)
-	msgRequest.(*AuthenticateRequestMessage).SetUserName(obj.GetChannelUserName())
-	msgRequest.(*AuthenticateRequestMessage).SetPassword(obj.GetChannelPassword())
+	user, pwd, err := obj.getChannelCredentials(obj.credentials)
+	if err != nil {
+		return err
+	}
+	msgRequest.(*AuthenticateRequestMessage).SetUserName(user)
+	msgRequest.(*AuthenticateRequestMessage).SetPassword(pwd)
 	msgRequest.(*AuthenticateRequestMessage).SetDatabaseName(obj.getDatabaseName())
 
 	//logger.Debug(fmt.Sprintf("======> Inside TCPChannel:doAuthenticate about to request reply for request '%+v'", msgRequest.String()))
@@ -2684,7 +2838,7 @@ func (obj *TCPChannel) DoAuthenticate() tgdb.TGError {
 	}
 	obj.setDataCryptoGrapher(cryptoDataGrapher)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("======> Returning TCPChannel:doAuthenticate Successfully authenticated for user: '%s'", obj.GetChannelUserName()))
+		logger.Debug(fmt.Sprintf("======> Returning TCPChannel:doAuthenticate Successfully authenticated for user: '%s'", user))
 	}
 	return nil
 }
@@ -2723,14 +2877,14 @@ func (obj *TCPChannel) performHandshake(sslMode bool) tgdb.TGError {
 			errMsg := msgResponse.(*SessionForcefullyTerminatedMessage).GetKillString()
 			return NewTGChannelDisconnectedWithMsg(errMsg)
 		}
-		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 
 	response := msgResponse.(*HandShakeResponseMessage)
 	if response.GetResponseStatus() != ResponseAcceptChallenge {
 		logger.Error(fmt.Sprint("ERROR: Returning TCPChannel::performHandshake response.GetResponseStatus() is NOT ResponseAcceptChallenge"))
-		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 
@@ -2764,14 +2918,14 @@ func (obj *TCPChannel) performHandshake(sslMode bool) tgdb.TGError {
 			errMsg := msgResponse.(*SessionForcefullyTerminatedMessage).GetKillString()
 			return NewTGChannelDisconnectedWithMsg(errMsg)
 		}
-		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 
 	response = msgResponse.(*HandShakeResponseMessage)
 	if response.GetResponseStatus() != ResponseProceedWithAuthentication {
 		logger.Error(fmt.Sprint("ERROR: Returning TCPChannel::performHandshake response.GetResponseStatus() is NOT ResponseAcceptChallenge"))
-		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 	if logger.IsDebug() {
@@ -2927,6 +3081,9 @@ func (obj *TCPChannel) writeToWire(msg tgdb.TGMessage) tgdb.TGError {
 		//return exception.GetErrorByType(types.TGErrorIOException, "TGErrorProtocolNotSupported", errMsg, err.GetErrorMsg())
 		return err
 	}
+	// Keep the size on the wire for GetMessageByteBufLength
+	msg.SetMessageByteBufLength(bufLen)
+	channelTraceWire(obj, WireSent, wireCapturePdu(msg, msgBytes[0:bufLen]))
 
 	// Clear timeout deadlines set at the time of creation of the socket
 	sErr := obj.socket.SetDeadline(time.Time{})
@@ -2946,7 +3103,7 @@ func (obj *TCPChannel) writeToWire(msg tgdb.TGMessage) tgdb.TGError {
 	//}
 
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("======> Inside TCPChannel:writeToWire about to write message bytes on the socket as '%+v'", msgBytes[0:bufLen]))
+		logger.Debug(fmt.Sprintf("======> Inside TCPChannel:writeToWire about to write message bytes on the socket as '%+v'", redactBytes(msgBytes[0:bufLen])))
 	}
 	// Put the data packet on the socket for network transmission
 	_, sErr = obj.socket.Write(msgBytes[0:bufLen])
@@ -2956,7 +3113,7 @@ func (obj *TCPChannel) writeToWire(msg tgdb.TGMessage) tgdb.TGError {
 		return GetErrorByType(TGErrorIOException, "TGErrorProtocolNotSupported", errMsg, sErr.Error())
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("======> Returning TCPChannel:writeToWire successfully wrote message bytes on the socket as '%+v'", msgBytes[0:bufLen]))
+		logger.Debug(fmt.Sprintf("======> Returning TCPChannel:writeToWire successfully wrote message bytes on the socket as '%+v'", redactBytes(msgBytes[0:bufLen])))
 	}
 	return nil
 }
@@ -3006,7 +3163,7 @@ func (obj *TCPChannel) Connect() tgdb.TGError {
 
 // DisablePing disables the pinging ability to the channel
 func (obj *TCPChannel) DisablePing() {
-	obj.needsPing = false
+	atomic.StoreInt32(&obj.needsPing, 0)
 }
 
 // Disconnect disconnects the channel from its URL end point
@@ -3016,7 +3173,7 @@ func (obj *TCPChannel) Disconnect() tgdb.TGError {
 
 // EnablePing enables the pinging ability to the channel
 func (obj *TCPChannel) EnablePing() {
-	obj.needsPing = true
+	atomic.StoreInt32(&obj.needsPing, 1)
 }
 
 // ExceptionLock locks the communication channel between TGDB client and server in case of business exceptions
@@ -3054,9 +3211,14 @@ func (obj *TCPChannel) GetExceptionCondition() *sync.Cond {
 	return obj.exceptionCond
 }
 
+// GetExceptionListener gets the listener notified of channel failures and reconnects
+func (obj *TCPChannel) GetExceptionListener() tgdb.TGChannelExceptionListener {
+	return obj.exceptionListener
+}
+
 // GetLinkState gets the Link/channel State
 func (obj *TCPChannel) GetLinkState() tgdb.LinkState {
-	return obj.channelLinkState
+	return tgdb.LinkState(atomic.LoadInt32(&obj.channelLinkState))
 }
 
 // GetNoOfConnections gets number of connections this channel has
@@ -3081,7 +3243,7 @@ func (obj *TCPChannel) GetReader() tgdb.TGChannelReader {
 
 // GetResponses gets the channel Response Map
 func (obj *TCPChannel) GetResponses() map[int64]tgdb.TGChannelResponse {
-	return obj.responses
+	return obj.AbstractChannel.GetResponses()
 }
 
 // GetSessionId gets Session id
@@ -3096,7 +3258,7 @@ func (obj *TCPChannel) GetTracer() tgdb.TGTracer {
 
 // IsChannelPingable checks whether the channel is pingable or not
 func (obj *TCPChannel) IsChannelPingable() bool {
-	return obj.needsPing
+	return atomic.LoadInt32(&obj.needsPing) == 1
 }
 
 // IsClosed checks whether channel is open or closed
@@ -3111,12 +3273,17 @@ func (obj *TCPChannel) SendMessage(msg tgdb.TGMessage) tgdb.TGError {
 
 // SendRequest sends a Message, waits for a response in the message format, and blocks the thread till it gets the response
 func (obj *TCPChannel) SendRequest(msg tgdb.TGMessage, response tgdb.TGChannelResponse) (tgdb.TGMessage, tgdb.TGError) {
-	return channelSendRequest(obj, msg, response, true)
+	return channelSendRequest(context.Background(), obj, msg, response, true)
+}
+
+// SendRequestContext sends a Message and waits for its response until the context is cancelled or expires
+func (obj *TCPChannel) SendRequestContext(ctx context.Context, msg tgdb.TGMessage, response tgdb.TGChannelResponse) (tgdb.TGMessage, tgdb.TGError) {
+	return channelSendRequest(ctx, obj, msg, response, true)
 }
 
 // SetChannelLinkState sets the Link/channel State
 func (obj *TCPChannel) SetChannelLinkState(state tgdb.LinkState) {
-	obj.channelLinkState = state
+	atomic.StoreInt32(&obj.channelLinkState, int32(state))
 }
 
 // SetChannelURL sets the channel URL
@@ -3129,6 +3296,11 @@ func (obj *TCPChannel) SetConnectionIndex(index int) {
 	obj.connectionIndex = index
 }
 
+// SetExceptionListener sets the listener notified of channel failures and reconnects
+func (obj *TCPChannel) SetExceptionListener(listener tgdb.TGChannelExceptionListener) {
+	obj.exceptionListener = listener
+}
+
 // SetNoOfConnections sets number of connections
 func (obj *TCPChannel) SetNoOfConnections(count int32) {
 	obj.numOfConnections = count
@@ -3136,7 +3308,7 @@ func (obj *TCPChannel) SetNoOfConnections(count int32) {
 
 // SetResponse sets the ChannelResponse Map
 func (obj *TCPChannel) SetResponse(reqId int64, response tgdb.TGChannelResponse) {
-	obj.responses[reqId] = response
+	obj.AbstractChannel.SetResponse(reqId, response)
 }
 
 // Start starts the channel so that it can send and receive messages
@@ -3341,6 +3513,7 @@ func (obj *TCPChannel) ReadWireMsg() (tgdb.TGMessage, tgdb.TGError) {
 	copy(in.Buf, totalBuffer[:totalBytesOnSocket])
 	in.BufLen = int(totalBytesOnSocket)
 
+	channelTraceWire(obj, WireReceived, in.Buf)
 	msg, err := CreateMessageFromBuffer(in.Buf, 0, int(totalBytesOnSocket))
 	if err != nil {
 		errMsg := "TCPChannel::ReadWireMsg - unable to create a message from the input stream bytes"
@@ -3477,42 +3650,28 @@ func initTLSConfig(props *SortedProperties) (*tls.Config, tgdb.TGError) {
 		logger.Debug(fmt.Sprint("======> Entering SSLChannel:initTLSConfig"))
 	}
 
-	// Load System certificate
-	rootCertPool, err := x509.SystemCertPool()
-	if err != nil {
-		errMsg := fmt.Sprint("ERROR: Returning SSLChannel::initTLSConfig Failed to read system certificate pool")
-		logger.Error(errMsg)
-		return nil, GetErrorByType(TGErrorGeneralException, "TGErrorProtocolNotSupported", errMsg, err.Error())
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: false,
+		Rand:               rand.Reader,
 	}
 
-	//sysTrustFile := fmt.Sprintf("%s%slib%ssecurity%scacerts", os.Getenv("JRE_HOME"), string(os.PathSeparator), string(os.PathSeparator), string(os.PathSeparator))
-	//logger.Debug(fmt.Sprintf("======> Inside SSLChannel:initTLSConfig about to ReadFile '%+v'", sysTrustFile))
-	//pem, err := ioutil.ReadFile(sysTrustFile)
-	//if err != nil {
-	//	errMsg := fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig Failed to read client certificate authority: %s", sysTrustFile)
-	//	logger.Error(errMsg)
-	//	return nil, exception.GetErrorByType(types.TGErrorGeneralException, "TGErrorProtocolNotSupported", errMsg, err.Error())
-	//}
-
-	certPool := x509.NewCertPool()
-	clientCertificates := make([]tls.Certificate, 0)
-
-	//logger.Debug(fmt.Sprint("======> Inside SSLChannel:initTLSConfig about to add system certificate to certificate pool"))
-	//if !certPool.AppendCertsFromPEM(pem) {
-	//	errMsg := fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig Can't parse client certificate data from '%s", sysTrustFile)
-	//	logger.Error(errMsg)
-	//	//return nil, exception.GetErrorByType(types.TGErrorGeneralException, "TGErrorProtocolNotSupported", errMsg, "")
-	//}
-
-	// Load the user defined certificates.
+	// Verify the server against the user defined certificates, or against the system certificates if there are none.
 	trustedCerts := props.GetProperty(GetConfigFromKey(TlsTrustedCertificates), "")
 	if trustedCerts == "" {
-		errMsg := fmt.Sprint("WARNING: Returning SSLChannel::initTLSConfig There are no user defined certificates")
+		errMsg := fmt.Sprint("WARNING: Inside SSLChannel::initTLSConfig There are no user defined certificates - using the system certificate pool")
 		logger.Warning(errMsg)
-		//return nil, nil
+		rootCertPool, err := x509.SystemCertPool()
+		if err != nil {
+			errMsg := fmt.Sprint("ERROR: Returning SSLChannel::initTLSConfig Failed to read system certificate pool")
+			logger.Error(errMsg)
+			return nil, GetErrorByType(TGErrorGeneralException, "TGErrorProtocolNotSupported", errMsg, err.Error())
+		}
+		tlsConfig.RootCAs = rootCertPool
 	} else {
+		certPool := x509.NewCertPool()
 		userCertificateFilePaths := strings.Split(trustedCerts, ",")
 		for _, userCertFile := range userCertificateFilePaths {
+			userCertFile = strings.TrimSpace(userCertFile)
 			userCertData, err := ioutil.ReadFile(userCertFile)
 			if err != nil {
 				errMsg := fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig Failed to read user certificate file: %s", userCertFile)
@@ -3520,61 +3679,47 @@ func initTLSConfig(props *SortedProperties) (*tls.Config, tgdb.TGError) {
 				return nil, GetErrorByType(TGErrorGeneralException, "TGErrorProtocolNotSupported", errMsg, err.Error())
 			}
 			if !certPool.AppendCertsFromPEM(userCertData) {
-				errMsg := fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig Can't parse client certificate data from '%s", userCertFile)
+				errMsg := fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig Can't parse certificate data from '%s'", userCertFile)
 				logger.Error(errMsg)
 				return nil, GetErrorByType(TGErrorGeneralException, "TGErrorProtocolNotSupported", errMsg, "")
 			}
-			clientCertificates = append(clientCertificates)
 		}
+		tlsConfig.RootCAs = certPool
 	}
 
-	tlsConfig := &tls.Config{
-		Certificates:       clientCertificates,
-		ClientAuth:         tls.RequireAndVerifyClientCert,
-		ClientCAs:          certPool,
-		InsecureSkipVerify: false,
-		Rand:               rand.Reader,
-		RootCAs:            rootCertPool,
-	}
-	// TODO: This list may change if GO Language supports more or different - Ref. https://golang.org/pkg/crypto/tls/
-	// TODO: Revisit later to find out if there is any API in GO to get this as a list.
-	suites := []uint16{
-		// TLS 1.0 - 1.2 cipher suites.
-		tls.TLS_RSA_WITH_RC4_128_SHA,
-		tls.TLS_RSA_WITH_3DES_EDE_CBC_SHA,
-		tls.TLS_RSA_WITH_AES_128_CBC_SHA,
-		tls.TLS_RSA_WITH_AES_256_CBC_SHA,
-		tls.TLS_RSA_WITH_AES_128_CBC_SHA256,
-		tls.TLS_RSA_WITH_AES_128_GCM_SHA256,
-		tls.TLS_RSA_WITH_AES_256_GCM_SHA384,
-		tls.TLS_ECDHE_ECDSA_WITH_RC4_128_SHA,
-		tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA,
-		tls.TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA,
-		tls.TLS_ECDHE_RSA_WITH_RC4_128_SHA,
-		tls.TLS_ECDHE_RSA_WITH_3DES_EDE_CBC_SHA,
-		tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA,
-		tls.TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA,
-		tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256,
-		tls.TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256,
-		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
-		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
-		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
-		tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305,
-		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305,
-		// TLS 1.3 cipher suites.
-		//tls.TLS_AES_128_GCM_SHA256,
-		//tls.TLS_AES_256_GCM_SHA384,
-		//tls.TLS_CHACHA20_POLY1305_SHA256,
-		// TLS_FALLBACK_SCSV isn't a standard cipher suite but an indicator
-		// that the client is doing version fallback. See RFC 7507.
-		tls.TLS_FALLBACK_SCSV,
-	}
-	supportedSuites := FilterSuitesById(suites)
+	// Present a client certificate for servers that require client-certificate authentication
+	clientCertFile := props.GetProperty(GetConfigFromKey(TlsClientCertificate), "")
+	if clientCertFile != "" {
+		clientKeyFile := props.GetProperty(GetConfigFromKey(TlsClientKey), "")
+		passphrase := props.GetProperty(GetConfigFromKey(KeyStorePassword), "")
+		clientCertificate, err := LoadClientCertificate(clientCertFile, clientKeyFile, passphrase)
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig LoadClientCertificate failed w/ '%+v'", err.Error()))
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{*clientCertificate}
+	}
+
+	// An empty server name makes tls.Dial use the host of the URL
+	tlsConfig.ServerName = props.GetProperty(GetConfigFromKey(TlsExpectedHostName), "")
+
+	minVersion, err := GetTLSVersion(props.GetProperty(GetConfigFromKey(TlsProtocol), GetConfigFromKey(TlsProtocol).GetDefaultValue()))
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig GetTLSVersion failed w/ '%+v'", err.Error()))
+		return nil, err
+	}
+	tlsConfig.MinVersion = minVersion
+
+	// Only the TLS 1.2 suites can be picked, Go always offers all of the TLS 1.3 ones
+	supportedSuites, err := GetTLSCipherSuites(props.GetProperty(GetConfigFromKey(TlsCipherSuites), ""))
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning SSLChannel::initTLSConfig GetTLSCipherSuites failed w/ '%+v'", err.Error()))
+		return nil, err
+	}
 	tlsConfig.CipherSuites = supportedSuites
 
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("======> Returning SSLChannel:initTLSConfig"))
+		logger.Debug(fmt.Sprintf("======> Returning SSLChannel:initTLSConfig w/ server name '%s', minimum version '%x' and '%d' cipher suites", tlsConfig.ServerName, tlsConfig.MinVersion, len(tlsConfig.CipherSuites)))
 	}
 	return tlsConfig, nil
 }
@@ -3605,7 +3750,7 @@ func initTLSConfig(props *SortedProperties) (*tls.Config, tgdb.TGError) {
 //	return nil
 //}
 
-func (obj *SSLChannel) doAuthenticate() tgdb.TGError {
+func (obj *SSLChannel) DoAuthenticate() tgdb.TGError {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("======> Entering SSLChannel:doAuthenticate"))
 	}
@@ -3618,8 +3763,12 @@ func (obj *SSLChannel) doAuthenticate() tgdb.TGError {
 
 	msgRequest.(*AuthenticateRequestMessage).SetClientId(obj.clientId)
 	msgRequest.(*AuthenticateRequestMessage).SetInboxAddr(obj.inboxAddress)
-	msgRequest.(*AuthenticateRequestMessage).SetUserName(obj.GetChannelUserName())
-	msgRequest.(*AuthenticateRequestMessage).SetPassword(obj.GetChannelPassword())
+	user, pwd, err := obj.getChannelCredentials(obj.credentials)
+	if err != nil {
+		return err
+	}
+	msgRequest.(*AuthenticateRequestMessage).SetUserName(user)
+	msgRequest.(*AuthenticateRequestMessage).SetPassword(pwd)
 
 	//logger.Debug(fmt.Sprintf("======> Inside SSLChannel:doAuthenticate about to request reply for request '%+v'", msgRequest.String()))
 	msgResponse, err := channelRequestReply(obj, msgRequest)
@@ -3643,7 +3792,7 @@ func (obj *SSLChannel) doAuthenticate() tgdb.TGError {
 	}
 	obj.setDataCryptoGrapher(cryptoDataGrapher)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("======> Returning SSLChannel:doAuthenticate Successfully authenticated for user: '%s'", obj.GetChannelUserName()))
+		logger.Debug(fmt.Sprintf("======> Returning SSLChannel:doAuthenticate Successfully authenticated for user: '%s'", user))
 	}
 	return nil
 }
@@ -3674,14 +3823,14 @@ func (obj *SSLChannel) performHandshake(sslMode bool) tgdb.TGError {
 			errMsg := msgResponse.(*SessionForcefullyTerminatedMessage).GetKillString()
 			return NewTGChannelDisconnectedWithMsg(errMsg)
 		}
-		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 
 	response := msgResponse.(*HandShakeResponseMessage)
 	if response.GetResponseStatus() != ResponseAcceptChallenge {
 		logger.Error(fmt.Sprint("ERROR: Returning SSLChannel::performHandshake response.GetResponseStatus() is NOT pdu.ResponseAcceptChallenge"))
-		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 
@@ -3715,14 +3864,14 @@ func (obj *SSLChannel) performHandshake(sslMode bool) tgdb.TGError {
 			errMsg := msgResponse.(*SessionForcefullyTerminatedMessage).GetKillString()
 			return NewTGChannelDisconnectedWithMsg(errMsg)
 		}
-		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("Expecting a HandshakeResponse message, and received: '%d'. Cannot connect to the server at: '%s'", msgResponse.GetVerbId(), redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 
 	response = msgResponse.(*HandShakeResponseMessage)
 	if response.GetResponseStatus() != ResponseProceedWithAuthentication {
 		logger.Error(fmt.Sprint("ERROR: Returning SSLChannel::performHandshake response.GetResponseStatus() is NOT pdu.ResponseAcceptChallenge"))
-		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, obj.channelUrl.GetUrlAsString())
+		errMsg := fmt.Sprintf("'%s': Handshake Failed. Cannot connect to the server at: '%s'", TGDB_HNDSHKRESP_ERROR, redactUrl(obj.channelUrl.GetUrlAsString()))
 		return NewTGGeneralException(TGDB_HNDSHKRESP_ERROR, TGErrorGeneralException, errMsg, "")
 	}
 	if logger.IsDebug() {
@@ -3872,6 +4021,9 @@ func (obj *SSLChannel) writeToWire(msg tgdb.TGMessage) tgdb.TGError {
 		//return exception.GetErrorByType(types.TGErrorIOException, "TGErrorProtocolNotSupported", errMsg, err.GetErrorMsg())
 		return err
 	}
+	// Keep the size on the wire for GetMessageByteBufLength
+	msg.SetMessageByteBufLength(bufLen)
+	channelTraceWire(obj, WireSent, wireCapturePdu(msg, msgBytes[0:bufLen]))
 
 	// Clear timeout deadlines set at the time of creation of the socket
 	sErr := obj.socket.SetDeadline(time.Time{})
@@ -3893,7 +4045,7 @@ func (obj *SSLChannel) writeToWire(msg tgdb.TGMessage) tgdb.TGError {
 	}
 
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("======> Inside SSLChannel:writeToWire about to write message bytes on the socket as '%+v'", msgBytes[0:bufLen]))
+		logger.Debug(fmt.Sprintf("======> Inside SSLChannel:writeToWire about to write message bytes on the socket as '%+v'", redactBytes(msgBytes[0:bufLen])))
 	}
 	// Put the data packet on the socket for network transmission
 	_, sErr = obj.socket.Write(msgBytes[0:bufLen])
@@ -3903,7 +4055,7 @@ func (obj *SSLChannel) writeToWire(msg tgdb.TGMessage) tgdb.TGError {
 		return GetErrorByType(TGErrorIOException, "TGErrorProtocolNotSupported", errMsg, sErr.Error())
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("======> Returning SSLChannel:writeToWire successfully wrote message bytes on the socket as '%+v'", msgBytes[0:bufLen]))
+		logger.Debug(fmt.Sprintf("======> Returning SSLChannel:writeToWire successfully wrote message bytes on the socket as '%+v'", redactBytes(msgBytes[0:bufLen])))
 	}
 	return nil
 }
@@ -3941,7 +4093,7 @@ func (obj *SSLChannel) Connect() tgdb.TGError {
 
 // DisablePing disables the pinging ability to the channel
 func (obj *SSLChannel) DisablePing() {
-	obj.needsPing = false
+	atomic.StoreInt32(&obj.needsPing, 0)
 }
 
 // Disconnect disconnects the channel from its URL end point
@@ -3951,7 +4103,7 @@ func (obj *SSLChannel) Disconnect() tgdb.TGError {
 
 // EnablePing enables the pinging ability to the channel
 func (obj *SSLChannel) EnablePing() {
-	obj.needsPing = true
+	atomic.StoreInt32(&obj.needsPing, 1)
 }
 
 // ExceptionLock locks the communication channel between TGDB client and server in case of business exceptions
@@ -3989,9 +4141,14 @@ func (obj *SSLChannel) GetExceptionCondition() *sync.Cond {
 	return obj.exceptionCond
 }
 
+// GetExceptionListener gets the listener notified of channel failures and reconnects
+func (obj *SSLChannel) GetExceptionListener() tgdb.TGChannelExceptionListener {
+	return obj.exceptionListener
+}
+
 // GetLinkState gets the Link/channel State
 func (obj *SSLChannel) GetLinkState() tgdb.LinkState {
-	return obj.channelLinkState
+	return tgdb.LinkState(atomic.LoadInt32(&obj.channelLinkState))
 }
 
 // GetNoOfConnections gets number of connections this channel has
@@ -4016,7 +4173,7 @@ func (obj *SSLChannel) GetReader() tgdb.TGChannelReader {
 
 // GetResponses gets the channel Response Map
 func (obj *SSLChannel) GetResponses() map[int64]tgdb.TGChannelResponse {
-	return obj.responses
+	return obj.AbstractChannel.GetResponses()
 }
 
 // GetSessionId gets Session id
@@ -4031,7 +4188,7 @@ func (obj *SSLChannel) GetTracer() tgdb.TGTracer {
 
 // IsChannelPingable checks whether the channel is pingable or not
 func (obj *SSLChannel) IsChannelPingable() bool {
-	return obj.needsPing
+	return atomic.LoadInt32(&obj.needsPing) == 1
 }
 
 // IsClosed checks whether channel is open or closed
@@ -4046,12 +4203,17 @@ func (obj *SSLChannel) SendMessage(msg tgdb.TGMessage) tgdb.TGError {
 
 // SendRequest sends a Message, waits for a response in the message format, and blocks the thread till it gets the response
 func (obj *SSLChannel) SendRequest(msg tgdb.TGMessage, response tgdb.TGChannelResponse) (tgdb.TGMessage, tgdb.TGError) {
-	return channelSendRequest(obj, msg, response, true)
+	return channelSendRequest(context.Background(), obj, msg, response, true)
+}
+
+// SendRequestContext sends a Message and waits for its response until the context is cancelled or expires
+func (obj *SSLChannel) SendRequestContext(ctx context.Context, msg tgdb.TGMessage, response tgdb.TGChannelResponse) (tgdb.TGMessage, tgdb.TGError) {
+	return channelSendRequest(ctx, obj, msg, response, true)
 }
 
 // SetChannelLinkState sets the Link/channel State
 func (obj *SSLChannel) SetChannelLinkState(state tgdb.LinkState) {
-	obj.channelLinkState = state
+	atomic.StoreInt32(&obj.channelLinkState, int32(state))
 }
 
 // SetChannelURL sets the channel URL
@@ -4064,6 +4226,11 @@ func (obj *SSLChannel) SetConnectionIndex(index int) {
 	obj.connectionIndex = index
 }
 
+// SetExceptionListener sets the listener notified of channel failures and reconnects
+func (obj *SSLChannel) SetExceptionListener(listener tgdb.TGChannelExceptionListener) {
+	obj.exceptionListener = listener
+}
+
 // SetNoOfConnections sets number of connections
 func (obj *SSLChannel) SetNoOfConnections(count int32) {
 	obj.numOfConnections = count
@@ -4071,7 +4238,7 @@ func (obj *SSLChannel) SetNoOfConnections(count int32) {
 
 // SetResponse sets the ChannelResponse Map
 func (obj *SSLChannel) SetResponse(reqId int64, response tgdb.TGChannelResponse) {
-	obj.responses[reqId] = response
+	obj.AbstractChannel.SetResponse(reqId, response)
 }
 
 // Start starts the channel so that it can send and receive messages
@@ -4122,7 +4289,8 @@ func (obj *SSLChannel) CreateSocket() tgdb.TGError {
 	//}
 	////logger.Debug(fmt.Sprintf("======> Inside SSLChannel:CreateSocket resolved SSL address for '%s' as '%+v'", serverAddr, tcpAddr))
 	//
-	sslConn, cErr := tls.Dial(tgdb.ProtocolSSL.String(), serverAddr, obj.tlsConfig)
+	// SSL runs over a TCP socket - "ssl" is not a network net.Dial knows
+	sslConn, cErr := tls.Dial(tgdb.ProtocolTCP.String(), serverAddr, obj.tlsConfig)
 	if cErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning SSLChannel::CreateSocket Failed to connect to the server at '%s' w/ '%+v'", serverAddr, cErr.Error()))
 		failureMessage := fmt.Sprintf("Failed to connect to the server at '%s'", serverAddr)
@@ -4236,7 +4404,7 @@ func (obj *SSLChannel) OnConnect() tgdb.TGError {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("======> Inside SSLChannel:OnConnect about to doAuthenticate"))
 	}
-	err = obj.doAuthenticate()
+	err = obj.DoAuthenticate()
 	if err != nil {
 		logger.Error(fmt.Sprint("ERROR: Returning SSLChannel::OnConnect obj.doAuthenticate() failed"))
 		errMsg := "SSLChannel::OnConnect error in authentication with server"
@@ -4295,6 +4463,7 @@ func (obj *SSLChannel) ReadWireMsg() (tgdb.TGMessage, tgdb.TGError) {
 	//bytesRead, _ := utils.FormatHex(msgBytes)
 	//logger.Debug(fmt.Sprintf("======> Inside SSLChannel:ReadWireMsg bytes read: '%s'", bytesRead))
 
+	channelTraceWire(obj, WireReceived, buffer)
 	msg, err := CreateMessageFromBuffer(buffer, 0, n)
 	if err != nil {
 		errMsg := "SSLChannel::ReadWireMsg - unable to create a message from the input stream bytes"
@@ -4388,7 +4557,6 @@ func GetChannelFactoryInstance() *TGChannelFactory {
 /////////////////////////////////////////////////////////////////
 
 func (obj *TGChannelFactory) createChannelWithProperties(urlPath, userName, password string, props map[string]string) (tgdb.TGChannel, tgdb.TGError) {
-	//logger.Log(fmt.Sprintf("Entering TGChannelFactory:createChannelWithProperties w/ URL: '%s' User: '%s', Pwd: '%s'", urlPath, userName, password))
 	if len(urlPath) == 0 {
 		logger.Error(fmt.Sprint("ERROR: Returning TGChannelFactory:createChannelWithProperties - urlPath is EMPTY"))
 		errMsg := fmt.Sprintf("TGChannelFactory:createChannelWithProperties Invalid URL specified as '%s'", urlPath)
@@ -4401,7 +4569,7 @@ func (obj *TGChannelFactory) createChannelWithProperties(urlPath, userName, pass
 	}
 	if len(password) == 0 {
 		logger.Error(fmt.Sprint("ERROR: Returning TGChannelFactory:createChannelWithProperties - password is EMPTY"))
-		errMsg := fmt.Sprintf("TGChannelFactory:createChannelWithProperties Invalid password specified as '%s'", password)
+		errMsg := fmt.Sprint("TGChannelFactory:createChannelWithProperties Invalid password specified")
 		return nil, GetErrorByType(TGErrorGeneralException, "TGErrorGeneralException", errMsg, "")
 	}
 	properties := NewSortedProperties()
@@ -4416,14 +4584,22 @@ func (obj *TGChannelFactory) createChannelWithProperties(urlPath, userName, pass
 		for _, kvp := range urlProps.GetAllProperties() {
 			properties.AddProperty(kvp.KeyName, kvp.KeyValue)
 		}
+		// The url keeps its own copy of the properties, which must not hold on to a password either
+		_ = takeCredentialProvider(urlProps, "")
 	}
-	err1 := SetUserAndPassword(properties, userName, password)
+	err1 := properties.SetUser(userName)
 	if err1 != nil {
-		logger.Error(fmt.Sprintf("ERROR: Returning TGChannelFactory:createChannelWithProperties - unable to set user and password in the property set w/ Error: '%+v'", err1.Error()))
-		errMsg := fmt.Sprintf("TGChannelFactory:createChannelWithProperties unable to set user '%s' and password in the property set", userName)
+		logger.Error(fmt.Sprintf("ERROR: Returning TGChannelFactory:createChannelWithProperties - unable to set user in the property set w/ Error: '%+v'", err1.Error()))
+		errMsg := fmt.Sprintf("TGChannelFactory:createChannelWithProperties unable to set user '%s' in the property set", userName)
 		return nil, GetErrorByType(TGErrorGeneralException, "TGErrorGeneralException", errMsg, err1.Error())
 	}
-	return obj.CreateChannelWithUrlProperties(channelUrl, properties)
+	credentials := takeCredentialProvider(properties, password)
+	channel, err := obj.CreateChannelWithUrlProperties(channelUrl, properties)
+	if err != nil {
+		return nil, err
+	}
+	channel.SetCredentialProvider(credentials)
+	return channel, nil
 }
 
 func (obj *TGChannelFactory) CreateChannelWithUrlProperties(channelUrl tgdb.TGChannelUrl, props *SortedProperties) (tgdb.TGChannel, tgdb.TGError) {
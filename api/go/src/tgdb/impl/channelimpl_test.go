@@ -0,0 +1,266 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: channelimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"sync"
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/mockserver"
+	"time"
+)
+
+// selfSignedCertificate returns the DER bytes of a certificate for key
+func selfSignedCertificate(t *testing.T, key interface{}, publicKey interface{}) []byte {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "tgdb-test-server"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, key)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return der
+}
+
+func TestDataCryptoGrapherEncryptsWithServerCertificate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	der := selfSignedCertificate(t, key, &key.PublicKey)
+	certificates := map[string][]byte{
+		"DER": der,
+		"PEM": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+	for format, certBytes := range certificates {
+		t.Run(format, func(t *testing.T) {
+			cryptoGrapher, tgErr := impl.NewDataCryptoGrapher(1, certBytes)
+			if tgErr != nil {
+				t.Fatal(tgErr.Error())
+			}
+			plain := []byte("secret attribute value")
+			encrypted, tgErr := cryptoGrapher.Encrypt(plain)
+			if tgErr != nil {
+				t.Fatal(tgErr.Error())
+			}
+			if len(encrypted) != key.Size() {
+				t.Fatalf("Expected %d encrypted bytes, got %d", key.Size(), len(encrypted))
+			}
+			decrypted, err := rsa.DecryptPKCS1v15(rand.Reader, key, encrypted)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if !bytes.Equal(decrypted, plain) {
+				t.Fatalf("Expected '%s' back, got '%s'", plain, decrypted)
+			}
+		})
+	}
+}
+
+func TestDataCryptoGrapherRejectsUnusableCertificates(t *testing.T) {
+	if _, err := impl.NewDataCryptoGrapher(1, []byte("not a certificate")); err == nil {
+		t.Fatal("Expected an unparsable certificate to be rejected")
+	}
+
+	// Without a certificate the cryptographer can only decrypt
+	cryptoGrapher, err := impl.NewDataCryptoGrapher(1, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := cryptoGrapher.Encrypt([]byte("value")); err == nil {
+		t.Fatal("Expected Encrypt to fail without a server certificate")
+	}
+
+	ecKey, ecErr := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if ecErr != nil {
+		t.Fatal(ecErr.Error())
+	}
+	cryptoGrapher, err = impl.NewDataCryptoGrapher(1, selfSignedCertificate(t, ecKey, &ecKey.PublicKey))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := cryptoGrapher.Encrypt([]byte("value")); err == nil {
+		t.Fatal("Expected Encrypt to fail with an EC public key")
+	}
+}
+
+func TestDataCryptoGrapherDecryptsMaskedValue(t *testing.T) {
+	cryptoGrapher, err := impl.NewDataCryptoGrapher(1, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// Two whole longs XOR-ed with the mask and three trailing bytes in clear
+	plain := []byte("0123456789abcdefXYZ")
+	mask := int64(0x5a5aa5a55a5aa5a5)
+	os := impl.DefaultProtocolDataOutputStream()
+	os.WriteLong(mask)
+	os.WriteLong(int64(len(plain)))
+	// The server masks its data as native little-endian longs and writes each one as a long
+	for i := 0; i < len(plain)/8; i++ {
+		val := int64(binary.LittleEndian.Uint64(plain[i*8:]))
+		os.WriteLong(val ^ mask)
+	}
+	for _, b := range plain[len(plain)/8*8:] {
+		os.WriteByte(int(b))
+	}
+	buf, err := os.ToByteArray()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	decrypted, err := cryptoGrapher.Decrypt(impl.NewProtocolDataInputStream(buf))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !bytes.Equal(decrypted, plain) {
+		t.Fatalf("Expected '%s' back, got '%s'", plain, decrypted)
+	}
+
+	// A value cut short by the server is an error rather than a partial result
+	if _, err := cryptoGrapher.Decrypt(impl.NewProtocolDataInputStream(buf[:len(buf)-5])); err == nil {
+		t.Fatal("Expected Decrypt to fail on a truncated value")
+	}
+}
+
+// reconnectRecorder counts the reconnects of a channel, and passes the events on to the listener it replaced
+type reconnectRecorder struct {
+	lock       sync.Mutex
+	listener   tgdb.TGChannelExceptionListener
+	reconnects int
+	url        string
+}
+
+func (obj *reconnectRecorder) OnException(ex tgdb.TGError, duringClose bool) {
+	if obj.listener != nil {
+		obj.listener.OnException(ex, duringClose)
+	}
+}
+
+func (obj *reconnectRecorder) OnReconnect(url tgdb.TGChannelUrl) {
+	obj.lock.Lock()
+	obj.reconnects++
+	obj.url = url.GetUrlAsString()
+	obj.lock.Unlock()
+	if obj.listener != nil {
+		obj.listener.OnReconnect(url)
+	}
+}
+
+func (obj *reconnectRecorder) getReconnects() (int, string) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.reconnects, obj.url
+}
+
+func TestChannelResendsRequestAfterFailover(t *testing.T) {
+	server, conn := connectToMockWithProperties(t, map[string]string{
+		"tgdb.channel.ftRetryCount":           "2",
+		"tgdb.channel.ftRetryIntervalSeconds": "0",
+	})
+	defer server.Close()
+	defer conn.Disconnect()
+	ids := addPeople(t, server.GetStore(), "a", "b")
+	server.GetStore().SetQueryResult("people", ids...)
+	channel := conn.(*impl.TGDBConnection).GetChannel()
+	recorder := &reconnectRecorder{listener: channel.GetExceptionListener()}
+	channel.SetExceptionListener(recorder)
+
+	// Each dropped connection is reconnected, and the query is resent on the new one
+	server.InjectFault(impl.VerbQueryRequest, mockserver.Fault{Kind: mockserver.FaultDropConnection, Count: 2})
+	resultSet, err := conn.ExecuteQuery("tgql://people", impl.NewQueryOption())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if count := len(resultSet.ToCollection()); count != 2 {
+		t.Fatalf("Expected 2 nodes, got %d", count)
+	}
+	if count := server.GetRequestCount(impl.VerbQueryRequest); count != 3 {
+		t.Fatalf("Expected the query to be sent 3 times, got %d", count)
+	}
+	reconnects, url := recorder.getReconnects()
+	if reconnects != 2 {
+		t.Fatalf("Expected 2 reconnect notifications, got %d", reconnects)
+	}
+	if url != server.GetUrl() {
+		t.Fatalf("Expected the reconnect to '%s', got '%s'", server.GetUrl(), url)
+	}
+
+	// A request that keeps failing over is given up after ftRetryCount resends
+	conn.SetRetryPolicy(&tgdb.TGRetryPolicy{MaxAttempts: 1})
+	server.InjectFault(impl.VerbQueryRequest, mockserver.Fault{Kind: mockserver.FaultDropConnection})
+	_, err = conn.ExecuteQuery("tgql://people", impl.NewQueryOption())
+	if err == nil {
+		t.Fatal("Expected the query to fail")
+	}
+	if err.GetErrorType() != impl.TGErrorRetryIOException {
+		t.Fatalf("Expected a retry IO error, got '%s'", err.Error())
+	}
+	if count := server.GetRequestCount(impl.VerbQueryRequest); count != 6 {
+		t.Fatalf("Expected the query to be sent 3 more times, got %d in all", count)
+	}
+}
+
+func TestBlockingChannelResponseWakesUpOnReply(t *testing.T) {
+	response := impl.NewBlockingChannelResponse(1, -1)
+	reply := impl.NewExceptionMessageWithType(impl.TGErrorGeneralException, "reply")
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		response.SetReply(reply)
+	}()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := response.AwaitContext(ctx, response); err != nil {
+		t.Fatal(err.Error())
+	}
+	if response.GetReply() != reply {
+		t.Fatal("Expected the reply that was set")
+	}
+
+	// After a reset the response waits for the next reply, until the context is cancelled
+	response.Reset()
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	err := response.AwaitContext(ctx, response)
+	if err == nil || err.GetErrorType() != impl.TGErrorOperationCancelled {
+		t.Fatalf("Expected the wait to be cancelled, got '%v'", err)
+	}
+	if response.GetStatus() != tgdb.Closed {
+		t.Fatalf("Expected the cancelled response to be closed, got status %d", response.GetStatus())
+	}
+}
@@ -23,9 +23,14 @@ package impl
 
 import (
 	"bytes"
+	"container/list"
+	"context"
+	"encoding/binary"
 	"encoding/gob"
 	"fmt"
+	"io"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -51,6 +56,8 @@ const (
 	TGDB_CHANNEL_ERROR       string = "TGDB-CHANNEL-ERR"
 	TGDB_SEND_ERROR          string = "TGDB-SENDL-ERR"
 	TGDB_CLIENT_READEXTERNAL string = "TGDB-CLIENT-READEXTERNAL"
+	TGDB_BULKIO_ERROR        string = "TGDB-BULKIO-ERR"
+	TGDB_ADMIN_ERROR         string = "TGDB-ADMIN-ERR"
 
 	DebugEnabled bool = false
 )
@@ -70,16 +77,28 @@ const (
 	CLOSE
 )
 
+// Commands of a get entity request that work on the server cursor of an earlier get entities request
+const (
+	GetEntityCommandContinue int16 = 10
+	GetEntityCommandClose    int16 = 20
+)
+
 type TGDBConnection struct {
 	channel         tgdb.TGChannel
 	connId          int64
 	connPoolImpl    tgdb.TGConnectionPool // Connection belongs to a connection pool
 	graphObjFactory *GraphObjectFactory   // Intentionally kept private to ensure execution of InitMetaData() before accessing graph objects
 	connProperties  tgdb.TGProperties
+	txnLock         sync.Mutex    // guards the transaction state below, which belongs to this connection alone
+	txnSlot         chan struct{} // held by the explicit transaction open on this connection, see Begin
 	addedList       map[int64]tgdb.TGEntity
 	changedList     map[int64]tgdb.TGEntity
 	removedList     map[int64]tgdb.TGEntity
 	attrByTypeList  map[int][]tgdb.TGAttribute
+	retryLock       sync.Mutex
+	retryPolicy     *tgdb.TGRetryPolicy
+	retries         int64 // accessed atomically
+	retryFailures   int64 // accessed atomically
 }
 
 func DefaultTGDBConnection() *TGDBConnection {
@@ -93,6 +112,7 @@ func DefaultTGDBConnection() *TGDBConnection {
 		changedList:    make(map[int64]tgdb.TGEntity, 0),
 		removedList:    make(map[int64]tgdb.TGEntity, 0),
 		attrByTypeList: make(map[int][]tgdb.TGAttribute, 0),
+		txnSlot:        make(chan struct{}, 1),
 	}
 	//newSGDBConnection.channel = DefaultAbstractChannel()
 	newSGDBConnection.connId = atomic.AddInt64(&connectionIds, 1)
@@ -156,6 +176,13 @@ func (obj *TGDBConnection) SetConnectionProperties(connProps tgdb.TGProperties)
 // Private functions for types.TGConnection
 /////////////////////////////////////////////////////////////////
 
+// changeList is the set of entities a unit of work inserts, updates and deletes
+type changeList struct {
+	addedList   map[int64]tgdb.TGEntity
+	changedList map[int64]tgdb.TGEntity
+	removedList map[int64]tgdb.TGEntity
+}
+
 func fixUpAttrDescriptors(response *CommitTransactionResponse, attrDescSet []tgdb.TGAttributeDescriptor) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Entering TGDBConnection:fixUpAttrDescriptors"))
@@ -180,7 +207,7 @@ func fixUpAttrDescriptors(response *CommitTransactionResponse, attrDescSet []tgd
 	}
 }
 
-func fixUpEntities(obj tgdb.TGConnection, response *CommitTransactionResponse) {
+func fixUpEntities(changes *changeList, response *CommitTransactionResponse) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Entering TGDBConnection:fixUpEntities"))
 	}
@@ -191,7 +218,7 @@ func fixUpEntities(obj tgdb.TGConnection, response *CommitTransactionResponse) {
 		realId := addedIdList[((i * 3) + 1)]
 		version := addedIdList[((i * 3) + 2)]
 
-		for _, addEntity := range obj.GetAddedList() {
+		for _, addEntity := range changes.addedList {
 			if addEntity.GetVirtualId() == tempId {
 				logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:fixUpEntities - Replace entity id: '%d' by '%d'", tempId, realId))
 				addEntity.SetEntityId(realId)
@@ -208,7 +235,7 @@ func fixUpEntities(obj tgdb.TGConnection, response *CommitTransactionResponse) {
 		id := updatedIdList[(i * 2)]
 		version := updatedIdList[((i * 2) + 1)]
 
-		for _, modEntity := range obj.GetChangedList() {
+		for _, modEntity := range changes.changedList {
 			if modEntity.GetVirtualId() == id {
 				logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:fixUpEntities - Replace entity version: '%d' to '%d'", id, version))
 				modEntity.SetVersion(int(version))
@@ -221,6 +248,257 @@ func fixUpEntities(obj tgdb.TGConnection, response *CommitTransactionResponse) {
 	}
 }
 
+// describeCommitFailure fills in the entities and attributes a rejected commit most likely tripped over
+func describeCommitFailure(changes *changeList, txnErr tgdb.TGTransactionError) tgdb.TGError {
+	entityIds, attrNames := commitFailureCandidates(changes, txnErr.GetTransactionStatus())
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TGDBConnection:describeCommitFailure w/ entities '%+v' and attributes '%+v'", entityIds, attrNames))
+	}
+	return BuildCommitException(txnErr.GetTransactionStatus(), txnErr.GetErrorMsg(), entityIds, attrNames)
+}
+
+// commitFailureCandidates narrows the pending entities down to the ones the status points at. The server only
+// reports a status and a message, so when nothing matches, every entity in the transaction is reported.
+func commitFailureCandidates(changes *changeList, status tgdb.TGTransactionStatus) ([]int64, []string) {
+	entities := make([]tgdb.TGEntity, 0)
+	switch status {
+	case tgdb.TGTransactionOptimisticLockFailed:
+		for _, entity := range changes.changedList {
+			entities = append(entities, entity)
+		}
+		for _, entity := range changes.removedList {
+			entities = append(entities, entity)
+		}
+	default:
+		for _, entity := range changes.addedList {
+			entities = append(entities, entity)
+		}
+		for _, entity := range changes.changedList {
+			entities = append(entities, entity)
+		}
+		for _, entity := range changes.removedList {
+			entities = append(entities, entity)
+		}
+	}
+
+	ids := make([]int64, 0)
+	names := make(map[string]bool, 0)
+	for _, entity := range entities {
+		var attrNames []string
+		switch status {
+		case tgdb.TGTransactionUniqueConstraintViolation:
+			attrNames = pkeyAttributeNames(entity, func(attr tgdb.TGAttribute) bool {
+				return attr != nil && attr.GetIsModified()
+			})
+		case tgdb.TGTransactionUniqueIndexKeyAttributeNullError:
+			attrNames = pkeyAttributeNames(entity, func(attr tgdb.TGAttribute) bool {
+				return attr == nil || attr.IsNull()
+			})
+		default:
+			attrNames = modifiedAttributeNames(entity)
+		}
+		if len(attrNames) == 0 && (status == tgdb.TGTransactionUniqueConstraintViolation || status == tgdb.TGTransactionUniqueIndexKeyAttributeNullError) {
+			continue
+		}
+		ids = append(ids, entity.GetVirtualId())
+		for _, name := range attrNames {
+			names[name] = true
+		}
+	}
+	if len(ids) == 0 && len(entities) > 0 && status != tgdb.TGTransactionOptimisticLockFailed {
+		return commitFailureCandidates(changes, tgdb.TGTransactionGeneralError)
+	}
+
+	attrNames := make([]string, 0, len(names))
+	for name := range names {
+		attrNames = append(attrNames, name)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	sort.Strings(attrNames)
+	return ids, attrNames
+}
+
+// pkeyAttributeNames returns the primary key attributes of a node that satisfy the match
+func pkeyAttributeNames(entity tgdb.TGEntity, match func(attr tgdb.TGAttribute) bool) []string {
+	names := make([]string, 0)
+	nodeType, ok := entity.GetEntityType().(tgdb.TGNodeType)
+	if !ok || nodeType == nil {
+		return names
+	}
+	for _, desc := range nodeType.GetPKeyAttributeDescriptors() {
+		if desc == nil {
+			continue
+		}
+		if match(entity.GetAttribute(desc.GetName())) {
+			names = append(names, desc.GetName())
+		}
+	}
+	return names
+}
+
+// modifiedAttributeNames returns the names of the attributes changed in this transaction
+func modifiedAttributeNames(entity tgdb.TGEntity) []string {
+	names := make([]string, 0)
+	modified, ok := entity.(interface{ GetModifiedAttributes() []tgdb.TGAttribute })
+	if !ok {
+		return names
+	}
+	for _, attr := range modified.GetModifiedAttributes() {
+		if attr != nil && attr.GetAttributeDescriptor() != nil {
+			names = append(names, attr.GetAttributeDescriptor().GetName())
+		}
+	}
+	return names
+}
+
+// commitChanges sends a set of changes to the server in one commit and, once it succeeds, assigns the server ids
+// and versions to the entities. The caller owns the change list and clears it afterwards.
+func commitChanges(ctx context.Context, obj *TGDBConnection, changes *changeList) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering TGDBConnection:commitChanges"))
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges - about to loop through addedList to include existing nodes to the changed list if it's part of a new edge"))
+	}
+	// Include existing nodes to the changed list if it's part of a new edge
+	for _, addEntity := range changes.addedList {
+		if addEntity.GetEntityKind() == tgdb.EntityKindEdge {
+			nodes := addEntity.(*Edge).GetVertices()
+			if len(nodes) > 0 {
+				for _, vNode := range nodes {
+					node := vNode.(*Node)
+					if !node.GetIsNew() {
+						changes.changedList[node.GetVirtualId()] = node
+						logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:commitChanges - Existing node '%d' added to change list for a new edge", node.GetVirtualId()))
+					}
+				}
+			}
+		}
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges - about to loop through changedList to include existing nodes to the changed list even for edge update"))
+	}
+	// Need to include existing node to the changed list even for edge update
+	for _, modEntity := range changes.changedList {
+		if modEntity.GetEntityKind() == tgdb.EntityKindEdge {
+			nodes := modEntity.(*Edge).GetVertices()
+			if len(nodes) > 0 {
+				for _, vNode := range nodes {
+					node := vNode.(*Node)
+					if !node.GetIsNew() {
+						changes.changedList[node.GetVirtualId()] = node
+						logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:commitChanges - Existing node '%d' added to change list for an existing edge '%d'", node.GetVirtualId(), modEntity.GetVirtualId()))
+					}
+				}
+			}
+		}
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges - about to loop through removedList to include existing nodes to the changed list even for edge update"))
+	}
+	// Need to include existing node to the changed list even for edge update
+	for _, delEntity := range changes.removedList {
+		if delEntity.GetEntityKind() == tgdb.EntityKindEdge {
+			nodes := delEntity.(*Edge).GetVertices()
+			if len(nodes) > 0 {
+				for _, vNode := range nodes {
+					node := vNode.(*Node)
+					if !node.GetIsNew() {
+						if changes.removedList[node.GetVirtualId()] == nil {
+							changes.changedList[node.GetVirtualId()] = node
+							logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:commitChanges - Existing node '%d' added to change list for an edge %d to be deleted", node.GetVirtualId(), delEntity.GetVirtualId()))
+						}
+					}
+				}
+			}
+		}
+	}
+	//For deleted edge and node, we don't immediately change the effected nodes or edges.
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges about to createChannelRequest() for: VerbCommitTransactionRequest"))
+	}
+	// Create a channel request
+	msgRequest, channelResponse, err := createChannelRequest(obj, VerbCommitTransactionRequest)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:commitChanges - unable to createChannelRequest(VerbCommitTransactionRequest w/ error: '%s'", err.Error()))
+		return err
+	}
+	queryRequest := msgRequest.(*CommitTransactionRequest)
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges about to GetAttributeDescriptors() for: VerbCommitTransactionRequest"))
+	}
+	gof := obj.graphObjFactory
+	attrDescSet, aErr := gof.GetGraphMetaData().GetNewAttributeDescriptors()
+	if aErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:commitChanges - unable to gmd.GetAttributeDescriptors() w/ error: '%s'", aErr.Error()))
+		return aErr
+	}
+	queryRequest.AddCommitLists(changes.addedList, changes.changedList, changes.removedList, attrDescSet)
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges about to channelSendRequest() for: VerbCommitTransactionRequest"))
+	}
+	// Execute request on channel and get the response
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
+	if channelErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:commitChanges - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
+		return channelErr
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside TGDBConnection::commitChanges received response for: VerbCommitTransactionRequest as '%+v'", msgResponse))
+	}
+	response := msgResponse.(*CommitTransactionResponse)
+
+	if response.HasException() {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:commitChanges as response has exception: '%s'", response.GetException().Error()))
+		return describeCommitFailure(changes, response.GetException())
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges about to fixUpAttrDescriptors()"))
+	}
+	fixUpAttrDescriptors(response, attrDescSet)
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Inside TGDBConnection::commitChanges about to fixUpEntities()"))
+	}
+	fixUpEntities(changes, response)
+
+	for _, delEntity := range changes.removedList {
+		delEntity.SetIsDeleted(true)
+	}
+
+	// Reset the modified flags now that the server holds the changes
+	for _, modEntity := range changes.changedList {
+		modEntity.ResetModifiedAttributes()
+	}
+	for _, newEntity := range changes.addedList {
+		newEntity.ResetModifiedAttributes()
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Returning TGDBConnection:commitChanges"))
+	}
+	return nil
+}
+
+// getDataCryptoGrapher returns the channel's data cryptographer, which only exists once the channel has authenticated
+func getDataCryptoGrapher(obj tgdb.TGConnection) (tgdb.TGDataCryptoGrapher, tgdb.TGError) {
+	var cryptoGrapher tgdb.TGDataCryptoGrapher
+	if obj.GetChannel() != nil {
+		cryptoGrapher = obj.GetChannel().GetDataCryptoGrapher()
+	}
+	if cryptoGrapher == nil {
+		logger.Error(fmt.Sprint("ERROR: Returning getDataCryptoGrapher - channel does not have a data cryptographer"))
+		errMsg := "Unable to encrypt/decrypt data - connection is not authenticated with the server"
+		return nil, GetErrorByType(TGErrorSecurityException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	return cryptoGrapher, nil
+}
+
 func createChannelRequest(obj tgdb.TGConnection, verb int) (tgdb.TGMessage, tgdb.TGChannelResponse, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:createChannelRequest for Verb: '%s'", GetVerb(verb).GetName()))
@@ -430,6 +708,100 @@ func (obj *TGDBConnection) populateResultSetFromQueryResponse(resultId int, msgR
 	return rSet, nil
 }
 
+// entityCursor is the server side cursor of a get entities request whose results did not fit in the first
+// batch. The server keeps it by result id, and continues or closes it through the get entity verb
+type entityCursor struct {
+	conn      *TGDBConnection
+	resultId  int
+	batchSize int
+}
+
+func (obj *entityCursor) fetchNext() ([]interface{}, bool, tgdb.TGError) {
+	return obj.conn.continueGetEntities(obj.resultId, obj.batchSize)
+}
+
+func (obj *entityCursor) release() tgdb.TGError {
+	return obj.conn.closeGetEntities(obj.resultId)
+}
+
+// attachEntityCursor turns the result set into a streaming one when the server filled the batch size
+// and kept a cursor open for the rest of the results
+func (obj *TGDBConnection) attachEntityCursor(rSet tgdb.TGResultSet, getRequest *GetEntityRequestMessage) {
+	rs, ok := rSet.(*ResultSet)
+	if !ok || rs == nil {
+		return
+	}
+	if rs.GetResultId() == 0 || rs.Count() < getRequest.GetBatchSize() {
+		return
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside TGDBConnection::attachEntityCursor streaming the results of '%d' in batches of %d", rs.GetResultId(), getRequest.GetBatchSize()))
+	}
+	rs.setCursor(&entityCursor{
+		conn:      obj,
+		resultId:  rs.GetResultId(),
+		batchSize: getRequest.GetBatchSize(),
+	})
+}
+
+// continueGetEntities reads the next batch of results from an open get entities cursor on the server
+func (obj *TGDBConnection) continueGetEntities(resultId, batchSize int) ([]interface{}, bool, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TGDBConnection:continueGetEntities for ResultId: '%d', BatchSize: '%d'", resultId, batchSize))
+	}
+	// Create a channel request
+	msgRequest, channelResponse, err := createChannelRequest(obj, VerbGetEntityRequest)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:continueGetEntities - unable to createChannelRequest(VerbGetEntityRequest w/ error: '%s'", err.Error()))
+		return nil, false, err
+	}
+	getRequest := msgRequest.(*GetEntityRequestMessage)
+	getRequest.SetCommand(GetEntityCommandContinue)
+	getRequest.SetResultId(resultId)
+
+	// Execute request on channel and get the response
+	msgResponse, channelErr := obj.GetChannel().SendRequest(getRequest, channelResponse.(*BlockingChannelResponse))
+	if channelErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:continueGetEntities - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
+		return nil, false, channelErr
+	}
+	response := msgResponse.(*GetEntityResponseMessage)
+	if !response.GetHasResult() {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Returning TGDBConnection:continueGetEntities - result '%d' has no more entities", resultId))
+		}
+		return nil, false, nil
+	}
+	rSet, err := obj.populateResultSetFromGetEntitiesResponse(response)
+	if err != nil {
+		return nil, false, err
+	}
+	results := rSet.(*ResultSet).ResultList
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TGDBConnection:continueGetEntities w/ %d result(s)", len(results)))
+	}
+	return results, len(results) >= batchSize, nil
+}
+
+// closeGetEntities releases an open get entities cursor on the server
+func (obj *TGDBConnection) closeGetEntities(resultId int) tgdb.TGError {
+	msgRequest, channelResponse, err := createChannelRequest(obj, VerbGetEntityRequest)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:closeGetEntities - unable to createChannelRequest(VerbGetEntityRequest w/ error: '%s'", err.Error()))
+		return err
+	}
+	getRequest := msgRequest.(*GetEntityRequestMessage)
+	getRequest.SetCommand(GetEntityCommandClose)
+	getRequest.SetResultId(resultId)
+
+	_, channelErr := obj.GetChannel().SendRequest(getRequest, channelResponse.(*BlockingChannelResponse))
+	if channelErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:closeGetEntities - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
+		return channelErr
+	}
+	return nil
+}
+
 func (obj *TGDBConnection) populateResultSetFromGetEntitiesResponse(msgResponse *GetEntityResponseMessage) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:populateResultSetFromGetEntitiesResponse w/ MsgResponse: '%+v'", msgResponse.String()))
@@ -692,150 +1064,88 @@ func (obj *TGDBConnection) populateResultSetFromGetEntityResponse(msgResponse *G
 // Implement functions from Interface ==> TGConnection
 /////////////////////////////////////////////////////////////////
 
-// Commit commits the current transaction on this connection
-func (obj *TGDBConnection) Commit() (tgdb.TGResultSet, tgdb.TGError) {
+// Begin starts an explicit transaction with its own change tracking, once the open one on this connection has ended
+func (obj *TGDBConnection) Begin(opts *tgdb.TGTransactionOptions) (tgdb.TGTransaction, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Entering TGDBConnection:Commit"))
+		logger.Debug(fmt.Sprint("Entering TGDBConnection:Begin"))
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit - about to loop through addedList to include existing nodes to the changed list if it's part of a new edge"))
+	ctx := context.Background()
+	if opts != nil && opts.Context != nil {
+		ctx = opts.Context
 	}
-	// Include existing nodes to the changed list if it's part of a new edge
-	for _, addEntity := range obj.GetAddedList() {
-		if addEntity.GetEntityKind() == tgdb.EntityKindEdge {
-			nodes := addEntity.(*Edge).GetVertices()
-			if len(nodes) > 0 {
-				for _, vNode := range nodes {
-					node := vNode.(*Node)
-					if !node.GetIsNew() {
-						obj.changedList[node.GetVirtualId()] = node
-						logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:Commit - Existing node '%d' added to change list for a new edge", node.GetVirtualId()))
-					}
-				}
-			}
-		}
+	// The server binds a transaction to the session, not to its id, so only one may be open on the connection
+	select {
+	case obj.txnSlot <- struct{}{}:
+	case <-ctx.Done():
+		errMsg := "TGDBConnection:Begin - gave up waiting for the open transaction on the connection to end"
+		logger.Error(fmt.Sprintf("ERROR: Returning %s", errMsg))
+		return nil, NewTGTransactionAlreadyInProgressException(errMsg)
 	}
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit - about to loop through changedList to include existing nodes to the changed list even for edge update"))
+	msgRequest, channelResponse, err := createChannelRequest(obj, VerbBeginTransactionRequest)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:Begin - unable to createChannelRequest(VerbBeginTransactionRequest w/ error: '%s'", err.Error()))
+		obj.endTransaction()
+		return nil, err
 	}
-	// Need to include existing node to the changed list even for edge update
-	for _, modEntity := range obj.GetChangedList() {
-		if modEntity.GetEntityKind() == tgdb.EntityKindEdge {
-			nodes := modEntity.(*Edge).GetVertices()
-			if len(nodes) > 0 {
-				for _, vNode := range nodes {
-					node := vNode.(*Node)
-					if !node.GetIsNew() {
-						obj.changedList[node.GetVirtualId()] = node
-						logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:Commit - Existing node '%d' added to change list for an existing edge '%d'", node.GetVirtualId(), modEntity.GetVirtualId()))
-					}
-				}
-			}
-		}
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, msgRequest, channelResponse.(*BlockingChannelResponse))
+	if channelErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:Begin - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
+		obj.endTransaction()
+		return nil, channelErr
 	}
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit - about to loop through removedList to include existing nodes to the changed list even for edge update"))
+	response, ok := msgResponse.(*BeginTransactionResponseMessage)
+	if !ok {
+		errMsg := fmt.Sprintf("TGDBConnection:Begin - unexpected response '%+v' to VerbBeginTransactionRequest", msgResponse)
+		logger.Error(fmt.Sprintf("ERROR: Returning %s", errMsg))
+		obj.endTransaction()
+		return nil, GetErrorByType(TGErrorProtocolNotSupported, "", errMsg, "")
 	}
-	// Need to include existing node to the changed list even for edge update
-	for _, delEntity := range obj.GetRemovedList() {
-		if delEntity.GetEntityKind() == tgdb.EntityKindEdge {
-			nodes := delEntity.(*Edge).GetVertices()
-			if len(nodes) > 0 {
-				for _, vNode := range nodes {
-					node := vNode.(*Node)
-					if !node.GetIsNew() {
-						if obj.removedList[node.GetVirtualId()] == nil {
-							obj.changedList[node.GetVirtualId()] = node
-							logger.Warning(fmt.Sprintf("WARNING: TGDBConnection:Commit - Existing node '%d' added to change list for an edge %d to be deleted", node.GetVirtualId(), delEntity.GetVirtualId()))
-						}
-					}
-				}
-			}
-		}
+	txn := NewTransaction(response.GetTransactionId())
+	txn.conn = obj
+	txn.ctx = ctx
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TGDBConnection:Begin w/ transaction '%d'", txn.GetTransactionId()))
 	}
-	//For deleted edge and node, we don't immediately change the effected nodes or edges.
+	return txn, nil
+}
+
+// endTransaction frees the connection for the next explicit transaction
+func (obj *TGDBConnection) endTransaction() {
+	<-obj.txnSlot
+}
+
+// Commit commits the current transaction on this connection
+func (obj *TGDBConnection) Commit() (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.CommitContext(context.Background())
+}
 
+// CommitContext is Commit that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) CommitContext(ctx context.Context) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit about to createChannelRequest() for: VerbCommitTransactionRequest"))
+		logger.Debug(fmt.Sprint("Entering TGDBConnection:Commit"))
 	}
-	// Create a channel request
-	msgRequest, channelResponse, err := createChannelRequest(obj, VerbCommitTransactionRequest)
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
+
+	err := commitChanges(ctx, obj, &changeList{addedList: obj.addedList, changedList: obj.changedList, removedList: obj.removedList})
 	if err != nil {
-		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:Commit - unable to createChannelRequest(VerbCommitTransactionRequest w/ error: '%s'", err.Error()))
+		if _, ok := err.(tgdb.TGTransactionError); ok && obj.rollbackOnCommitFailure() {
+			logger.Warning(fmt.Sprint("WARNING: TGDBConnection:Commit - rolling back the pending changes"))
+			obj.resetChanges()
+		}
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:Commit w/ error: '%s'", err.Error()))
 		return nil, err
 	}
-	queryRequest := msgRequest.(*CommitTransactionRequest)
+	obj.resetChanges()
 
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit about to GetAttributeDescriptors() for: VerbCommitTransactionRequest"))
-	}
-	gof := obj.graphObjFactory
-	attrDescSet, aErr := gof.GetGraphMetaData().GetNewAttributeDescriptors()
-	if aErr != nil {
-		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:Commit - unable to gmd.GetAttributeDescriptors() w/ error: '%s'", aErr.Error()))
-		return nil, aErr
+		logger.Debug(fmt.Sprint("Returning TGDBConnection:Commit"))
 	}
-	queryRequest.AddCommitLists(obj.addedList, obj.changedList, obj.removedList, attrDescSet)
+	return nil, nil
+}
 
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit about to channelSendRequest() for: VerbCommitTransactionRequest"))
-	}
-	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
-	if channelErr != nil {
-		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:Commit - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
-		return nil, channelErr
-	}
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside TGDBConnection::Commit received response for: VerbCommitTransactionRequest as '%+v'", msgResponse))
-	}
-	response := msgResponse.(*CommitTransactionResponse)
-
-	if response.HasException() {
-		logger.Error(fmt.Sprint("ERROR: Returning TGDBConnection:Commit as response has exceptions"))
-		// TODO: Revisit later - Should we not throw an appropriate exception?
-		return nil, nil
-	}
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit about to fixUpAttrDescriptors()"))
-	}
-	fixUpAttrDescriptors(response, attrDescSet)
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside TGDBConnection::Commit about to obj.fixUpEntities()"))
-	}
-	fixUpEntities(obj, response)
-
-	for _, delEntity := range obj.GetRemovedList() {
-		delEntity.SetIsDeleted(true)
-	}
-
-	// Reset and clear all the lists
-	for _, modEntity := range obj.GetChangedList() {
-		modEntity.ResetModifiedAttributes()
-	}
-	for _, newEntity := range obj.GetAddedList() {
-		newEntity.ResetModifiedAttributes()
-	}
-
-	obj.addedList = make(map[int64]tgdb.TGEntity, 0)
-	obj.changedList = make(map[int64]tgdb.TGEntity, 0)
-	obj.removedList = make(map[int64]tgdb.TGEntity, 0)
-	obj.attrByTypeList = make(map[int][]tgdb.TGAttribute, 0)
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Returning TGDBConnection:Commit"))
-	}
-	return nil, nil
-}
-
-// Connect establishes a network connection to the TGDB server
-func (obj *TGDBConnection) Connect() tgdb.TGError {
+// Connect establishes a network connection to the TGDB server
+func (obj *TGDBConnection) Connect() tgdb.TGError {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:Connect for connection: '%+v'", obj))
 	}
@@ -860,12 +1170,14 @@ func (obj *TGDBConnection) Connect() tgdb.TGError {
 
 // CloseQuery closes a specific query and associated objects
 func (obj *TGDBConnection) CloseQuery(queryHashId int64) (tgdb.TGQuery, tgdb.TGError) {
+	return obj.CloseQueryContext(context.Background(), queryHashId)
+}
+
+// CloseQueryContext is CloseQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) CloseQueryContext(ctx context.Context, queryHashId int64) (tgdb.TGQuery, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:CloseQuery for QueryHashId: '%+v'", queryHashId))
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::CloseQuery about to createChannelRequest() for: VerbQueryRequest"))
 	}
@@ -883,7 +1195,7 @@ func (obj *TGDBConnection) CloseQuery(queryHashId int64) (tgdb.TGQuery, tgdb.TGE
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::CloseQuery about to obj.GetChannel().SendRequest() for: VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	_, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	_, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:CloseQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -897,6 +1209,11 @@ func (obj *TGDBConnection) CloseQuery(queryHashId int64) (tgdb.TGQuery, tgdb.TGE
 
 // CreateQuery creates a reusable query object that can be used to execute one or more statement
 func (obj *TGDBConnection) CreateQuery(expr string) (tgdb.TGQuery, tgdb.TGError) {
+	return obj.CreateQueryContext(context.Background(), expr)
+}
+
+// CreateQueryContext is CreateQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) CreateQueryContext(ctx context.Context, expr string) (tgdb.TGQuery, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:CreateQuery for Query: '%+v'", expr))
 	}
@@ -904,9 +1221,6 @@ func (obj *TGDBConnection) CreateQuery(expr string) (tgdb.TGQuery, tgdb.TGError)
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::CreateQuery about to createChannelRequest() for: VerbQueryRequest"))
 	}
@@ -924,7 +1238,7 @@ func (obj *TGDBConnection) CreateQuery(expr string) (tgdb.TGQuery, tgdb.TGError)
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::CreateQuery about to obj.GetChannel().SendRequest() for: VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:CreateQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -986,17 +1300,28 @@ func (obj *TGDBConnection) DecryptBuffer(is tgdb.TGInputStream) ([]byte, tgdb.TG
 	//
 	//logger.Log(fmt.Sprintf("Returning TGDBConnection:DecryptBuffer w/ '%+v'", response.GetDecryptedBuffer()))
 	//return response.GetDecryptedBuffer(), nil
-	cryptoGrapher := obj.GetChannel().GetDataCryptoGrapher()
+	cryptoGrapher, err := getDataCryptoGrapher(obj)
+	if err != nil {
+		return nil, err
+	}
 	return cryptoGrapher.Decrypt(is)
 }
 
 // DecryptEntity decrypts the encrypted entity using channel's data cryptographer
 func (obj *TGDBConnection) DecryptEntity(entityId int64) ([]byte, tgdb.TGError) {
-	buf, err := obj.GetLargeObjectAsBytes(entityId, true)
+	return obj.DecryptEntityContext(context.Background(), entityId)
+}
+
+// DecryptEntityContext is DecryptEntity that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) DecryptEntityContext(ctx context.Context, entityId int64) ([]byte, tgdb.TGError) {
+	buf, err := obj.GetLargeObjectAsBytesContext(ctx, entityId, true)
+	if err != nil {
+		return nil, err
+	}
+	cryptoGrapher, err := getDataCryptoGrapher(obj)
 	if err != nil {
 		return nil, err
 	}
-	cryptoGrapher := obj.GetChannel().GetDataCryptoGrapher()
 	return cryptoGrapher.Decrypt(NewProtocolDataInputStream(buf))
 }
 
@@ -1005,6 +1330,16 @@ func (obj *TGDBConnection) DeleteEntity(entity tgdb.TGEntity) tgdb.TGError {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:DeleteEntity for Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
+	if entity.GetIsNew() {
+		// The server has never seen this entity, so only the pending insert needs to be dropped
+		delete(obj.addedList, entity.GetVirtualId())
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprint("Returning TGDBConnection:DeleteEntity - dropped the pending insert of a new entity"))
+		}
+		return nil
+	}
 	obj.removedList[entity.GetVirtualId()] = entity
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Returning TGDBConnection:DeleteEntity"))
@@ -1034,12 +1369,20 @@ func (obj *TGDBConnection) Disconnect() tgdb.TGError {
 
 // EncryptEntity encrypts the encrypted entity using channel's data cryptographer
 func (obj *TGDBConnection) EncryptEntity(rawBuffer []byte) ([]byte, tgdb.TGError) {
-	cryptoGrapher := obj.GetChannel().GetDataCryptoGrapher()
+	cryptoGrapher, err := getDataCryptoGrapher(obj)
+	if err != nil {
+		return nil, err
+	}
 	return cryptoGrapher.Encrypt(rawBuffer)
 }
 
 // ExecuteGremlinQuery executes a Gremlin Grammer-Based query with  query options
 func (obj *TGDBConnection) ExecuteGremlinQuery(expr string, collection []interface{}, options tgdb.TGQueryOption) ([]interface{}, tgdb.TGError) {
+	return obj.ExecuteGremlinQueryContext(context.Background(), expr, collection, options)
+}
+
+// ExecuteGremlinQueryContext is ExecuteGremlinQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) ExecuteGremlinQueryContext(ctx context.Context, expr string, collection []interface{}, options tgdb.TGQueryOption) ([]interface{}, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:ExecuteGremlinQuery for Query: '%+v'", expr))
 	}
@@ -1047,9 +1390,6 @@ func (obj *TGDBConnection) ExecuteGremlinQuery(expr string, collection []interfa
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteGremlinQuery about to createChannelRequest() for: VerbQueryRequest"))
 	}
@@ -1071,7 +1411,7 @@ func (obj *TGDBConnection) ExecuteGremlinQuery(expr string, collection []interfa
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteGremlinQuery about to obj.GetChannel().SendRequest() for: VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:ExecuteGremlinQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1103,6 +1443,11 @@ func (obj *TGDBConnection) ExecuteGremlinQuery(expr string, collection []interfa
 
 // ExecuteGremlinStrQuery executes a Gremlin Grammer-Based string query with  query options
 func (obj *TGDBConnection) ExecuteGremlinStrQuery(strQuery string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.ExecuteGremlinStrQueryContext(context.Background(), strQuery, options)
+}
+
+// ExecuteGremlinStrQueryContext is ExecuteGremlinStrQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) ExecuteGremlinStrQueryContext(ctx context.Context, strQuery string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:ExecuteGremlinStrQuery for Query: '%+v'", strQuery))
 	}
@@ -1110,9 +1455,6 @@ func (obj *TGDBConnection) ExecuteGremlinStrQuery(strQuery string, options tgdb.
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteGremlinStrQuery about to createChannelRequest() for: VerbQueryRequest"))
 	}
@@ -1134,7 +1476,7 @@ func (obj *TGDBConnection) ExecuteGremlinStrQuery(strQuery string, options tgdb.
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteGremlinStrQuery about to obj.GetChannel().SendRequest() for: VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:ExecuteGremlinStrQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1179,6 +1521,18 @@ func (obj *TGDBConnection) ExecuteGremlinStrQuery(strQuery string, options tgdb.
 
 // ExecuteQuery executes an immediate query with associated query options
 func (obj *TGDBConnection) ExecuteQuery(expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.ExecuteQueryContext(context.Background(), expr, options)
+}
+
+// ExecuteQueryContext is ExecuteQuery that gives up waiting on the server once ctx is cancelled or expires. Failures that the
+// retry policy of the connection retries are retried
+func (obj *TGDBConnection) ExecuteQueryContext(ctx context.Context, expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.retryQuery(ctx, "ExecuteQuery", func() (tgdb.TGResultSet, tgdb.TGError) {
+		return obj.executeQuery(ctx, expr, options)
+	})
+}
+
+func (obj *TGDBConnection) executeQuery(ctx context.Context, expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:ExecuteQuery for Query: '%+v'", expr))
 	}
@@ -1187,32 +1541,27 @@ func (obj *TGDBConnection) ExecuteQuery(expr string, options tgdb.TGQueryOption)
 	cn := GetConfigFromKey(ConnectionDefaultQueryLanguage)
 	queryLang := obj.GetConnectionProperties().GetProperty(cn, "tgql")
 
-	idx := strings.Index(expr, "://")
-	if idx != -1 {
-		tokens := strings.Split(expr, "://")
-		switch tokens[0] {
-		case "tgql":
-			return obj.ExecuteTGDBQuery(tokens[1], options)
-		case "gremlin":
-			return obj.ExecuteGremlinStrQuery(tokens[1], options)
-		default:
-			return NewResultSet(obj, 0), nil
-		}
-	} else {
-		switch queryLang {
-		case "tgql":
-			return obj.ExecuteTGDBQuery(expr, options)
-		case "gremlin":
-			return obj.ExecuteGremlinStrQuery(expr, options)
-		default:
-			return NewResultSet(obj, 0), nil
-		}
+	// Only a leading language prefix is split off, since the query itself may hold "://" in a literal
+	if tokens := strings.SplitN(expr, "://", 2); len(tokens) == 2 && (tokens[0] == "tgql" || tokens[0] == "gremlin") {
+		queryLang, expr = tokens[0], tokens[1]
+	}
+	switch queryLang {
+	case "tgql":
+		return obj.ExecuteTGDBQueryContext(ctx, expr, options)
+	case "gremlin":
+		return obj.ExecuteGremlinStrQueryContext(ctx, expr, options)
+	default:
+		return NewResultSet(obj, 0), nil
 	}
-	return nil, nil
 }
 
 // ExecuteTGDBQuery executes an immediate query with associated query options
 func (obj *TGDBConnection) ExecuteTGDBQuery(expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.ExecuteTGDBQueryContext(context.Background(), expr, options)
+}
+
+// ExecuteTGDBQueryContext is ExecuteTGDBQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) ExecuteTGDBQueryContext(ctx context.Context, expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:ExecuteTGDBQuery for Query: '%+v'", expr))
 	}
@@ -1220,9 +1569,6 @@ func (obj *TGDBConnection) ExecuteTGDBQuery(expr string, options tgdb.TGQueryOpt
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteTGDBQuery about to createChannelRequest() for: VerbQueryRequest"))
 	}
@@ -1244,7 +1590,7 @@ func (obj *TGDBConnection) ExecuteTGDBQuery(expr string, options tgdb.TGQueryOpt
 			logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteTGDBQuery about to obj.GetChannel().SendRequest() for: VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:ExecuteTGDBQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1273,6 +1619,18 @@ func (obj *TGDBConnection) ExecuteTGDBQuery(expr string, options tgdb.TGQueryOpt
 // @param endCondition condition used to stop the traversal
 // @param option Query options for executing. Can be null, then it will use the default option
 func (obj *TGDBConnection) ExecuteQueryWithFilter(expr, edgeFilter, traversalCondition, endCondition string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.ExecuteQueryWithFilterContext(context.Background(), expr, edgeFilter, traversalCondition, endCondition, options)
+}
+
+// ExecuteQueryWithFilterContext is ExecuteQueryWithFilter that gives up waiting on the server once ctx is cancelled or expires. Failures that the
+// retry policy of the connection retries are retried
+func (obj *TGDBConnection) ExecuteQueryWithFilterContext(ctx context.Context, expr, edgeFilter, traversalCondition, endCondition string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.retryQuery(ctx, "ExecuteQueryWithFilter", func() (tgdb.TGResultSet, tgdb.TGError) {
+		return obj.executeQueryWithFilter(ctx, expr, edgeFilter, traversalCondition, endCondition, options)
+	})
+}
+
+func (obj *TGDBConnection) executeQueryWithFilter(ctx context.Context, expr, edgeFilter, traversalCondition, endCondition string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:ExecuteQueryWithFilter for Query: '%+v', EdgeFilter: '%+v', Traversal: '%+v', EndCondition: '%+v'", expr, edgeFilter, traversalCondition, endCondition))
 	}
@@ -1280,9 +1638,6 @@ func (obj *TGDBConnection) ExecuteQueryWithFilter(expr, edgeFilter, traversalCon
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteQueryWithFilter about to createChannelRequest() for: VerbQueryRequest"))
 	}
@@ -1307,7 +1662,7 @@ func (obj *TGDBConnection) ExecuteQueryWithFilter(expr, edgeFilter, traversalCon
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteQueryWithFilter about to obj.GetChannel().SendRequest() for: VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:ExecuteQueryWithFilter - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1330,6 +1685,18 @@ func (obj *TGDBConnection) ExecuteQueryWithFilter(expr, edgeFilter, traversalCon
 
 // ExecuteQueryWithId executes an immediate query for specified id & query options
 func (obj *TGDBConnection) ExecuteQueryWithId(queryHashId int64, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.ExecuteQueryWithIdContext(context.Background(), queryHashId, options)
+}
+
+// ExecuteQueryWithIdContext is ExecuteQueryWithId that gives up waiting on the server once ctx is cancelled or expires. Failures that the
+// retry policy of the connection retries are retried
+func (obj *TGDBConnection) ExecuteQueryWithIdContext(ctx context.Context, queryHashId int64, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.retryQuery(ctx, "ExecuteQueryWithId", func() (tgdb.TGResultSet, tgdb.TGError) {
+		return obj.executeQueryWithId(ctx, queryHashId, options)
+	})
+}
+
+func (obj *TGDBConnection) executeQueryWithId(ctx context.Context, queryHashId int64, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:ExecuteQueryWithId for QueryHashId: '%+v'", queryHashId))
 	}
@@ -1337,9 +1704,6 @@ func (obj *TGDBConnection) ExecuteQueryWithId(queryHashId int64, options tgdb.TG
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteQueryWithId about to createChannelRequest() for: VerbQueryRequest"))
 	}
@@ -1361,7 +1725,7 @@ func (obj *TGDBConnection) ExecuteQueryWithId(queryHashId int64, options tgdb.TG
 		logger.Debug(fmt.Sprint("Inside TGDBConnection::ExecuteQueryWithId about to obj.GetChannel().SendRequest() for: VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	_, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	_, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:ExecuteQueryWithId - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1400,6 +1764,11 @@ func (obj *TGDBConnection) GetConnectionProperties() tgdb.TGProperties {
 
 // GetEntities gets a result set of entities given an non-uniqueKey
 func (obj *TGDBConnection) GetEntities(qryKey tgdb.TGKey, props tgdb.TGProperties) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.GetEntitiesContext(context.Background(), qryKey, props)
+}
+
+// GetEntitiesContext is GetEntities that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) GetEntitiesContext(ctx context.Context, qryKey tgdb.TGKey, props tgdb.TGProperties) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:GetEntities for QueryKey: '%+v'", qryKey))
 	}
@@ -1408,9 +1777,6 @@ func (obj *TGDBConnection) GetEntities(qryKey tgdb.TGKey, props tgdb.TGPropertie
 		logger.Error(fmt.Sprint("ERROR: Returning TGDBConnection:GetEntities - unable to InitMetadata"))
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if props == nil {
 		props = NewQueryOption()
 	}
@@ -1432,7 +1798,7 @@ func (obj *TGDBConnection) GetEntities(qryKey tgdb.TGKey, props tgdb.TGPropertie
 			logger.Debug(fmt.Sprint("Inside TGDBConnection::GetEntities about to obj.GetChannel().SendRequest() for: VerbGetEntityRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:GetEntities - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1450,11 +1816,21 @@ func (obj *TGDBConnection) GetEntities(qryKey tgdb.TGKey, props tgdb.TGPropertie
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Returning TGDBConnection:GetEntities w/ '%+v'", response))
 	}
-	return obj.populateResultSetFromGetEntitiesResponse(response)
+	rSet, err := obj.populateResultSetFromGetEntitiesResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	obj.attachEntityCursor(rSet, queryRequest)
+	return rSet, nil
 }
 
 // GetEntity gets an Entity given an UniqueKey for the Object
 func (obj *TGDBConnection) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQueryOption) (tgdb.TGEntity, tgdb.TGError) {
+	return obj.GetEntityContext(context.Background(), qryKey, options)
+}
+
+// GetEntityContext is GetEntity that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) GetEntityContext(ctx context.Context, qryKey tgdb.TGKey, options tgdb.TGQueryOption) (tgdb.TGEntity, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:GetEntity for QueryKey: '%+v'", qryKey))
 	}
@@ -1463,9 +1839,6 @@ func (obj *TGDBConnection) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQueryOpti
 		logger.Error(fmt.Sprint("ERROR: Returning TGDBConnection:GetEntity - unable to InitMetadata"))
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if options == nil {
 		options = NewQueryOption()
 	}
@@ -1487,7 +1860,7 @@ func (obj *TGDBConnection) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQueryOpti
 			logger.Debug(fmt.Sprint("Inside TGDBConnection::GetEntity about to obj.GetChannel().SendRequest() for: VerbGetEntityRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:GetEntity - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1510,13 +1883,15 @@ func (obj *TGDBConnection) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQueryOpti
 
 // GetGraphMetadata gets the Graph Metadata
 func (obj *TGDBConnection) GetGraphMetadata(refresh bool) (tgdb.TGGraphMetadata, tgdb.TGError) {
+	return obj.GetGraphMetadataContext(context.Background(), refresh)
+}
+
+// GetGraphMetadataContext is GetGraphMetadata that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) GetGraphMetadataContext(ctx context.Context, refresh bool) (tgdb.TGGraphMetadata, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Entering TGDBConnection:GetGraphMetadata"))
 	}
 	if refresh {
-		obj.connPoolImpl.AdminLock()
-		defer obj.connPoolImpl.AdminUnlock()
-
 		if logger.IsDebug() {
 					logger.Debug(fmt.Sprint("Inside TGDBConnection::GetGraphMetadata about to createChannelRequest() for: VerbMetadataRequest"))
 		}
@@ -1535,7 +1910,7 @@ func (obj *TGDBConnection) GetGraphMetadata(refresh bool) (tgdb.TGGraphMetadata,
 					logger.Debug(fmt.Sprint("Inside TGDBConnection::GetGraphMetadata about to obj.GetChannel().SendRequest() for: VerbMetadataRequest"))
 		}
 		// Execute request on channel and get the response
-		msgResponse, channelErr := obj.GetChannel().SendRequest(metaRequest, channelResponse.(*BlockingChannelResponse))
+		msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, metaRequest, channelResponse.(*BlockingChannelResponse))
 		if channelErr != nil {
 			logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:GetGraphMetadata - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 			return nil, channelErr
@@ -1584,6 +1959,11 @@ func (obj *TGDBConnection) GetGraphObjectFactory() (tgdb.TGGraphObjectFactory, t
 
 // GetLargeObjectAsBytes gets an Binary Large Object Entity given an UniqueKey for the Object
 func (obj *TGDBConnection) GetLargeObjectAsBytes(entityId int64, decryptFlag bool) ([]byte, tgdb.TGError) {
+	return obj.GetLargeObjectAsBytesContext(context.Background(), entityId, decryptFlag)
+}
+
+// GetLargeObjectAsBytesContext is GetLargeObjectAsBytes that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TGDBConnection) GetLargeObjectAsBytesContext(ctx context.Context, entityId int64, decryptFlag bool) ([]byte, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:GetLargeObjectAsBytes for EntityId: '%+v'", entityId))
 	}
@@ -1592,9 +1972,6 @@ func (obj *TGDBConnection) GetLargeObjectAsBytes(entityId int64, decryptFlag boo
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:GetLargeObjectAsBytes - unable to initialize metadata w/ error: '%s'", err.Error()))
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside TGDBConnection::GetLargeObjectAsBytes about to createChannelRequest() for: VerbGetLargeObjectRequest"))
 	}
@@ -1612,7 +1989,7 @@ func (obj *TGDBConnection) GetLargeObjectAsBytes(entityId int64, decryptFlag boo
 			logger.Debug(fmt.Sprint("Inside TGDBConnection::GetLargeObjectAsBytes about to obj.GetChannel().SendRequest() for: VerbGetLargeObjectRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:GetLargeObjectAsBytes - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -1644,6 +2021,8 @@ func (obj *TGDBConnection) InsertEntity(entity tgdb.TGEntity) tgdb.TGError {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:InsertEntity to insert Entity: '%+v'", entity.GetEntityType()))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	obj.addedList[entity.GetVirtualId()] = entity
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Returning TGDBConnection:InsertEntity"))
@@ -1651,13 +2030,26 @@ func (obj *TGDBConnection) InsertEntity(entity tgdb.TGEntity) tgdb.TGError {
 	return nil
 }
 
+// resetChanges forgets every pending insert, update and delete. The caller holds txnLock.
+func (obj *TGDBConnection) resetChanges() {
+	obj.addedList = make(map[int64]tgdb.TGEntity, 0)
+	obj.changedList = make(map[int64]tgdb.TGEntity, 0)
+	obj.removedList = make(map[int64]tgdb.TGEntity, 0)
+	obj.attrByTypeList = make(map[int][]tgdb.TGAttribute, 0)
+}
+
+// rollbackOnCommitFailure reports whether a rejected commit discards the pending changes
+func (obj *TGDBConnection) rollbackOnCommitFailure() bool {
+	return obj.GetConnectionProperties().GetPropertyAsBoolean(GetConfigFromKey(ConnectionRollbackOnCommitFailure))
+}
+
 // Rollback rolls back the current transaction on this connection
 func (obj *TGDBConnection) Rollback() tgdb.TGError {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Entering TGDBConnection:Rollback"))
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 
 	// Reset all the lists to empty contents
 	obj.addedList = make(map[int64]tgdb.TGEntity, 0)
@@ -1676,6 +2068,29 @@ func (obj *TGDBConnection) SetExceptionListener(listener tgdb.TGConnectionExcept
 	obj.connPoolImpl.SetExceptionListener(listener) //delegate it to the Pool.
 }
 
+// GetRetryPolicy gets the policy used to retry queries and closure-based commits. Until one is set, it is built
+// from the tgdb.connection.retry* connection properties
+func (obj *TGDBConnection) GetRetryPolicy() *tgdb.TGRetryPolicy {
+	obj.retryLock.Lock()
+	defer obj.retryLock.Unlock()
+	if obj.retryPolicy == nil {
+		obj.retryPolicy = NewRetryPolicy(obj.GetConnectionProperties())
+	}
+	return obj.retryPolicy
+}
+
+// SetRetryPolicy sets the policy used to retry queries and closure-based commits. Nil restores the configured one
+func (obj *TGDBConnection) SetRetryPolicy(policy *tgdb.TGRetryPolicy) {
+	obj.retryLock.Lock()
+	defer obj.retryLock.Unlock()
+	if policy == nil {
+		obj.retryPolicy = nil
+		return
+	}
+	p := *policy
+	obj.retryPolicy = &p
+}
+
 // UpdateEntity marks an ENTITY for update operation. Upon commit, the entity will be updated in the database
 // When commit is called, the object is resolved to check if it is dirty. Entity.setAttribute calls make the entity
 // dirty. If it is dirty, then the object is send to the server for update, otherwise it is ignored.
@@ -1685,6 +2100,8 @@ func (obj *TGDBConnection) UpdateEntity(entity tgdb.TGEntity) tgdb.TGError {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:UpdateEntity to update Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	obj.changedList[entity.GetVirtualId()] = entity
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Returning TGDBConnection:UpdateEntity"))
@@ -1722,6 +2139,8 @@ func (obj *TGDBConnection) EntityCreated(entity tgdb.TGEntity) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:EntityCreated to add Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := entity.(*AbstractEntity).GetVirtualId()
 	obj.addedList[entityId] = entity
 	if logger.IsDebug() {
@@ -1734,6 +2153,8 @@ func (obj *TGDBConnection) EntityDeleted(entity tgdb.TGEntity) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering TGDBConnection:EntityDeleted to delete Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := entity.(*AbstractEntity).GetVirtualId()
 	obj.removedList[entityId] = entity
 	if logger.IsDebug() {
@@ -1746,6 +2167,8 @@ func (obj *TGDBConnection) NodeAdded(graph tgdb.TGGraph, node tgdb.TGNode) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:NodeAdded to add Node: '%+v' to Graph: '%+v'", node, graph))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := graph.(*Graph).GetVirtualId()
 	obj.addedList[entityId] = graph
 	if logger.IsDebug() {
@@ -1758,6 +2181,8 @@ func (obj *TGDBConnection) NodeRemoved(graph tgdb.TGGraph, node tgdb.TGNode) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering TGDBConnection:NodeRemoved to remove Node: '%+v' to Graph: '%+v'", node, graph))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := graph.(*Graph).GetVirtualId()
 	obj.removedList[entityId] = graph
 	if logger.IsDebug() {
@@ -1805,15 +2230,28 @@ const (
 
 type ConnectionPoolImpl struct {
 	adminLock             sync.RWMutex // rw-lock for synchronizing read-n-update of connection pool properties
+	channelUrl            tgdb.TGChannelUrl
 	connectReserveTimeOut time.Duration
 	connList              []tgdb.TGConnection // Total Available Connections (Active + Dead/ToBeReused)
 	connType              tgdb.TypeConnection
-	chanPool              chan tgdb.TGConnection
+	connectedAt           map[int64]time.Time // When the pool connected each connection
+	credentials           tgdb.TGCredentialProvider
+	idleConns             []tgdb.TGConnection // Free connections, the longest released first
+	waiters               *list.List          // chan tgdb.TGConnection of every caller waiting in Get, in arrival order
+	healthCheckInterval   time.Duration
+	listeners             []tgdb.TGConnectionPoolListener
+	maxLifetime           time.Duration
+	minIdle               int
 	poolProperties        tgdb.TGProperties
 	consumers             map[int64]tgdb.TGConnection        // Active/In-Use Connections
 	exceptionListener     tgdb.TGConnectionExceptionListener // Function Pointer
 	poolSize              int
 	poolState             int
+	retryLock             sync.Mutex
+	retryPolicy           *tgdb.TGRetryPolicy
+	sharedChannel         tgdb.TGChannel             // Channel of all the connections unless useDedicateChannel is set
+	stats                 tgdb.TGConnectionPoolStats // Only the counters are kept up to date, Stats() adds the rest
+	stopHealthCheck       chan struct{}
 	useDedicateChannel    bool
 }
 
@@ -1828,9 +2266,12 @@ func defaultTGConnectionPool() *ConnectionPoolImpl {
 
 	//once.Do(func() {
 	gInstance := &ConnectionPoolImpl{
-		connList:  make([]tgdb.TGConnection, 0),
-		connType:  tgdb.TypeConventional,
-		consumers: make(map[int64]tgdb.TGConnection, 0),
+		connList:    make([]tgdb.TGConnection, 0),
+		connType:    tgdb.TypeConventional,
+		connectedAt: make(map[int64]time.Time, 0),
+		idleConns:   make([]tgdb.TGConnection, 0),
+		waiters:     list.New(),
+		consumers:   make(map[int64]tgdb.TGConnection, 0),
 	}
 	gInstance.poolSize, _ = strconv.Atoi(GetConfigFromKey(ConnectionPoolDefaultPoolSize).GetDefaultValue())
 	gInstance.useDedicateChannel, _ = strconv.ParseBool(GetConfigFromKey(ConnectionPoolUseDedicatedChannelPerConnection).GetDefaultValue())
@@ -1839,18 +2280,24 @@ func defaultTGConnectionPool() *ConnectionPoolImpl {
 }
 
 func NewTGConnectionPool(url tgdb.TGChannelUrl, poolSize int, props *SortedProperties, connType tgdb.TypeConnection) *ConnectionPoolImpl {
+	return NewTGConnectionPoolWithCredentials(url, poolSize, props, connType, takeCredentialProvider(props, ""))
+}
+
+// NewTGConnectionPoolWithCredentials returns a pool whose channels authenticate with the credentials the provider hands out
+func NewTGConnectionPoolWithCredentials(url tgdb.TGChannelUrl, poolSize int, props *SortedProperties, connType tgdb.TypeConnection, credentials tgdb.TGCredentialProvider) *ConnectionPoolImpl {
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Entering ConnectionPoolImpl:NewTGConnectionPool w/ ChannelURL: '%+v', Poolsize: '%d'", url.GetUrlAsString(), poolSize))
+			logger.Debug(fmt.Sprintf("Entering ConnectionPoolImpl:NewTGConnectionPool w/ ChannelURL: '%+v', Poolsize: '%d'", redactUrl(url.GetUrlAsString()), poolSize))
 	}
 	cp := defaultTGConnectionPool()
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl:NewTGConnectionPool w/ Default Connection Pool: '%s'", cp.String()))
 	}
+	cp.channelUrl = url
 	cp.connType = connType
+	cp.credentials = credentials
 	cp.poolProperties = props
-	cp.chanPool = make(chan tgdb.TGConnection, poolSize+2)
 	cp.poolSize = poolSize
-	timeoutStr := GetConfigFromKey(ConnectionReserveTimeoutSeconds).GetDefaultValue()
+	timeoutStr := props.GetProperty(GetConfigFromKey(ConnectionReserveTimeoutSeconds), GetConfigFromKey(ConnectionReserveTimeoutSeconds).GetDefaultValue())
 	if timeoutStr == Immediate {
 		cp.connectReserveTimeOut = time.Second * IMMEDIATE
 	} else if timeoutStr == Indefinite {
@@ -1859,39 +2306,20 @@ func NewTGConnectionPool(url tgdb.TGChannelUrl, poolSize int, props *SortedPrope
 		timeout, _ := strconv.Atoi(timeoutStr)
 		cp.connectReserveTimeOut = time.Second * time.Duration(timeout)
 	}
-	var ch tgdb.TGChannel
-	channelFactory := GetChannelFactoryInstance()
+	cp.minIdle = props.GetPropertyAsInt(GetConfigFromKey(ConnectionPoolMinIdle))
+	cp.maxLifetime = time.Second * time.Duration(props.GetPropertyAsInt(GetConfigFromKey(ConnectionPoolMaxLifetimeSeconds)))
+	cp.healthCheckInterval = time.Second * time.Duration(props.GetPropertyAsInt(GetConfigFromKey(ConnectionPoolHealthCheckIntervalSeconds)))
+	cp.retryPolicy = NewRetryPolicy(props)
 	for i := 0; i < cp.poolSize; i++ {
-		if ch == nil || cp.useDedicateChannel {
-			if logger.IsDebug() {
-							logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl:NewTGConnectionPool - about to channelFactory.CreateChannelWithUrlProperties() for URL: '%s'", url.GetUrlAsString()))
-			}
-			// Create a channel from channel factory
-			channel1, err := channelFactory.CreateChannelWithUrlProperties(url, props)
-			if err != nil {
-				errMsg := fmt.Sprintf("ERROR: Returning ConnectionPoolImpl:NewTGConnectionPool Unable to create a channel for URL: '%s' via channel factory - '%+v'", url, err.Error())
-				logger.Error(errMsg)
-				continue
-			}
-			ch = channel1
-		}
-		//logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl:NewTGConnectionPool - about to NewTGDBConnection() for Channel: '%+v' and Properties: '%+v'", ch, props))
-		// Create a connection
-		var conn tgdb.TGConnection
-		switch connType {
-		case tgdb.TypeConventional:
-			conn = NewTGDBConnection(cp, ch, props)
-		case tgdb.TypeAdmin:
-			conn = NewAdminConnection(cp, ch, props)
-		default:
-			conn = NewTGDBConnection(cp, ch, props)
+		conn, err := cp.newPoolConnection()
+		if err != nil {
+			errMsg := fmt.Sprintf("ERROR: Returning ConnectionPoolImpl:NewTGConnectionPool Unable to create a channel for URL: '%s' via channel factory - '%+v'", url, err.Error())
+			logger.Error(errMsg)
+			continue
 		}
-		conn.SetConnectionPool(cp)
-		conn.SetConnectionProperties(props)
 		// Add it in the pool to initialize the pool with a set number of initialized connections
 		cp.connList = append(cp.connList, conn)
-		//logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl:NewTGConnectionPool - about to add conn: '%+v' to the pool", conn.String()))
-		cp.chanPool <- conn
+		cp.idleConns = append(cp.idleConns, conn)
 	} // End of For loop for Pool Size
 	cp.poolState = ConnectionPoolInitialized
 	if logger.IsDebug() {
@@ -1901,149 +2329,561 @@ func NewTGConnectionPool(url tgdb.TGChannelUrl, poolSize int, props *SortedPrope
 }
 
 /////////////////////////////////////////////////////////////////
-// Helper functions from Interface ==> TGConnectionPool
+// Private functions for ConnectionPoolImpl
 /////////////////////////////////////////////////////////////////
 
-// GetConnectionList returns all the connections = Active/In-Use + Un-used/Initialized
-func (obj *ConnectionPoolImpl) GetConnectionList() []tgdb.TGConnection {
-	return obj.connList
+// newPoolConnection creates a connection of the pool type on a new channel, or on the shared channel of the pool
+// as long as that one is usable
+func (obj *ConnectionPoolImpl) newPoolConnection() (tgdb.TGConnection, tgdb.TGError) {
+	obj.adminLock.Lock()
+	ch := obj.sharedChannel
+	if obj.useDedicateChannel || ch == nil || isChannelBroken(ch) {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl:newPoolConnection - about to channelFactory.CreateChannelWithUrlProperties() for URL: '%s'", redactUrl(obj.channelUrl.GetUrlAsString())))
+		}
+		// Create a channel from channel factory
+		channel1, err := GetChannelFactoryInstance().CreateChannelWithUrlProperties(obj.channelUrl, obj.poolProperties.(*SortedProperties))
+		if err != nil {
+			obj.adminLock.Unlock()
+			return nil, err
+		}
+		ch = channel1
+		ch.SetCredentialProvider(obj.credentials)
+		ch.SetExceptionListener(&poolChannelListener{pool: obj, channel: ch})
+		if !obj.useDedicateChannel {
+			obj.sharedChannel = ch
+		}
+	}
+	obj.adminLock.Unlock()
+
+	// Create a connection
+	var conn tgdb.TGConnection
+	switch obj.connType {
+	case tgdb.TypeConventional:
+		conn = NewTGDBConnection(obj, ch, obj.poolProperties)
+	case tgdb.TypeAdmin:
+		conn = NewAdminConnection(obj, ch, obj.poolProperties)
+	default:
+		conn = NewTGDBConnection(obj, ch, obj.poolProperties)
+	}
+	conn.SetConnectionPool(obj)
+	conn.SetConnectionProperties(obj.poolProperties)
+	conn.SetRetryPolicy(obj.GetRetryPolicy())
+	return conn, nil
 }
 
-// GetConnectionProperties returns all the connection properties
-func (obj *ConnectionPoolImpl) GetConnectionProperties() tgdb.TGProperties {
-	return obj.poolProperties
+// isChannelBroken checks whether the channel has failed or been closed, so that it can no longer carry requests
+func isChannelBroken(ch tgdb.TGChannel) bool {
+	if ch.IsClosed() {
+		return true
+	}
+	switch ch.GetLinkState() {
+	case tgdb.LinkClosing, tgdb.LinkClosed, tgdb.LinkFailedOnSend, tgdb.LinkFailedOnRecv, tgdb.LinkFailedOnProcessing, tgdb.LinkTerminated:
+		return true
+	}
+	return false
 }
 
-// GetActiveConnections returns all the Active/In-Use connections
-func (obj *ConnectionPoolImpl) GetActiveConnections() map[int64]tgdb.TGConnection {
-	return obj.consumers
+// maxHealthCheckTimeout bounds how long the health check waits for the server to answer
+const maxHealthCheckTimeout = 5 * time.Second
+
+// isConnectionUsable checks that the channel of the connection is connected, without asking the server
+func isConnectionUsable(conn tgdb.TGConnection) bool {
+	ch := conn.GetChannel()
+	return ch != nil && !isChannelBroken(ch) && ch.GetLinkState() == tgdb.LinkConnected
 }
 
-// GetNoOfActiveConnections returns the count of Active/In-Use connections
-func (obj *ConnectionPoolImpl) GetNoOfActiveConnections() int {
-	return len(obj.consumers)
+// isConnectionHealthy asks the server for the metadata on the channel of the connection, and waits up to timeout
+// for the answer. The server does not answer pings, so they cannot tell a live server from a hung one
+func isConnectionHealthy(conn tgdb.TGConnection, timeout time.Duration) bool {
+	if !isConnectionUsable(conn) {
+		return false
+	}
+	request, response, err := createChannelRequest(conn, VerbMetadataRequest)
+	if err != nil {
+		logger.Warning(fmt.Sprintf("WARNING: ConnectionPoolImpl:isConnectionHealthy - unable to create a request on connection '%d' w/ error: '%s'", conn.GetConnectionId(), err.Error()))
+		return false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	_, err = conn.GetChannel().SendRequestContext(ctx, request, response)
+	if err != nil {
+		logger.Warning(fmt.Sprintf("WARNING: ConnectionPoolImpl:isConnectionHealthy - no answer on connection '%d' w/ error: '%s'", conn.GetConnectionId(), err.Error()))
+		return false
+	}
+	return true
 }
 
-// GetPoolState returns current state of the connection pool
-func (obj *ConnectionPoolImpl) GetPoolState() int {
-	return obj.poolState
+// handOff gives a free connection to the longest waiting caller, or parks it in the idle list. The caller holds adminLock
+func (obj *ConnectionPoolImpl) handOff(conn tgdb.TGConnection) {
+	if front := obj.waiters.Front(); front != nil {
+		waiter := obj.waiters.Remove(front).(chan tgdb.TGConnection)
+		obj.consumers[conn.GetConnectionId()] = conn
+		waiter <- conn
+		return
+	}
+	obj.idleConns = append(obj.idleConns, conn)
 }
 
-// GetConnection returns an available connection from the pool that is NOT being used or nil if timeout elapses
-func (obj *ConnectionPoolImpl) GetConnection() (tgdb.TGConnection, tgdb.TGError) {
-	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Entering ConnectionPoolImpl:GetConnection for Pool Type: '%+v'", obj.connType))
+// acquire takes the longest idle connection, or waits in line for one until deadline fires or ctx is done
+func (obj *ConnectionPoolImpl) acquire(ctx context.Context, deadline <-chan time.Time) (tgdb.TGConnection, bool, tgdb.TGError) {
+	obj.adminLock.Lock()
+	if len(obj.idleConns) > 0 {
+		conn := obj.idleConns[0]
+		obj.idleConns = obj.idleConns[1:]
+		obj.consumers[conn.GetConnectionId()] = conn
+		obj.adminLock.Unlock()
+		return conn, false, nil
+	}
+	waiter := make(chan tgdb.TGConnection, 1)
+	elem := obj.waiters.PushBack(waiter)
+	obj.adminLock.Unlock()
+
+	var err tgdb.TGError
+	select {
+	case conn := <-waiter:
+		return conn, true, nil
+	case <-deadline:
+		logger.Warning(fmt.Sprintf("WARNING: Returning ConnectionPoolImpl:GetConnection - trying to get a connection after wating for '%+v'", obj.connectReserveTimeOut))
+		errMsg := fmt.Sprintf("Timed out trying to get a connection after wating for %v", obj.connectReserveTimeOut)
+		err = GetErrorByType(TGErrorConnectionTimeout, "", errMsg, "")
+	case <-ctx.Done():
+		err = channelContextError(ctx, "Stopped waiting for a connection from the pool")
 	}
+
 	obj.adminLock.Lock()
 	defer obj.adminLock.Unlock()
-
-	if obj.GetNoOfActiveConnections() == obj.GetPoolSize() {
-		logger.Error(fmt.Sprint("ERROR: Returning ConnectionPoolImpl:GetConnection - as all the connections in the pool are in use."))
-		errMsg := "ConnectionPoolImpl has already exhausted its limit. All the connections in the pool are in use. Please wait and retry."
-		return nil, GetErrorByType(TGErrorGeneralException, "", errMsg, "")
+	for e := obj.waiters.Front(); e != nil; e = e.Next() {
+		if e == elem {
+			obj.waiters.Remove(elem)
+			return nil, true, err
+		}
 	}
+	// A connection was handed over while giving up - take it rather than lose it
+	return <-waiter, true, nil
+}
 
-	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl:GetConnection - about to pull connection from obj.ConnPool"))
+// prepareForHandOut connects a connection that the pool has not connected yet, and checks the lifetime and health
+// of the others. A connection that fails is evicted, and false returned so that the caller acquires another one
+func (obj *ConnectionPoolImpl) prepareForHandOut(conn tgdb.TGConnection) (bool, tgdb.TGError) {
+	obj.adminLock.RLock()
+	state := obj.poolState
+	since, connected := obj.connectedAt[conn.GetConnectionId()]
+	obj.adminLock.RUnlock()
+
+	// Connections of a pool that is not connected are connected by the application, as they always were
+	if state != ConnectionPoolConnected {
+		return true, nil
 	}
-	var conn tgdb.TGConnection
-	// Search through all available connections within the pooled set to find out which one is free to service next
-	select {
-	case conn = <-obj.chanPool:
-		//logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl::GetConnection about to verify the state of the connection: '%+v' pulled from obj.ConnPool:", conn))
-		// Proceed with the connection that is NOT being used already a.k.a. part of consumer connection map
-		if _, ok := obj.consumers[conn.GetConnectionId()]; !ok {
-			// Add it in the pool to initialize the pool with a set number of initialized connections
-			obj.consumers[conn.GetConnectionId()] = conn
-			break
+	if !connected {
+		if err := conn.Connect(); err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning ConnectionPoolImpl:GetConnection - unable to conn.Connect() w/ '%s'", err.Error()))
+			_, _ = obj.ReleaseConnection(conn)
+			return false, err
 		}
-	case <-time.After(obj.connectReserveTimeOut):
-		logger.Warning(fmt.Sprintf("WARNING: Returning ConnectionPoolImpl:GetConnection - trying to get a connection after wating for '%+v'", obj.connectReserveTimeOut))
-		//log.Warning("Timed out trying to get connection from the pool")
-		errMsg := fmt.Sprintf("Timed out trying to get a connection after wating for %v", obj.connectReserveTimeOut)
-		return nil, GetErrorByType(TGErrorGeneralException, "", errMsg, "")
+		obj.markConnected(conn)
+		return true, nil
 	}
-
-	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning ConnectionPoolImpl:GetConnection w/ Connection: '%+v'", conn))
+	if obj.isExpired(since) {
+		obj.evict(conn, "it reached its max lifetime")
+		return false, nil
 	}
-	return conn, nil
+	if !isConnectionUsable(conn) {
+		obj.evict(conn, "its channel is broken")
+		return false, nil
+	}
+	return true, nil
 }
 
-/////////////////////////////////////////////////////////////////
-// Implement functions from Interface ==> TGConnectionPool
-/////////////////////////////////////////////////////////////////
+func (obj *ConnectionPoolImpl) markConnected(conn tgdb.TGConnection) {
+	obj.adminLock.Lock()
+	obj.connectedAt[conn.GetConnectionId()] = time.Now()
+	obj.adminLock.Unlock()
+}
 
-// AdminLock locks the connection pool so that the list of connections can be updated
-func (obj *ConnectionPoolImpl) AdminLock() {
-	obj.adminLock.RLock()
+// healthCheckTimeout is how long the health check waits for an answer - the check interval, up to maxHealthCheckTimeout
+func (obj *ConnectionPoolImpl) healthCheckTimeout() time.Duration {
+	if obj.healthCheckInterval < maxHealthCheckTimeout {
+		return obj.healthCheckInterval
+	}
+	return maxHealthCheckTimeout
 }
 
-// AdminUnlock unlocks the connection pool so that the list of connections can be updated
-func (obj *ConnectionPoolImpl) AdminUnlock() {
-	obj.adminLock.RUnlock()
+func (obj *ConnectionPoolImpl) isExpired(connectedAt time.Time) bool {
+	return obj.maxLifetime > 0 && time.Since(connectedAt) > obj.maxLifetime
 }
 
-// Connect establishes connection from this pool of available/configured connections to the TGDB server
-// Exception could be BadAuthentication or BadUrl
-func (obj *ConnectionPoolImpl) Connect() tgdb.TGError {
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Entering ConnectionPoolImpl:Connect"))
+// recordAcquisition counts a call to Get that handed out conn, or failed with err
+func (obj *ConnectionPoolImpl) recordAcquisition(conn tgdb.TGConnection, waited bool, wait time.Duration, err tgdb.TGError) {
+	obj.adminLock.Lock()
+	if waited {
+		obj.stats.WaitTime += wait
+	}
+	if conn != nil {
+		obj.stats.Acquisitions++
+		if waited {
+			obj.stats.Waits++
+		}
+	} else if err.GetErrorType() == TGErrorConnectionTimeout {
+		obj.stats.Timeouts++
+	}
+	obj.adminLock.Unlock()
+	if conn == nil {
+		return
 	}
+	if !waited {
+		wait = 0
+	}
+	for _, listener := range obj.poolListeners() {
+		listener.OnAcquire(conn, wait)
+	}
+}
+
+func (obj *ConnectionPoolImpl) poolListeners() []tgdb.TGConnectionPoolListener {
 	obj.adminLock.RLock()
 	defer obj.adminLock.RUnlock()
+	return append([]tgdb.TGConnectionPoolListener(nil), obj.listeners...)
+}
 
-	if obj.poolState == ConnectionPoolConnected {
-		logger.Error(fmt.Sprint("ERROR: Returning ConnectionPoolImpl:Connect - ConnectionPoolImpl is already connected. Disconnect and then reconnect."))
-		errMsg := "ConnectionPoolImpl is already connected. Disconnect and then reconnect"
-		return GetErrorByType(TGErrorGeneralException, "", errMsg, "")
-	}
-	// Set the state to connecting
-	obj.poolState = ConnectionPoolConnecting
+// retryCounter is implemented by the connections that count their retries
+type retryCounter interface {
+	GetRetryCount() int64
+	GetRetryFailureCount() int64
+}
 
-	// Attempt to connect using each of the available connections in the pool
-	for i := 0; i < len(obj.connList); i++ {
-		conn := obj.connList[i]
+// poolChannelListener counts the reconnects of a channel of the pool, and passes its failures on to the
+// exception listener of the pool
+type poolChannelListener struct {
+	pool    *ConnectionPoolImpl
+	channel tgdb.TGChannel
+}
 
+func (obj *poolChannelListener) OnException(ex tgdb.TGError, duringClose bool) {
+	obj.pool.adminLock.RLock()
+	listener := obj.pool.exceptionListener
+	obj.pool.adminLock.RUnlock()
+	if listener != nil && !duringClose {
+		listener.OnException(ex)
+	}
+}
 
-		// Proceed when the connection is either in Initialized OR Disconnected (OR Stopped???) state
-		if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl::Connect Consumer Loop about to conn.Connect() using connection: '%+v'", conn))
-		}
-		err := conn.Connect()
-		if err != nil {
-			logger.Error(fmt.Sprintf("ERROR: Returning ConnectionPoolImpl:Connect - unable to conn.Connect() w/ '%s'", err.Error()))
-			// TODO: Revisit later - Decide what error to throw and whether to continue / break
-			return err
+func (obj *poolChannelListener) OnReconnect(url tgdb.TGChannelUrl) {
+	logger.Warning(fmt.Sprintf("WARNING: Inside ConnectionPoolImpl:OnReconnect - channel reconnected to url: '%s'", redactUrl(url.GetUrlAsString())))
+	obj.pool.adminLock.Lock()
+	obj.pool.stats.Reconnects++
+	conns := make([]tgdb.TGConnection, 0)
+	for _, conn := range obj.pool.connList {
+		if conn.GetChannel() == obj.channel {
+			conns = append(conns, conn)
 		}
 	}
-
-	// Set the state to connecting
-	obj.poolState = ConnectionPoolConnected
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Returning ConnectionPoolImpl:Connect"))
+	obj.pool.adminLock.Unlock()
+	for _, listener := range obj.pool.poolListeners() {
+		for _, conn := range conns {
+			listener.OnReconnect(conn)
+		}
 	}
-	return nil
 }
 
-// Disconnect breaks the connection from the TGDB server and returns the connection back to this connection pool for reuse
-func (obj *ConnectionPoolImpl) Disconnect() tgdb.TGError {
+// evict removes the connection from the pool, and replaces it in the background
+func (obj *ConnectionPoolImpl) evict(conn tgdb.TGConnection, reason string) {
+	logger.Warning(fmt.Sprintf("WARNING: Inside ConnectionPoolImpl:evict - evicting connection '%d' as %s", conn.GetConnectionId(), reason))
+	obj.adminLock.Lock()
+	for i, member := range obj.connList {
+		if member == conn {
+			obj.connList = append(obj.connList[:i:i], obj.connList[i+1:]...)
+			break
+		}
+	}
+	delete(obj.consumers, conn.GetConnectionId())
+	delete(obj.connectedAt, conn.GetConnectionId())
+	obj.stats.Evictions++
+	// Keep the retry counts of the connection in the pool totals
+	if counter, ok := conn.(retryCounter); ok {
+		obj.stats.Retries += counter.GetRetryCount()
+		obj.stats.RetryFailures += counter.GetRetryFailureCount()
+	}
+	obj.adminLock.Unlock()
+	for _, listener := range obj.poolListeners() {
+		listener.OnEvict(conn, reason)
+	}
+
+	go func() {
+		_ = conn.Disconnect()
+		obj.addConnection()
+	}()
+}
+
+// addConnection creates a connection in place of an evicted or missing one, and hands it to the next waiter
+func (obj *ConnectionPoolImpl) addConnection() {
+	conn, err := obj.newPoolConnection()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning ConnectionPoolImpl:addConnection - unable to create a connection w/ '%s'", err.Error()))
+		return
+	}
+	obj.adminLock.Lock()
+	defer obj.adminLock.Unlock()
+	if len(obj.connList) >= obj.poolSize {
+		return
+	}
+	obj.connList = append(obj.connList, conn)
+	obj.handOff(conn)
+}
+
+// healthCheck checks the idle connections every healthCheckInterval until stop is closed
+func (obj *ConnectionPoolImpl) healthCheck(stop <-chan struct{}) {
+	ticker := time.NewTicker(obj.healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			obj.checkIdleConnections()
+		}
+	}
+}
+
+// checkIdleConnections evicts the idle connections that are broken or past their max lifetime, connects idle ones
+// until minIdle of them are connected, and replaces the connections that could not be recreated earlier
+func (obj *ConnectionPoolImpl) checkIdleConnections() {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Entering ConnectionPoolImpl:Disconnect"))
+		logger.Debug(fmt.Sprint("Entering ConnectionPoolImpl:checkIdleConnections"))
 	}
+	obj.adminLock.Lock()
+	idle := obj.idleConns
+	obj.idleConns = make([]tgdb.TGConnection, 0)
+	missing := obj.poolSize - len(obj.connList)
+	state := obj.poolState
+	obj.adminLock.Unlock()
+
+	connectedIdle := 0
+	for _, conn := range idle {
+		obj.adminLock.RLock()
+		since, connected := obj.connectedAt[conn.GetConnectionId()]
+		obj.adminLock.RUnlock()
+		if connected {
+			if obj.isExpired(since) {
+				obj.evict(conn, "it reached its max lifetime")
+				continue
+			}
+			if !isConnectionHealthy(conn, obj.healthCheckTimeout()) {
+				obj.evict(conn, "it failed the health check")
+				continue
+			}
+			connectedIdle++
+		} else if state == ConnectionPoolConnected && connectedIdle < obj.minIdle {
+			if err := conn.Connect(); err != nil {
+				logger.Warning(fmt.Sprintf("WARNING: Inside ConnectionPoolImpl:checkIdleConnections - unable to connect an idle connection w/ '%s'", err.Error()))
+			} else {
+				obj.markConnected(conn)
+				connectedIdle++
+			}
+		}
+		obj.adminLock.Lock()
+		obj.handOff(conn)
+		obj.adminLock.Unlock()
+	}
+	for i := 0; i < missing; i++ {
+		obj.addConnection()
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning ConnectionPoolImpl:checkIdleConnections w/ '%d' connected idle connections", connectedIdle))
+	}
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions from Interface ==> TGConnectionPool
+/////////////////////////////////////////////////////////////////
+
+// GetConnectionList returns all the connections = Active/In-Use + Un-used/Initialized
+func (obj *ConnectionPoolImpl) GetConnectionList() []tgdb.TGConnection {
+	obj.adminLock.RLock()
+	defer obj.adminLock.RUnlock()
+	return append([]tgdb.TGConnection(nil), obj.connList...)
+}
+
+// GetConnectionProperties returns all the connection properties
+func (obj *ConnectionPoolImpl) GetConnectionProperties() tgdb.TGProperties {
+	return obj.poolProperties
+}
+
+// GetActiveConnections returns all the Active/In-Use connections
+func (obj *ConnectionPoolImpl) GetActiveConnections() map[int64]tgdb.TGConnection {
 	obj.adminLock.RLock()
 	defer obj.adminLock.RUnlock()
+	consumers := make(map[int64]tgdb.TGConnection, len(obj.consumers))
+	for id, conn := range obj.consumers {
+		consumers[id] = conn
+	}
+	return consumers
+}
+
+// GetNoOfActiveConnections returns the count of Active/In-Use connections
+func (obj *ConnectionPoolImpl) GetNoOfActiveConnections() int {
+	obj.adminLock.RLock()
+	defer obj.adminLock.RUnlock()
+	return len(obj.consumers)
+}
+
+// GetPoolState returns current state of the connection pool
+func (obj *ConnectionPoolImpl) GetPoolState() int {
+	obj.adminLock.RLock()
+	defer obj.adminLock.RUnlock()
+	return obj.poolState
+}
+
+// GetConnection returns an available connection from the pool that is NOT being used or nil if timeout elapses
+func (obj *ConnectionPoolImpl) GetConnection() (tgdb.TGConnection, tgdb.TGError) {
+	return obj.GetConnectionContext(context.Background())
+}
+
+// GetConnectionContext is GetConnection that also stops waiting once ctx is cancelled or expires. Callers wait in
+// line, and connections are handed out in the order the callers asked for them
+func (obj *ConnectionPoolImpl) GetConnectionContext(ctx context.Context) (tgdb.TGConnection, tgdb.TGError) {
+	if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Entering ConnectionPoolImpl:GetConnection for Pool Type: '%+v'", obj.connType))
+	}
+	var deadline <-chan time.Time
+	if obj.connectReserveTimeOut < time.Second*INFINITE {
+		timer := time.NewTimer(obj.connectReserveTimeOut)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+	start := time.Now()
+	waited := false
+	for {
+		conn, w, err := obj.acquire(ctx, deadline)
+		waited = waited || w
+		if err != nil {
+			obj.recordAcquisition(nil, waited, time.Since(start), err)
+			return nil, err
+		}
+		ready, err := obj.prepareForHandOut(conn)
+		if err != nil {
+			obj.recordAcquisition(nil, waited, time.Since(start), err)
+			return nil, err
+		}
+		if ready {
+			obj.recordAcquisition(conn, waited, time.Since(start), nil)
+			if logger.IsDebug() {
+					logger.Debug(fmt.Sprintf("Returning ConnectionPoolImpl:GetConnection w/ Connection: '%+v'", conn))
+			}
+			return conn, nil
+		}
+	}
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGConnectionPool
+/////////////////////////////////////////////////////////////////
+
+// AdminLock locks the connection pool so that the list of connections can be updated
+func (obj *ConnectionPoolImpl) AdminLock() {
+	obj.adminLock.RLock()
+}
+
+// AdminUnlock unlocks the connection pool so that the list of connections can be updated
+func (obj *ConnectionPoolImpl) AdminUnlock() {
+	obj.adminLock.RUnlock()
+}
+
+// AddPoolListener registers a listener for the acquire, release, evict and reconnect events of the pool
+func (obj *ConnectionPoolImpl) AddPoolListener(listener tgdb.TGConnectionPoolListener) {
+	obj.adminLock.Lock()
+	defer obj.adminLock.Unlock()
+	obj.listeners = append(obj.listeners, listener)
+}
+
+// Connect establishes connection from this pool of available/configured connections to the TGDB server
+// Exception could be BadAuthentication or BadUrl
+func (obj *ConnectionPoolImpl) Connect() tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering ConnectionPoolImpl:Connect"))
+	}
+	obj.adminLock.Lock()
+	if obj.poolState == ConnectionPoolConnected {
+		obj.adminLock.Unlock()
+		logger.Error(fmt.Sprint("ERROR: Returning ConnectionPoolImpl:Connect - ConnectionPoolImpl is already connected. Disconnect and then reconnect."))
+		errMsg := "ConnectionPoolImpl is already connected. Disconnect and then reconnect"
+		return GetErrorByType(TGErrorGeneralException, "", errMsg, "")
+	}
+	// Set the state to connecting
+	previousState := obj.poolState
+	obj.poolState = ConnectionPoolConnecting
+	// Hold back the idle connections while they are being connected
+	idle := obj.idleConns
+	obj.idleConns = make([]tgdb.TGConnection, 0)
+	obj.adminLock.Unlock()
+
+	// Connect at least one connection so that a bad URL or bad credentials are reported here, and the rest on
+	// demand or by the health check
+	toConnect := obj.minIdle
+	if toConnect < 1 {
+		toConnect = 1
+	}
+	var err tgdb.TGError
+	for i := 0; i < len(idle) && i < toConnect; i++ {
+		conn := idle[i]
+		// Proceed when the connection is either in Initialized OR Disconnected (OR Stopped???) state
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl::Connect Consumer Loop about to conn.Connect() using connection: '%+v'", conn))
+		}
+		err = conn.Connect()
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning ConnectionPoolImpl:Connect - unable to conn.Connect() w/ '%s'", err.Error()))
+			// TODO: Revisit later - Decide what error to throw and whether to continue / break
+			break
+		}
+		obj.markConnected(conn)
+	}
 
+	obj.adminLock.Lock()
+	for _, conn := range idle {
+		obj.handOff(conn)
+	}
+	if err != nil {
+		// Leave the pool in its earlier state, so that Connect can be called again
+		obj.poolState = previousState
+		obj.adminLock.Unlock()
+		return err
+	}
+	// Set the state to connecting
+	obj.poolState = ConnectionPoolConnected
+	if obj.healthCheckInterval > 0 {
+		obj.stopHealthCheck = make(chan struct{})
+		go obj.healthCheck(obj.stopHealthCheck)
+	}
+	obj.adminLock.Unlock()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Returning ConnectionPoolImpl:Connect"))
+	}
+	return nil
+}
+
+// Disconnect breaks the connection from the TGDB server and returns the connection back to this connection pool for reuse
+func (obj *ConnectionPoolImpl) Disconnect() tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering ConnectionPoolImpl:Disconnect"))
+	}
+	obj.adminLock.Lock()
 	if obj.poolState != ConnectionPoolConnected {
+		obj.adminLock.Unlock()
 		logger.Error(fmt.Sprint("ERROR: Returning ConnectionPoolImpl:Disconnect - ConnectionPoolImpl is NOT connected."))
 		errMsg := fmt.Sprintf("ConnectionPoolImpl is not connected. State is: %d", obj.poolState)
 		return GetErrorByType(TGErrorGeneralException, "", errMsg, "")
 	}
 	// Set the state to connecting
 	obj.poolState = ConnectionPoolDisconnecting
+	if obj.stopHealthCheck != nil {
+		close(obj.stopHealthCheck)
+		obj.stopHealthCheck = nil
+	}
+	connList := append([]tgdb.TGConnection(nil), obj.connList...)
+	obj.adminLock.Unlock()
 
 	// Attempt to connect using each of the active connections in the pool
-	for _, conn := range obj.connList {
+	for _, conn := range connList {
 		if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Inside ConnectionPoolImpl::Disconnect active connection Loop about to conn.Disconnect() using connection: '%+v'", conn))
 		}
@@ -2056,7 +2896,10 @@ func (obj *ConnectionPoolImpl) Disconnect() tgdb.TGError {
 	}
 
 	// Set the state to connecting
+	obj.adminLock.Lock()
+	obj.connectedAt = make(map[int64]time.Time, 0)
 	obj.poolState = ConnectionPoolDisconnected
+	obj.adminLock.Unlock()
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Returning ConnectionPoolImpl:Disconnect"))
 	}
@@ -2073,39 +2916,52 @@ func (obj *ConnectionPoolImpl) Get() (tgdb.TGConnection, tgdb.TGError) {
 	return obj.GetConnection()
 }
 
+// GetContext is Get that also stops waiting once ctx is cancelled or expires
+func (obj *ConnectionPoolImpl) GetContext(ctx context.Context) (tgdb.TGConnection, tgdb.TGError) {
+	return obj.GetConnectionContext(ctx)
+}
+
 // GetPoolSize gets pool size
 func (obj *ConnectionPoolImpl) GetPoolSize() int {
 	return obj.poolSize
 }
 
+// GetRetryPolicy gets the retry policy handed to the connections of this pool
+func (obj *ConnectionPoolImpl) GetRetryPolicy() *tgdb.TGRetryPolicy {
+	obj.retryLock.Lock()
+	defer obj.retryLock.Unlock()
+	return obj.retryPolicy
+}
+
 // ReleaseConnection frees the connection and sends back to the pool
 func (obj *ConnectionPoolImpl) ReleaseConnection(conn tgdb.TGConnection) (tgdb.TGConnectionPool, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Entering ConnectionPoolImpl:ReleaseConnection"))
 	}
-	obj.adminLock.RLock()
-	defer obj.adminLock.RUnlock()
-
+	obj.adminLock.Lock()
+	if _, ok := obj.consumers[conn.GetConnectionId()]; !ok {
+		obj.adminLock.Unlock()
+		logger.Warning(fmt.Sprintf("WARNING: Returning ConnectionPoolImpl:ReleaseConnection - connection '%d' is not in use", conn.GetConnectionId()))
+		return obj, nil
+	}
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside ConnectionPoolImpl::ReleaseConnection Consumer Loop about to remove connection from consumer list"))
 	}
-
-	tgConn, ok := conn.(*TGDBConnection)
-	if ok {
-		delete(obj.consumers, tgConn.GetConnectionId())
+	delete(obj.consumers, conn.GetConnectionId())
+	since, connected := obj.connectedAt[conn.GetConnectionId()]
+	expired := connected && obj.isExpired(since)
+	if !expired {
 		if logger.IsDebug() {
-					logger.Debug(fmt.Sprint("Inside ConnectionPoolImpl::ReleaseConnection Consumer Loop about to return connection to obj.ConnPool"))
-		}
-		obj.chanPool <- tgConn
-	} else {
-		tgAdminConn, ok := conn.(*AdminConnectionImpl)
-		if ok {
-			delete(obj.consumers, tgAdminConn.TGDBConnection.GetConnectionId())
-			if logger.IsDebug() {
-							logger.Debug(fmt.Sprint("Inside ConnectionPoolImpl::ReleaseConnection Consumer Loop about to return connection to obj.ConnPool"))
-			}
-			obj.chanPool <- tgAdminConn
+			logger.Debug(fmt.Sprint("Inside ConnectionPoolImpl::ReleaseConnection Consumer Loop about to hand the connection to the next waiter"))
 		}
+		obj.handOff(conn)
+	}
+	obj.adminLock.Unlock()
+	for _, listener := range obj.poolListeners() {
+		listener.OnRelease(conn)
+	}
+	if expired {
+		obj.evict(conn, "it reached its max lifetime")
 	}
 
 	if logger.IsDebug() {
@@ -2114,18 +2970,70 @@ func (obj *ConnectionPoolImpl) ReleaseConnection(conn tgdb.TGConnection) (tgdb.T
 	return obj, nil
 }
 
+// RemovePoolListener unregisters a listener added with AddPoolListener
+func (obj *ConnectionPoolImpl) RemovePoolListener(listener tgdb.TGConnectionPoolListener) {
+	obj.adminLock.Lock()
+	defer obj.adminLock.Unlock()
+	for i, l := range obj.listeners {
+		if l == listener {
+			obj.listeners = append(obj.listeners[:i:i], obj.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
 // SetExceptionListener sets exception listener
 func (obj *ConnectionPoolImpl) SetExceptionListener(listener tgdb.TGConnectionExceptionListener) {
+	obj.adminLock.Lock()
+	defer obj.adminLock.Unlock()
 	obj.exceptionListener = listener
 }
 
+// SetRetryPolicy sets the retry policy of this pool and of every connection in it
+func (obj *ConnectionPoolImpl) SetRetryPolicy(policy *tgdb.TGRetryPolicy) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering ConnectionPoolImpl:SetRetryPolicy w/ Policy: '%+v'", policy))
+	}
+	obj.retryLock.Lock()
+	defer obj.retryLock.Unlock()
+	if policy == nil {
+		policy = NewRetryPolicy(obj.poolProperties)
+	}
+	obj.retryPolicy = policy
+	for _, conn := range obj.GetConnectionList() {
+		conn.SetRetryPolicy(policy)
+	}
+}
+
+// Stats returns a snapshot of the usage counters of the pool
+func (obj *ConnectionPoolImpl) Stats() tgdb.TGConnectionPoolStats {
+	obj.adminLock.RLock()
+	stats := obj.stats
+	stats.PoolSize = obj.poolSize
+	stats.InUse = len(obj.consumers)
+	stats.Idle = len(obj.idleConns)
+	stats.Waiting = obj.waiters.Len()
+	members := append([]tgdb.TGConnection(nil), obj.connList...)
+	obj.adminLock.RUnlock()
+	for _, conn := range members {
+		if counter, ok := conn.(retryCounter); ok {
+			stats.Retries += counter.GetRetryCount()
+			stats.RetryFailures += counter.GetRetryFailureCount()
+		}
+	}
+	return stats
+}
+
 func (obj *ConnectionPoolImpl) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("ConnectionPoolImpl:{")
 	buffer.WriteString(fmt.Sprintf("ConnectReserveTimeOut: %+v", obj.connectReserveTimeOut))
 	buffer.WriteString(fmt.Sprintf(", ConnType: %+v", obj.connType))
 	buffer.WriteString(fmt.Sprintf(", ConnList: %+v", obj.connList))
-	buffer.WriteString(fmt.Sprintf(", ConnPool: %+v", obj.chanPool))
+	buffer.WriteString(fmt.Sprintf(", IdleConns: %d", len(obj.idleConns)))
+	buffer.WriteString(fmt.Sprintf(", Waiters: %d", obj.waiters.Len()))
+	buffer.WriteString(fmt.Sprintf(", MinIdle: %d", obj.minIdle))
+	buffer.WriteString(fmt.Sprintf(", MaxLifetime: %+v", obj.maxLifetime))
 	//buffer.WriteString(fmt.Sprintf(", PoolProperties: %+v", obj.poolProperties))
 	buffer.WriteString(fmt.Sprintf(", Consumers: %+v", obj.consumers))
 	buffer.WriteString(fmt.Sprintf(", PoolSize: %d", obj.poolSize))
@@ -2236,6 +3144,18 @@ func configureAdminRequest(adminReq *AdminRequestMessage, option interface{}) {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:configureAdminRequest w/ AdminRequest: '%+v'", adminReq.String()))
 	}
 	switch adminReq.GetCommand() {
+	case AdminCommandCreateAttrDesc:
+		adminReq.SetAttributeDescriptor(option.(tgdb.TGAttributeDescriptor))
+	case AdminCommandCreateEdgeType:
+		fallthrough
+	case AdminCommandCreateNodeType:
+		adminReq.SetCreateEntityTypeInfo(option.(*CreateEntityTypeInfo))
+	case AdminCommandCreateIndex:
+		adminReq.SetCreateIndexInfo(option.(*CreateIndexInfo))
+	case AdminCommandCreateUser:
+		adminReq.SetCreateUserInfo(option.(*CreateUserInfo))
+	case AdminCommandDescribe:
+		adminReq.SetDescribeName(option.(string))
 	case AdminCommandKillConnection:
 		adminReq.SetSessionId(option.(int64))
 	case AdminCommandSetLogLevel:
@@ -2259,9 +3179,6 @@ func (obj *AdminConnectionImpl) executeAdminRequest(command AdminCommand, parame
 	//if err != nil {
 	//	return nil, err
 	//}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::ExecuteAdminRequest about to createChannelRequest() for: pdu.VerbQueryRequest"))
 	}
@@ -2291,6 +3208,9 @@ func (obj *AdminConnectionImpl) executeAdminRequest(command AdminCommand, parame
 			logger.Debug(fmt.Sprintf("Inside AdminConnectionImpl::ExecuteAdminRequest received response for: pdu.VerbAdminRequest as '%+v'", msgResponse))
 	}
 	response := msgResponse.(*AdminResponseMessage)
+	if response.GetResultId() != TGAdminResultSuccess {
+		return nil, adminCommandError(command, response)
+	}
 
 	//if !response.GetHasResult() {
 	//	logger.Warning(fmt.Sprint("WARNING: Returning AdminConnectionImpl::ExecuteAdminRequest - The query does not have any results in AdminResponseMessage"))
@@ -2316,6 +3236,8 @@ func (obj *AdminConnectionImpl) populateResultSetFromAdminResponse(command Admin
 	var results interface{}
 
 	switch command {
+	case AdminCommandDescribe:
+		results = msgResponse.GetDescription()
 	case AdminCommandShowAttrDescs:
 		results = msgResponse.GetDescriptorList()
 	case AdminCommandShowConnections:
@@ -2339,6 +3261,57 @@ func (obj *AdminConnectionImpl) populateResultSetFromAdminResponse(command Admin
 	return results, nil
 }
 
+// refreshMetadata reloads the graph metadata after a schema change, so that the new types and
+// attributes can be used straight away. The change itself has succeeded, so failures are only logged
+func (obj *AdminConnectionImpl) refreshMetadata() {
+	_, err := obj.GetGraphMetadata(true)
+	if err != nil {
+		logger.Warning(fmt.Sprintf("WARNING: AdminConnectionImpl:refreshMetadata - unable to refresh graph metadata w/ error: '%s'", err.Error()))
+	}
+}
+
+func invalidAdminArgument(method, errMsg string) tgdb.TGError {
+	logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:%s - %s", method, errMsg))
+	return GetErrorByType(TGErrorGeneralException, TGDB_ADMIN_ERROR, errMsg, "")
+}
+
+// validateEntityTypeArguments checks a node or edge type definition before it is sent to the server
+func validateEntityTypeArguments(method, name string, attrs, pkeys []string) tgdb.TGError {
+	if name == "" {
+		return invalidAdminArgument(method, "Entity type must have a name")
+	}
+	attrSet := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		attrSet[attr] = true
+	}
+	for _, pkey := range pkeys {
+		if !attrSet[pkey] {
+			return invalidAdminArgument(method, fmt.Sprintf("Primary key attribute '%s' is not one of the attributes of '%s'", pkey, name))
+		}
+	}
+	return nil
+}
+
+// adminCommandError converts a failed admin response into an error that carries the server's result code
+func adminCommandError(command AdminCommand, msgResponse *AdminResponseMessage) tgdb.TGError {
+	resultId := msgResponse.GetResultId()
+	errMsg := msgResponse.GetErrorMessage()
+	switch resultId {
+	case TGAdminResultDuplicateSystemObject:
+		errMsg = "A system object with this name already exists - user, node type, edge type and index names must all be unique"
+	case TGAdminResultDuplicateAttrDesc:
+		errMsg = "An attribute descriptor with this name already exists"
+	case TGAdminResultInvalidRole:
+		errMsg = "Invalid role"
+	default:
+		if errMsg == "" {
+			errMsg = fmt.Sprintf("Error processing admin command %d", int(command))
+		}
+	}
+	logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:executeAdminRequest - server result '%d': %s", resultId, errMsg))
+	return NewTGDBErrorWithServerErrorCode(TGDB_ADMIN_ERROR, TGErrorGeneralException, errMsg, msgResponse.GetErrorMessage(), resultId)
+}
+
 func (obj *AdminConnectionImpl) populateResultSetFromQueryResponse(resultId int, msgResponse *QueryResponseMessage) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:populateResultSetFromQueryResponse w/ MsgResponse: '%+v'", msgResponse.String()))
@@ -2739,6 +3712,219 @@ func (obj *AdminConnectionImpl) populateResultSetFromGetEntityResponse(msgRespon
 	return entityFound, nil
 }
 
+// sendBulkRequest sends one bulk export / import request to the server and waits for its response
+func (obj *AdminConnectionImpl) sendBulkRequest(ctx context.Context, verb int, configure func(msg tgdb.TGMessage)) (tgdb.TGMessage, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:sendBulkRequest for Verb: '%s'", GetVerb(verb).GetName()))
+	}
+	msgRequest, channelResponse, cErr := createChannelRequest(obj, verb)
+	if cErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:sendBulkRequest - unable to createChannelRequest(%s) w/ error: '%s'", GetVerb(verb).GetName(), cErr.Error()))
+		return nil, cErr
+	}
+	if configure != nil {
+		configure(msgRequest)
+	}
+
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, msgRequest, channelResponse.(*BlockingChannelResponse))
+	if channelErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:sendBulkRequest - unable to channel.SendRequest(%s) w/ error: '%s'", GetVerb(verb).GetName(), channelErr.Error()))
+		return nil, channelErr
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning AdminConnectionImpl:sendBulkRequest w/ response '%+v'", msgResponse))
+	}
+	return msgResponse, nil
+}
+
+// cancelExport asks the server to abandon the export in progress. It is best effort - the server does not reply
+func (obj *AdminConnectionImpl) cancelExport() {
+	msgRequest, _, cErr := createChannelRequest(obj, VerbCancelExportRequest)
+	if cErr != nil {
+		logger.Warning(fmt.Sprintf("WARNING: AdminConnectionImpl:cancelExport - unable to createChannelRequest(pdu.VerbCancelExportRequest) w/ error: '%s'", cErr.Error()))
+		return
+	}
+	channelErr := obj.GetChannel().SendMessage(msgRequest)
+	if channelErr != nil {
+		logger.Warning(fmt.Sprintf("WARNING: AdminConnectionImpl:cancelExport - unable to channel.SendMessage() w/ error: '%s'", channelErr.Error()))
+	}
+}
+
+func bulkIOError(errMsg string, status int) tgdb.TGError {
+	logger.Error(fmt.Sprintf("ERROR: %s w/ server status %d", errMsg, status))
+	return GetErrorByType(TGErrorGeneralException, TGDB_BULKIO_ERROR, errMsg, fmt.Sprintf("Server status: %d", status))
+}
+
+/////////////////////////////////////////////////////////////////
+// Stream format written by ExportDatabase and read by ImportDatabase
+//
+//	header: magic, type count, {type name, is node, instance count}...
+//	frame:  1, type name, column count (-1 if not the first frame of the type), {column name}...,
+//	        entity count, data length, data
+//	end:    0
+//
+// All integers are big endian and strings are prefixed by a 16 bit length. Frames hold complete
+// records, and are never more than bulkIOMaxDataSize bytes unless a single record is larger.
+/////////////////////////////////////////////////////////////////
+
+const (
+	bulkIOStreamMagic = "TGDBEXP1"
+	// bulkIOMaxDataSize is the largest amount of data sent in one partial import request
+	bulkIOMaxDataSize = 32000
+)
+
+type bulkIOFrame struct {
+	typeName    string
+	attrList    []string
+	numEntities int
+	data        []byte
+}
+
+type bulkIOStreamWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (sw *bulkIOStreamWriter) write(v interface{}) {
+	if sw.err == nil {
+		sw.err = binary.Write(sw.w, binary.BigEndian, v)
+	}
+}
+
+func (sw *bulkIOStreamWriter) writeString(s string) {
+	sw.write(uint16(len(s)))
+	sw.write([]byte(s))
+}
+
+func (sw *bulkIOStreamWriter) writeHeader(typeList []tgdb.TGBulkDescriptor) {
+	sw.write([]byte(bulkIOStreamMagic))
+	sw.write(int32(len(typeList)))
+	for _, desc := range typeList {
+		sw.writeString(desc.GetTypeName())
+		sw.write(desc.IsNode())
+		sw.write(desc.GetNumInstances())
+	}
+}
+
+func (sw *bulkIOStreamWriter) writeFrame(frame *bulkIOFrame) {
+	sw.write(byte(1))
+	sw.writeString(frame.typeName)
+	if frame.attrList == nil {
+		sw.write(int32(-1))
+	} else {
+		sw.write(int32(len(frame.attrList)))
+		for _, attr := range frame.attrList {
+			sw.writeString(attr)
+		}
+	}
+	sw.write(int32(frame.numEntities))
+	sw.write(int32(len(frame.data)))
+	sw.write(frame.data)
+}
+
+func (sw *bulkIOStreamWriter) writeEnd() {
+	sw.write(byte(0))
+}
+
+type bulkIOStreamReader struct {
+	r   io.Reader
+	err error
+}
+
+func (sr *bulkIOStreamReader) read(v interface{}) {
+	if sr.err == nil {
+		sr.err = binary.Read(sr.r, binary.BigEndian, v)
+	}
+}
+
+func (sr *bulkIOStreamReader) readString() string {
+	var sLen uint16
+	sr.read(&sLen)
+	if sr.err != nil {
+		return ""
+	}
+	buf := make([]byte, sLen)
+	_, sr.err = io.ReadFull(sr.r, buf)
+	return string(buf)
+}
+
+func (sr *bulkIOStreamReader) readHeader() []tgdb.TGBulkDescriptor {
+	magic := make([]byte, len(bulkIOStreamMagic))
+	sr.read(magic)
+	if sr.err == nil && string(magic) != bulkIOStreamMagic {
+		sr.err = fmt.Errorf("not a TGDB export stream")
+	}
+	var numTypes int32
+	sr.read(&numTypes)
+	typeList := make([]tgdb.TGBulkDescriptor, 0)
+	for i := int32(0); i < numTypes && sr.err == nil; i++ {
+		typeName := sr.readString()
+		var isNode bool
+		var numInstances int64
+		sr.read(&isNode)
+		sr.read(&numInstances)
+		typeList = append(typeList, NewBulkDescriptorImpl(typeName, isNode, numInstances))
+	}
+	return typeList
+}
+
+// readFrame returns the next frame, or nil at the end of the stream
+func (sr *bulkIOStreamReader) readFrame() *bulkIOFrame {
+	var tag byte
+	sr.read(&tag)
+	if sr.err != nil || tag == 0 {
+		return nil
+	}
+	frame := &bulkIOFrame{typeName: sr.readString()}
+	var numAttrs, numEntities, dataLen int32
+	sr.read(&numAttrs)
+	if numAttrs >= 0 {
+		frame.attrList = make([]string, 0, numAttrs)
+		for i := int32(0); i < numAttrs && sr.err == nil; i++ {
+			frame.attrList = append(frame.attrList, sr.readString())
+		}
+	}
+	sr.read(&numEntities)
+	sr.read(&dataLen)
+	if sr.err != nil {
+		return nil
+	}
+	if dataLen < 0 {
+		sr.err = fmt.Errorf("invalid frame data length %d", dataLen)
+		return nil
+	}
+	frame.numEntities = int(numEntities)
+	frame.data = make([]byte, dataLen)
+	_, sr.err = io.ReadFull(sr.r, frame.data)
+	if sr.err != nil {
+		return nil
+	}
+	return frame
+}
+
+// bulkIORecordSplit returns the length of the longest prefix of data, no longer than limit, that
+// ends on a record boundary i.e. a new line outside quotes, and the number of records in it.
+// If the first record is longer than limit, it is returned on its own
+func bulkIORecordSplit(data []byte, limit int) (int, int) {
+	inQuotes := false
+	splitAt, numRecords := 0, 0
+	for i := 0; i < len(data); i++ {
+		if i >= limit && splitAt > 0 {
+			break
+		}
+		switch data[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case '\n':
+			if !inQuotes {
+				splitAt = i + 1
+				numRecords++
+			}
+		}
+	}
+	return splitAt, numRecords
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGAdminConnection
 /////////////////////////////////////////////////////////////////
@@ -2752,6 +3938,88 @@ func (obj *AdminConnectionImpl) CheckpointServer() tgdb.TGError {
 	return nil
 }
 
+// CreateAttributeDescriptor creates an attribute descriptor on the server
+func (obj *AdminConnectionImpl) CreateAttributeDescriptor(attrDesc tgdb.TGAttributeDescriptor) tgdb.TGError {
+	if attrDesc == nil || attrDesc.GetName() == "" {
+		return invalidAdminArgument("CreateAttributeDescriptor", "Attribute descriptor must have a name")
+	}
+	_, err := obj.executeAdminRequest(AdminCommandCreateAttrDesc, attrDesc)
+	if err != nil {
+		return err
+	}
+	obj.refreshMetadata()
+	return nil
+}
+
+// CreateEdgeType creates an edge type between two node types
+func (obj *AdminConnectionImpl) CreateEdgeType(name string, fromNodeType, toNodeType string, direction tgdb.TGDirectionType, attrs []string, pkeys []string) tgdb.TGError {
+	if err := validateEntityTypeArguments("CreateEdgeType", name, attrs, pkeys); err != nil {
+		return err
+	}
+	typeInfo := NewCreateEdgeTypeInfo(name, fromNodeType, toNodeType, direction, attrs, pkeys)
+	_, err := obj.executeAdminRequest(AdminCommandCreateEdgeType, typeInfo)
+	if err != nil {
+		return err
+	}
+	obj.refreshMetadata()
+	return nil
+}
+
+// CreateIndex creates an index on the attributes for the given node types
+func (obj *AdminConnectionImpl) CreateIndex(name string, nodeTypes []string, attrs []string, unique bool) tgdb.TGError {
+	if name == "" {
+		return invalidAdminArgument("CreateIndex", "Index must have a name")
+	}
+	if len(attrs) == 0 {
+		return invalidAdminArgument("CreateIndex", fmt.Sprintf("Index '%s' must have at least one attribute", name))
+	}
+	indexInfo := NewCreateIndexInfo(name, nodeTypes, attrs, unique)
+	_, err := obj.executeAdminRequest(AdminCommandCreateIndex, indexInfo)
+	if err != nil {
+		return err
+	}
+	obj.refreshMetadata()
+	return nil
+}
+
+// CreateNodeType creates a node type
+func (obj *AdminConnectionImpl) CreateNodeType(name string, attrs []string, pkeys []string) tgdb.TGError {
+	if err := validateEntityTypeArguments("CreateNodeType", name, attrs, pkeys); err != nil {
+		return err
+	}
+	typeInfo := NewCreateNodeTypeInfo(name, attrs, pkeys)
+	_, err := obj.executeAdminRequest(AdminCommandCreateNodeType, typeInfo)
+	if err != nil {
+		return err
+	}
+	obj.refreshMetadata()
+	return nil
+}
+
+// CreateUser creates a user with the given password and roles
+func (obj *AdminConnectionImpl) CreateUser(name string, password string, roles []string) tgdb.TGError {
+	if len(name) < 1 || len(name) > 255 || strings.Contains(name, "@") {
+		return invalidAdminArgument("CreateUser", fmt.Sprintf("Bad user name: '%s'", name))
+	}
+	_, err := obj.executeAdminRequest(AdminCommandCreateUser, NewCreateUserInfo(name, password, roles))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// Describe returns the server's description of the named system object
+func (obj *AdminConnectionImpl) Describe(name string) (tgdb.TGAdminDescription, tgdb.TGError) {
+	if name == "" {
+		return nil, invalidAdminArgument("Describe", "Name of the system object to describe is empty")
+	}
+	results, err := obj.executeAdminRequest(AdminCommandDescribe, name)
+	if err != nil {
+		return nil, err
+	}
+	return results.(*AdminDescriptionImpl), nil
+}
+
 // DumpServerStackTrace prints the stack trace
 func (obj *AdminConnectionImpl) DumpServerStackTrace() tgdb.TGError {
 	if logger.IsDebug() {
@@ -2761,9 +4029,6 @@ func (obj *AdminConnectionImpl) DumpServerStackTrace() tgdb.TGError {
 	//if err != nil {
 	//	return nil, err
 	//}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::DumpServerStackTrace about to createChannelRequest() for: pdu.DumpServerStackTrace"))
 	}
@@ -2791,6 +4056,128 @@ func (obj *AdminConnectionImpl) DumpServerStackTrace() tgdb.TGError {
 	return nil
 }
 
+// ExportDatabase streams the contents of the database to the writer in batches
+func (obj *AdminConnectionImpl) ExportDatabase(w io.Writer, opts *tgdb.TGExportOptions) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering AdminConnectionImpl:ExportDatabase"))
+	}
+	if opts == nil {
+		opts = &tgdb.TGExportOptions{}
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = obj.GetConnectionProperties().GetPropertyAsInt(GetConfigFromKey(BulkIOEntityBatchSize))
+	}
+	if batchSize <= 0 {
+		batchSize = DefaultBulkIOBatchSize
+	}
+
+	msgResponse, err := obj.sendBulkRequest(ctx, VerbBeginExportRequest, func(msg tgdb.TGMessage) {
+		msg.(*BeginExportRequestMessage).SetIsBatch(true)
+		msg.(*BeginExportRequestMessage).SetMaxBatchEntities(batchSize)
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			obj.cancelExport()
+		}
+		return err
+	}
+	beginResponse, ok := msgResponse.(*BeginExportResponseMessage)
+	if !ok {
+		return bulkIOError(fmt.Sprintf("Unexpected response '%s' to begin export", GetVerb(msgResponse.GetVerbId()).GetName()), -1)
+	}
+	if beginResponse.GetResultStatus() != 0 {
+		return bulkIOError("Error starting export", beginResponse.GetResultStatus())
+	}
+
+	typeList := beginResponse.GetTypeList()
+	progress := tgdb.TGBulkProgress{}
+	for _, desc := range typeList {
+		progress.TotalEntities += desc.GetNumInstances()
+	}
+	sw := &bulkIOStreamWriter{w: w}
+	sw.writeHeader(typeList)
+
+	// The server's batches are re-cut into frames of whole records, small enough to be imported again
+	typeName := ""
+	var attrList []string
+	var pending []byte
+	writeFrames := func(final bool) {
+		for len(pending) > 0 && sw.err == nil {
+			if !final && len(pending) < bulkIOMaxDataSize {
+				return
+			}
+			n, numRecords := bulkIORecordSplit(pending, bulkIOMaxDataSize)
+			if n == 0 {
+				if !final {
+					return
+				}
+				n, numRecords = len(pending), 1
+			}
+			sw.writeFrame(&bulkIOFrame{typeName: typeName, attrList: attrList, numEntities: numRecords, data: pending[:n]})
+			attrList = nil
+			pending = pending[n:]
+
+			progress.TypeName = typeName
+			progress.Batches++
+			progress.Entities += int64(numRecords)
+			progress.Bytes += int64(n)
+			if opts.Progress != nil {
+				opts.Progress(progress)
+			}
+		}
+	}
+
+	hasMore := len(typeList) > 0
+	for hasMore {
+		if ctx.Err() != nil {
+			obj.cancelExport()
+			return channelContextError(ctx, "AdminConnectionImpl:ExportDatabase - export cancelled")
+		}
+		msgResponse, err = obj.sendBulkRequest(ctx, VerbPartialExportRequest, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				obj.cancelExport()
+			}
+			return err
+		}
+		partialResponse, ok := msgResponse.(*PartialExportResponseMessage)
+		if !ok {
+			obj.cancelExport()
+			return bulkIOError(fmt.Sprintf("Unexpected response '%s' to partial export", GetVerb(msgResponse.GetVerbId()).GetName()), -1)
+		}
+		if partialResponse.GetNewType() {
+			writeFrames(true)
+			typeName = partialResponse.GetTypeName()
+			attrList = partialResponse.GetAttrList()
+			if attrList == nil {
+				attrList = make([]string, 0)
+			}
+		}
+		pending = append(pending, partialResponse.GetData()...)
+		writeFrames(false)
+		if sw.err != nil {
+			obj.cancelExport()
+			return GetErrorByType(TGErrorIOException, TGDB_BULKIO_ERROR, "AdminConnectionImpl:ExportDatabase - unable to write export stream", sw.err.Error())
+		}
+		hasMore = partialResponse.GetHasMore()
+	}
+	writeFrames(true)
+	sw.writeEnd()
+	if sw.err != nil {
+		return GetErrorByType(TGErrorIOException, TGDB_BULKIO_ERROR, "AdminConnectionImpl:ExportDatabase - unable to write export stream", sw.err.Error())
+	}
+
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning AdminConnectionImpl:ExportDatabase after exporting %d entities in %d batches", progress.Entities, progress.Batches))
+	}
+	return nil
+}
+
 // GetAttributeDescriptors gets the list of attribute descriptors
 func (obj *AdminConnectionImpl) GetAttributeDescriptors() ([]tgdb.TGAttributeDescriptor, tgdb.TGError) {
 	results, err := obj.executeAdminRequest(AdminCommandShowAttrDescs, nil)
@@ -2836,173 +4223,187 @@ func (obj *AdminConnectionImpl) GetUsers() ([]tgdb.TGUserInfo, tgdb.TGError) {
 	return results.([]tgdb.TGUserInfo), nil
 }
 
-// KillConnection terminates the connection forcefully
-func (obj *AdminConnectionImpl) KillConnection(sessionId int64) tgdb.TGError {
-	_, err := obj.executeAdminRequest(AdminCommandKillConnection, sessionId)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// SetServerLogLevel set the log level
-func (obj *AdminConnectionImpl) SetServerLogLevel(logLevel int, logComponent int64) tgdb.TGError {
-	logDetails := NewServerLogDetails(TGLogLevel(logLevel), TGLogComponent(logComponent))
-	_, err := obj.executeAdminRequest(AdminCommandSetLogLevel, logDetails)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// StopServer stops the admin connection
-func (obj *AdminConnectionImpl) StopServer() tgdb.TGError {
-	_, err := obj.executeAdminRequest(AdminCommandStopServer, nil)
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-/////////////////////////////////////////////////////////////////
-// Implement functions from Interface ==> TGConnection
-/////////////////////////////////////////////////////////////////
-
-// Commit commits the current transaction on this connection
-func (obj *AdminConnectionImpl) Commit() (tgdb.TGResultSet, tgdb.TGError) {
+// ImportDatabase streams data written by ExportDatabase back into the database
+func (obj *AdminConnectionImpl) ImportDatabase(r io.Reader, opts *tgdb.TGImportOptions) ([]tgdb.TGBulkDescriptor, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Entering AdminConnectionImpl:Commit"))
+		logger.Debug(fmt.Sprint("Entering AdminConnectionImpl:ImportDatabase"))
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit - about to loop through addedList to include existing nodes to the changed list if it's part of a new edge"))
+	if opts == nil {
+		opts = &tgdb.TGImportOptions{}
 	}
-	// Include existing nodes to the changed list if it's part of a new edge
-	for _, addEntity := range obj.GetAddedList() {
-		if addEntity.GetEntityKind() == tgdb.EntityKindEdge {
-			nodes := addEntity.(*Edge).GetVertices()
-			if len(nodes) > 0 {
-				for _, vNode := range nodes {
-					node := vNode.(*Node)
-					if !node.GetIsNew() {
-						obj.changedList[node.GetVirtualId()] = node
-						logger.Warning(fmt.Sprintf("WARNING: AdminConnectionImpl:Commit - Existing node '%d' added to change list for a new edge", node.GetVirtualId()))
-					}
-				}
-			}
-		}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
 	}
 
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit - about to loop through changedList to include existing nodes to the changed list even for edge update"))
+	sr := &bulkIOStreamReader{r: r}
+	typeList := sr.readHeader()
+	if sr.err != nil {
+		return nil, GetErrorByType(TGErrorIOException, TGDB_BULKIO_ERROR, "AdminConnectionImpl:ImportDatabase - unable to read export stream", sr.err.Error())
 	}
-	// Need to include existing node to the changed list even for edge update
-	for _, modEntity := range obj.GetChangedList() {
-		if modEntity.GetEntityKind() == tgdb.EntityKindEdge {
-			nodes := modEntity.(*Edge).GetVertices()
-			if len(nodes) > 0 {
-				for _, vNode := range nodes {
-					node := vNode.(*Node)
-					if !node.GetIsNew() {
-						obj.changedList[node.GetVirtualId()] = node
-						logger.Warning(fmt.Sprintf("WARNING: AdminConnectionImpl:Commit - Existing node '%d' added to change list for an existing edge '%d'", node.GetVirtualId(), modEntity.GetVirtualId()))
-					}
-				}
-			}
-		}
+	progress := tgdb.TGBulkProgress{}
+	for _, desc := range typeList {
+		progress.TotalEntities += desc.GetNumInstances()
 	}
 
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit - about to loop through removedList to include existing nodes to the changed list even for edge update"))
-	}
-	// Need to include existing node to the changed list even for edge update
-	for _, delEntity := range obj.GetRemovedList() {
-		if delEntity.GetEntityKind() == tgdb.EntityKindEdge {
-			nodes := delEntity.(*Edge).GetVertices()
-			if len(nodes) > 0 {
-				for _, vNode := range nodes {
-					node := vNode.(*Node)
-					if !node.GetIsNew() {
-						if obj.removedList[node.GetVirtualId()] == nil {
-							obj.changedList[node.GetVirtualId()] = node
-							logger.Warning(fmt.Sprintf("WARNING: AdminConnectionImpl:Commit - Existing node '%d' added to change list for an edge %d to be deleted", node.GetVirtualId(), delEntity.GetVirtualId()))
-						}
-					}
-				}
-			}
+	msgResponse, err := obj.sendBulkRequest(ctx, VerbBeginImportRequest, func(msg tgdb.TGMessage) {
+		beginRequest := msg.(*BeginImportRequestMessage)
+		beginRequest.SetIsBatch(true)
+		if opts.LoadOption != "" {
+			beginRequest.SetLoadOption(opts.LoadOption)
 		}
-	}
-	//For deleted edge and node, we don't immediately change the effected nodes or edges.
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit about to createChannelRequest() for: pdu.VerbCommitTransactionRequest"))
-	}
-	// Create a channel request
-	msgRequest, channelResponse, err := createChannelRequest(obj, VerbCommitTransactionRequest)
+		if opts.ErrorOption != "" {
+			beginRequest.SetErrorOption(opts.ErrorOption)
+		}
+		if opts.DateFormat != "" {
+			beginRequest.SetDateFormat(opts.DateFormat)
+		}
+	})
 	if err != nil {
-		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:Commit - unable to createChannelRequest(pdu.VerbCommitTransactionRequest w/ error: '%s'", err.Error()))
 		return nil, err
 	}
-	queryRequest := msgRequest.(*CommitTransactionRequest)
-
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit about to GetAttributeDescriptors() for: pdu.VerbCommitTransactionRequest"))
+	beginResponse, ok := msgResponse.(*BeginImportResponseMessage)
+	if !ok {
+		return nil, bulkIOError(fmt.Sprintf("Unexpected response '%s' to begin import", GetVerb(msgResponse.GetVerbId()).GetName()), -1)
 	}
-	gof := obj.graphObjFactory
-	attrDescSet, aErr := gof.GetGraphMetaData().GetNewAttributeDescriptors()
-	if aErr != nil {
-		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:Commit - unable to gmd.GetAttributeDescriptors() w/ error: '%s'", aErr.Error()))
-		return nil, aErr
+	if beginResponse.GetResultStatus() != 0 {
+		return nil, bulkIOError("Error starting import", beginResponse.GetResultStatus())
 	}
-	queryRequest.AddCommitLists(obj.addedList, obj.changedList, obj.removedList, attrDescSet)
 
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit about to channelSendRequest() for: pdu.VerbCommitTransactionRequest"))
+	results := make([]tgdb.TGBulkDescriptor, 0)
+	resultsByType := make(map[string]*BulkDescriptorImpl)
+
+	// The number of batches of a type is only known once its last frame has been read, so read one
+	// frame ahead and keep telling the server there is at least one more batch until then
+	typeName := ""
+	var attrList []string
+	reqIdx := 0
+	frame := sr.readFrame()
+	for frame != nil {
+		if ctx.Err() != nil {
+			return results, channelContextError(ctx, "AdminConnectionImpl:ImportDatabase - import cancelled")
+		}
+		nextFrame := sr.readFrame()
+		if sr.err != nil {
+			return results, GetErrorByType(TGErrorIOException, TGDB_BULKIO_ERROR, "AdminConnectionImpl:ImportDatabase - unable to read export stream", sr.err.Error())
+		}
+		if frame.typeName != typeName {
+			typeName = frame.typeName
+			attrList = frame.attrList
+			if attrList == nil {
+				attrList = make([]string, 0)
+			}
+			reqIdx = 0
+		}
+		totalRequests := reqIdx + 1
+		if nextFrame != nil && nextFrame.typeName == typeName {
+			totalRequests++
+		}
+
+		msgResponse, err = obj.sendBulkRequest(ctx, VerbPartialImportRequest, func(msg tgdb.TGMessage) {
+			partialRequest := msg.(*PartialImportRequestMessage)
+			partialRequest.SetIsBatch(true)
+			partialRequest.SetTypeName(typeName)
+			partialRequest.SetReqIdx(reqIdx)
+			partialRequest.SetTotalRequestsForType(totalRequests)
+			partialRequest.SetAttrList(attrList)
+			partialRequest.SetData(frame.data)
+		})
+		if err != nil {
+			return results, err
+		}
+		partialResponse, ok := msgResponse.(*PartialImportResponseMessage)
+		if !ok {
+			return results, bulkIOError(fmt.Sprintf("Unexpected response '%s' to partial import", GetVerb(msgResponse.GetVerbId()).GetName()), -1)
+		}
+		if partialResponse.GetResultStatus() != 0 {
+			return results, bulkIOError(fmt.Sprintf("Error importing batch %d of type '%s'", reqIdx, typeName), partialResponse.GetResultStatus())
+		}
+		for _, result := range partialResponse.GetResultList() {
+			if desc, found := resultsByType[result.GetTypeName()]; found {
+				desc.NumInstances += result.GetNumInstances()
+				continue
+			}
+			desc := NewBulkDescriptorImpl(result.GetTypeName(), result.IsNode(), result.GetNumInstances())
+			resultsByType[desc.TypeName] = desc
+			results = append(results, desc)
+		}
+
+		progress.TypeName = typeName
+		progress.Batches++
+		progress.Entities += int64(frame.numEntities)
+		progress.Bytes += int64(len(frame.data))
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+		reqIdx++
+		frame = nextFrame
 	}
-	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
-	if channelErr != nil {
-		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:Commit - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
-		return nil, channelErr
+	if sr.err != nil {
+		return results, GetErrorByType(TGErrorIOException, TGDB_BULKIO_ERROR, "AdminConnectionImpl:ImportDatabase - unable to read export stream", sr.err.Error())
 	}
+
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside AdminConnectionImpl::Commit received response for: pdu.VerbCommitTransactionRequest as '%+v'", msgResponse))
+		logger.Debug(fmt.Sprintf("Returning AdminConnectionImpl:ImportDatabase after importing %d entities in %d batches", progress.Entities, progress.Batches))
 	}
-	response := msgResponse.(*CommitTransactionResponse)
+	return results, nil
+}
 
-	if response.HasException() {
-		logger.Error(fmt.Sprint("ERROR: Returning AdminConnectionImpl:Commit as response has exceptions"))
-		// TODO: Revisit later - Should we not throw an appropriate exception?
-		return nil, nil
+// KillConnection terminates the connection forcefully
+func (obj *AdminConnectionImpl) KillConnection(sessionId int64) tgdb.TGError {
+	_, err := obj.executeAdminRequest(AdminCommandKillConnection, sessionId)
+	if err != nil {
+		return err
 	}
+	return nil
+}
 
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit about to fixUpAttrDescriptors()"))
-	}
-	fixUpAttrDescriptors(response, attrDescSet)
-	if logger.IsDebug() {
-		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::Commit about to obj.fixUpEntities()"))
+// SetServerLogLevel set the log level
+func (obj *AdminConnectionImpl) SetServerLogLevel(logLevel int, logComponent int64) tgdb.TGError {
+	logDetails := NewServerLogDetails(TGLogLevel(logLevel), TGLogComponent(logComponent))
+	_, err := obj.executeAdminRequest(AdminCommandSetLogLevel, logDetails)
+	if err != nil {
+		return err
 	}
-	fixUpEntities(obj, response)
+	return nil
+}
 
-	for _, delEntity := range obj.GetRemovedList() {
-		delEntity.SetIsDeleted(true)
+// StopServer stops the admin connection
+func (obj *AdminConnectionImpl) StopServer() tgdb.TGError {
+	_, err := obj.executeAdminRequest(AdminCommandStopServer, nil)
+	if err != nil {
+		return err
 	}
+	return nil
+}
 
-	// Reset and clear all the lists
-	for _, modEntity := range obj.GetChangedList() {
-		modEntity.ResetModifiedAttributes()
-	}
-	for _, newEntity := range obj.GetAddedList() {
-		newEntity.ResetModifiedAttributes()
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGConnection
+/////////////////////////////////////////////////////////////////
+
+// Commit commits the current transaction on this connection
+func (obj *AdminConnectionImpl) Commit() (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.CommitContext(context.Background())
+}
+
+// CommitContext is Commit that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) CommitContext(ctx context.Context) (tgdb.TGResultSet, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering AdminConnectionImpl:Commit"))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 
-	obj.addedList = make(map[int64]tgdb.TGEntity, 0)
-	obj.changedList = make(map[int64]tgdb.TGEntity, 0)
-	obj.removedList = make(map[int64]tgdb.TGEntity, 0)
-	obj.attrByTypeList = make(map[int][]tgdb.TGAttribute, 0)
+	err := commitChanges(ctx, obj.TGDBConnection, &changeList{addedList: obj.addedList, changedList: obj.changedList, removedList: obj.removedList})
+	if err != nil {
+		if _, ok := err.(tgdb.TGTransactionError); ok && obj.rollbackOnCommitFailure() {
+			logger.Warning(fmt.Sprint("WARNING: AdminConnectionImpl:Commit - rolling back the pending changes"))
+			obj.resetChanges()
+		}
+		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:Commit w/ error: '%s'", err.Error()))
+		return nil, err
+	}
+	obj.resetChanges()
 
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Returning AdminConnectionImpl:Commit"))
@@ -3036,12 +4437,14 @@ func (obj *AdminConnectionImpl) Connect() tgdb.TGError {
 
 // CloseQuery closes a specific query and associated objects
 func (obj *AdminConnectionImpl) CloseQuery(queryHashId int64) (tgdb.TGQuery, tgdb.TGError) {
+	return obj.CloseQueryContext(context.Background(), queryHashId)
+}
+
+// CloseQueryContext is CloseQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) CloseQueryContext(ctx context.Context, queryHashId int64) (tgdb.TGQuery, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:CloseQuery for QueryHashId: '%+v'", queryHashId))
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::CloseQuery about to createChannelRequest() for: pdu.VerbQueryRequest"))
 	}
@@ -3059,7 +4462,7 @@ func (obj *AdminConnectionImpl) CloseQuery(queryHashId int64) (tgdb.TGQuery, tgd
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::CloseQuery about to obj.GetChannel().SendRequest() for: pdu.VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	_, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	_, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:CloseQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3073,6 +4476,11 @@ func (obj *AdminConnectionImpl) CloseQuery(queryHashId int64) (tgdb.TGQuery, tgd
 
 // CreateQuery creates a reusable query object that can be used to execute one or more statement
 func (obj *AdminConnectionImpl) CreateQuery(expr string) (tgdb.TGQuery, tgdb.TGError) {
+	return obj.CreateQueryContext(context.Background(), expr)
+}
+
+// CreateQueryContext is CreateQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) CreateQueryContext(ctx context.Context, expr string) (tgdb.TGQuery, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:CreateQuery for Query: '%+v'", expr))
 	}
@@ -3080,9 +4488,6 @@ func (obj *AdminConnectionImpl) CreateQuery(expr string) (tgdb.TGQuery, tgdb.TGE
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::CreateQuery about to createChannelRequest() for: pdu.VerbQueryRequest"))
 	}
@@ -3100,7 +4505,7 @@ func (obj *AdminConnectionImpl) CreateQuery(expr string) (tgdb.TGQuery, tgdb.TGE
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::CreateQuery about to obj.GetChannel().SendRequest() for: pdu.VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:CreateQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3162,17 +4567,28 @@ func (obj *AdminConnectionImpl) DecryptBuffer(is tgdb.TGInputStream) ([]byte, tg
 	//
 	//logger.Log(fmt.Sprintf("Returning AdminConnectionImpl:DecryptBuffer w/ '%+v'", response.GetDecryptedBuffer()))
 	//return response.GetDecryptedBuffer(), nil
-	cryptoGrapher := obj.GetChannel().GetDataCryptoGrapher()
+	cryptoGrapher, err := getDataCryptoGrapher(obj)
+	if err != nil {
+		return nil, err
+	}
 	return cryptoGrapher.Decrypt(is)
 }
 
 // DecryptEntity decrypts the encrypted entity using channel's data cryptographer
 func (obj *AdminConnectionImpl) DecryptEntity(entityId int64) ([]byte, tgdb.TGError) {
-	buf, err := obj.GetLargeObjectAsBytes(entityId, true)
+	return obj.DecryptEntityContext(context.Background(), entityId)
+}
+
+// DecryptEntityContext is DecryptEntity that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) DecryptEntityContext(ctx context.Context, entityId int64) ([]byte, tgdb.TGError) {
+	buf, err := obj.GetLargeObjectAsBytesContext(ctx, entityId, true)
+	if err != nil {
+		return nil, err
+	}
+	cryptoGrapher, err := getDataCryptoGrapher(obj)
 	if err != nil {
 		return nil, err
 	}
-	cryptoGrapher := obj.GetChannel().GetDataCryptoGrapher()
 	return cryptoGrapher.Decrypt(NewProtocolDataInputStream(buf))
 }
 
@@ -3181,6 +4597,16 @@ func (obj *AdminConnectionImpl) DeleteEntity(entity tgdb.TGEntity) tgdb.TGError
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:DeleteEntity for Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
+	if entity.GetIsNew() {
+		// The server has never seen this entity, so only the pending insert needs to be dropped
+		delete(obj.addedList, entity.GetVirtualId())
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprint("Returning AdminConnectionImpl:DeleteEntity - dropped the pending insert of a new entity"))
+		}
+		return nil
+	}
 	obj.removedList[entity.GetVirtualId()] = entity
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Returning AdminConnectionImpl:DeleteEntity"))
@@ -3210,12 +4636,20 @@ func (obj *AdminConnectionImpl) Disconnect() tgdb.TGError {
 
 // EncryptEntity encrypts the encrypted entity using channel's data cryptographer
 func (obj *AdminConnectionImpl) EncryptEntity(rawBuffer []byte) ([]byte, tgdb.TGError) {
-	cryptoGrapher := obj.GetChannel().GetDataCryptoGrapher()
+	cryptoGrapher, err := getDataCryptoGrapher(obj)
+	if err != nil {
+		return nil, err
+	}
 	return cryptoGrapher.Encrypt(rawBuffer)
 }
 
 // ExecuteGremlinQuery executes a Gremlin Grammer-Based query with  query options
 func (obj *AdminConnectionImpl) ExecuteGremlinQuery(expr string, collection []interface{}, options tgdb.TGQueryOption) ([]interface{}, tgdb.TGError) {
+	return obj.ExecuteGremlinQueryContext(context.Background(), expr, collection, options)
+}
+
+// ExecuteGremlinQueryContext is ExecuteGremlinQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) ExecuteGremlinQueryContext(ctx context.Context, expr string, collection []interface{}, options tgdb.TGQueryOption) ([]interface{}, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:ExecuteGremlinQuery for Query: '%+v'", expr))
 	}
@@ -3223,9 +4657,6 @@ func (obj *AdminConnectionImpl) ExecuteGremlinQuery(expr string, collection []in
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::ExecuteGremlinQuery about to createChannelRequest() for: pdu.VerbQueryRequest"))
 	}
@@ -3247,7 +4678,7 @@ func (obj *AdminConnectionImpl) ExecuteGremlinQuery(expr string, collection []in
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::ExecuteGremlinQuery about to obj.GetChannel().SendRequest() for: pdu.VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:ExecuteGremlinQuery - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3277,7 +4708,12 @@ func (obj *AdminConnectionImpl) ExecuteGremlinQuery(expr string, collection []in
 // ExecuteQuery executes an immediate query with associated query options
 
 func (obj *AdminConnectionImpl) ExecuteQuery(expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
-	return obj.TGDBConnection.ExecuteQuery(expr, options)
+	return obj.ExecuteQueryContext(context.Background(), expr, options)
+}
+
+// ExecuteQueryContext is ExecuteQuery that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) ExecuteQueryContext(ctx context.Context, expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.TGDBConnection.ExecuteQueryContext(ctx, expr, options)
 }
 
 //func (obj *AdminConnectionImpl) ExecuteQuery(expr string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
@@ -3329,6 +4765,18 @@ func (obj *AdminConnectionImpl) ExecuteQuery(expr string, options tgdb.TGQueryOp
 // @param endCondition condition used to stop the traversal
 // @param option Query options for executing. Can be null, then it will use the default option
 func (obj *AdminConnectionImpl) ExecuteQueryWithFilter(expr, edgeFilter, traversalCondition, endCondition string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.ExecuteQueryWithFilterContext(context.Background(), expr, edgeFilter, traversalCondition, endCondition, options)
+}
+
+// ExecuteQueryWithFilterContext is ExecuteQueryWithFilter that gives up waiting on the server once ctx is cancelled or expires. Failures that the
+// retry policy of the connection retries are retried
+func (obj *AdminConnectionImpl) ExecuteQueryWithFilterContext(ctx context.Context, expr, edgeFilter, traversalCondition, endCondition string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.retryQuery(ctx, "ExecuteQueryWithFilter", func() (tgdb.TGResultSet, tgdb.TGError) {
+		return obj.executeQueryWithFilter(ctx, expr, edgeFilter, traversalCondition, endCondition, options)
+	})
+}
+
+func (obj *AdminConnectionImpl) executeQueryWithFilter(ctx context.Context, expr, edgeFilter, traversalCondition, endCondition string, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:ExecuteQueryWithFilter for Query: '%+v', EdgeFilter: '%+v', Traversal: '%+v', EndCondition: '%+v'", expr, edgeFilter, traversalCondition, endCondition))
 	}
@@ -3336,9 +4784,6 @@ func (obj *AdminConnectionImpl) ExecuteQueryWithFilter(expr, edgeFilter, travers
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::ExecuteQueryWithFilter about to createChannelRequest() for: pdu.VerbQueryRequest"))
 	}
@@ -3363,7 +4808,7 @@ func (obj *AdminConnectionImpl) ExecuteQueryWithFilter(expr, edgeFilter, travers
 		logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::ExecuteQueryWithFilter about to obj.GetChannel().SendRequest() for: pdu.VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:ExecuteQueryWithFilter - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3386,6 +4831,18 @@ func (obj *AdminConnectionImpl) ExecuteQueryWithFilter(expr, edgeFilter, travers
 
 // ExecuteQueryWithId executes an immediate query for specified id & query options
 func (obj *AdminConnectionImpl) ExecuteQueryWithId(queryHashId int64, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.ExecuteQueryWithIdContext(context.Background(), queryHashId, options)
+}
+
+// ExecuteQueryWithIdContext is ExecuteQueryWithId that gives up waiting on the server once ctx is cancelled or expires. Failures that the
+// retry policy of the connection retries are retried
+func (obj *AdminConnectionImpl) ExecuteQueryWithIdContext(ctx context.Context, queryHashId int64, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.retryQuery(ctx, "ExecuteQueryWithId", func() (tgdb.TGResultSet, tgdb.TGError) {
+		return obj.executeQueryWithId(ctx, queryHashId, options)
+	})
+}
+
+func (obj *AdminConnectionImpl) executeQueryWithId(ctx context.Context, queryHashId int64, options tgdb.TGQueryOption) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:ExecuteQueryWithId for QueryHashId: '%+v'", queryHashId))
 	}
@@ -3393,9 +4850,6 @@ func (obj *AdminConnectionImpl) ExecuteQueryWithId(queryHashId int64, options tg
 	if err != nil {
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::ExecuteQueryWithId about to createChannelRequest() for: pdu.VerbQueryRequest"))
 	}
@@ -3417,7 +4871,7 @@ func (obj *AdminConnectionImpl) ExecuteQueryWithId(queryHashId int64, options tg
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::ExecuteQueryWithId about to obj.GetChannel().SendRequest() for: pdu.VerbQueryRequest"))
 	}
 	// Execute request on channel and get the response
-	_, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	_, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:ExecuteQueryWithId - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3456,6 +4910,11 @@ func (obj *AdminConnectionImpl) GetConnectionProperties() tgdb.TGProperties {
 
 // GetEntities gets a result set of entities given an non-uniqueKey
 func (obj *AdminConnectionImpl) GetEntities(qryKey tgdb.TGKey, props tgdb.TGProperties) (tgdb.TGResultSet, tgdb.TGError) {
+	return obj.GetEntitiesContext(context.Background(), qryKey, props)
+}
+
+// GetEntitiesContext is GetEntities that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) GetEntitiesContext(ctx context.Context, qryKey tgdb.TGKey, props tgdb.TGProperties) (tgdb.TGResultSet, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:GetEntities for QueryKey: '%+v'", qryKey))
 	}
@@ -3464,9 +4923,6 @@ func (obj *AdminConnectionImpl) GetEntities(qryKey tgdb.TGKey, props tgdb.TGProp
 		logger.Error(fmt.Sprint("ERROR: Returning AdminConnectionImpl:GetEntities - unable to InitMetadata"))
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if props == nil {
 		props = NewQueryOption()
 	}
@@ -3488,7 +4944,7 @@ func (obj *AdminConnectionImpl) GetEntities(qryKey tgdb.TGKey, props tgdb.TGProp
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::GetEntities about to obj.GetChannel().SendRequest() for: pdu.VerbGetEntityRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:GetEntities - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3506,11 +4962,21 @@ func (obj *AdminConnectionImpl) GetEntities(qryKey tgdb.TGKey, props tgdb.TGProp
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Returning AdminConnectionImpl:GetEntities w/ '%+v'", response))
 	}
-	return obj.populateResultSetFromGetEntitiesResponse(response)
+	rSet, err := obj.populateResultSetFromGetEntitiesResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	obj.attachEntityCursor(rSet, queryRequest)
+	return rSet, nil
 }
 
 // GetEntity gets an Entity given an UniqueKey for the Object
 func (obj *AdminConnectionImpl) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQueryOption) (tgdb.TGEntity, tgdb.TGError) {
+	return obj.GetEntityContext(context.Background(), qryKey, options)
+}
+
+// GetEntityContext is GetEntity that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) GetEntityContext(ctx context.Context, qryKey tgdb.TGKey, options tgdb.TGQueryOption) (tgdb.TGEntity, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:GetEntity for QueryKey: '%+v'", qryKey))
 	}
@@ -3519,9 +4985,6 @@ func (obj *AdminConnectionImpl) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQuer
 		logger.Error(fmt.Sprint("ERROR: Returning AdminConnectionImpl:GetEntity - unable to InitMetadata"))
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if options == nil {
 		options = NewQueryOption()
 	}
@@ -3543,7 +5006,7 @@ func (obj *AdminConnectionImpl) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQuer
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::GetEntity about to obj.GetChannel().SendRequest() for: pdu.VerbGetEntityRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:GetEntity - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3566,13 +5029,15 @@ func (obj *AdminConnectionImpl) GetEntity(qryKey tgdb.TGKey, options tgdb.TGQuer
 
 // GetGraphMetadata gets the Graph Metadata
 func (obj *AdminConnectionImpl) GetGraphMetadata(refresh bool) (tgdb.TGGraphMetadata, tgdb.TGError) {
+	return obj.GetGraphMetadataContext(context.Background(), refresh)
+}
+
+// GetGraphMetadataContext is GetGraphMetadata that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) GetGraphMetadataContext(ctx context.Context, refresh bool) (tgdb.TGGraphMetadata, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Entering AdminConnectionImpl:GetGraphMetadata"))
 	}
 	if refresh {
-		obj.connPoolImpl.AdminLock()
-		defer obj.connPoolImpl.AdminUnlock()
-
 		if logger.IsDebug() {
 					logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::GetGraphMetadata about to createChannelRequest() for: pdu.VerbMetadataRequest"))
 		}
@@ -3591,7 +5056,7 @@ func (obj *AdminConnectionImpl) GetGraphMetadata(refresh bool) (tgdb.TGGraphMeta
 					logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::GetGraphMetadata about to obj.GetChannel().SendRequest() for: pdu.VerbMetadataRequest"))
 		}
 		// Execute request on channel and get the response
-		msgResponse, channelErr := obj.GetChannel().SendRequest(metaRequest, channelResponse.(*BlockingChannelResponse))
+		msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, metaRequest, channelResponse.(*BlockingChannelResponse))
 		if channelErr != nil {
 			logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:GetGraphMetadata - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 			return nil, channelErr
@@ -3640,6 +5105,11 @@ func (obj *AdminConnectionImpl) GetGraphObjectFactory() (tgdb.TGGraphObjectFacto
 
 // GetLargeObjectAsBytes gets an Binary Large Object Entity given an UniqueKey for the Object
 func (obj *AdminConnectionImpl) GetLargeObjectAsBytes(entityId int64, decryptFlag bool) ([]byte, tgdb.TGError) {
+	return obj.GetLargeObjectAsBytesContext(context.Background(), entityId, decryptFlag)
+}
+
+// GetLargeObjectAsBytesContext is GetLargeObjectAsBytes that gives up waiting on the server once ctx is cancelled or expires
+func (obj *AdminConnectionImpl) GetLargeObjectAsBytesContext(ctx context.Context, entityId int64, decryptFlag bool) ([]byte, tgdb.TGError) {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:GetLargeObjectAsBytes for EntityId: '%+v'", entityId))
 	}
@@ -3648,9 +5118,6 @@ func (obj *AdminConnectionImpl) GetLargeObjectAsBytes(entityId int64, decryptFla
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:GetLargeObjectAsBytes - unable to initialize metadata w/ error: '%s'", err.Error()))
 		return nil, err
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
-
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::GetLargeObjectAsBytes about to createChannelRequest() for: pdu.VerbGetLargeObjectRequest"))
 	}
@@ -3668,7 +5135,7 @@ func (obj *AdminConnectionImpl) GetLargeObjectAsBytes(entityId int64, decryptFla
 			logger.Debug(fmt.Sprint("Inside AdminConnectionImpl::GetLargeObjectAsBytes about to obj.GetChannel().SendRequest() for: pdu.VerbGetLargeObjectRequest"))
 	}
 	// Execute request on channel and get the response
-	msgResponse, channelErr := obj.GetChannel().SendRequest(queryRequest, channelResponse.(*BlockingChannelResponse))
+	msgResponse, channelErr := obj.GetChannel().SendRequestContext(ctx, queryRequest, channelResponse.(*BlockingChannelResponse))
 	if channelErr != nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning AdminConnectionImpl:GetLargeObjectAsBytes - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
 		return nil, channelErr
@@ -3700,6 +5167,8 @@ func (obj *AdminConnectionImpl) InsertEntity(entity tgdb.TGEntity) tgdb.TGError
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:InsertEntity to insert Entity: '%+v'", entity.GetEntityType()))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	obj.addedList[entity.GetVirtualId()] = entity
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Returning AdminConnectionImpl:InsertEntity"))
@@ -3712,8 +5181,8 @@ func (obj *AdminConnectionImpl) Rollback() tgdb.TGError {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Entering AdminConnectionImpl:Rollback"))
 	}
-	obj.connPoolImpl.AdminLock()
-	defer obj.connPoolImpl.AdminUnlock()
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 
 	// Reset all the lists to empty contents
 	obj.addedList = make(map[int64]tgdb.TGEntity, 0)
@@ -3741,6 +5210,8 @@ func (obj *AdminConnectionImpl) UpdateEntity(entity tgdb.TGEntity) tgdb.TGError
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:UpdateEntity to update Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	obj.changedList[entity.GetVirtualId()] = entity
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Returning AdminConnectionImpl:UpdateEntity"))
@@ -3778,6 +5249,8 @@ func (obj *AdminConnectionImpl) EntityCreated(entity tgdb.TGEntity) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:EntityCreated to add Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := entity.(*AbstractEntity).GetVirtualId()
 	obj.addedList[entityId] = entity
 	if logger.IsDebug() {
@@ -3790,6 +5263,8 @@ func (obj *AdminConnectionImpl) EntityDeleted(entity tgdb.TGEntity) {
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:EntityDeleted to delete Entity: '%+v'", entity))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := entity.(*AbstractEntity).GetVirtualId()
 	obj.removedList[entityId] = entity
 	if logger.IsDebug() {
@@ -3802,6 +5277,8 @@ func (obj *AdminConnectionImpl) NodeAdded(graph tgdb.TGGraph, node tgdb.TGNode)
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:NodeAdded to add Node: '%+v' to Graph: '%+v'", node, graph))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := graph.(*Graph).GetVirtualId()
 	obj.addedList[entityId] = graph
 	if logger.IsDebug() {
@@ -3814,6 +5291,8 @@ func (obj *AdminConnectionImpl) NodeRemoved(graph tgdb.TGGraph, node tgdb.TGNode
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering AdminConnectionImpl:NodeRemoved to remove Node: '%+v' to Graph: '%+v'", node, graph))
 	}
+	obj.txnLock.Lock()
+	defer obj.txnLock.Unlock()
 	entityId := graph.(*Graph).GetVirtualId()
 	obj.removedList[entityId] = graph
 	if logger.IsDebug() {
@@ -4044,8 +5523,20 @@ func (obj *AdminConnectionImpl) String() string {
 
 
 
+// Transaction states
+const (
+	TransactionActive = iota
+	TransactionCommitted
+	TransactionRolledBack
+)
+
 type TransactionImpl struct {
 	transactionId int64
+	conn          *TGDBConnection
+	ctx           context.Context
+	lock          sync.Mutex // guards state and changes, so the transaction can be handed between goroutines
+	state         int
+	changes       *changeList
 }
 
 // Make sure that the transactionImpl implements the TGTransaction interface
@@ -4057,7 +5548,16 @@ func DefaultTransaction() *TransactionImpl {
 	// engine which concrete type is being sent that implements the interface.
 	gob.Register(TransactionImpl{})
 
-	return &TransactionImpl{transactionId: -1}
+	return &TransactionImpl{
+		transactionId: -1,
+		ctx:           context.Background(),
+		state:         TransactionActive,
+		changes: &changeList{
+			addedList:   make(map[int64]tgdb.TGEntity, 0),
+			changedList: make(map[int64]tgdb.TGEntity, 0),
+			removedList: make(map[int64]tgdb.TGEntity, 0),
+		},
+	}
 }
 
 func NewTransaction(txnId int64) *TransactionImpl {
@@ -4070,14 +5570,186 @@ func NewTransaction(txnId int64) *TransactionImpl {
 // Helper functions from Interface ==> TGTransaction
 /////////////////////////////////////////////////////////////////
 
+// checkActive returns an error unless the transaction is still open. The caller holds the lock.
+func (obj *TransactionImpl) checkActive(operation string) tgdb.TGError {
+	if obj.conn == nil {
+		errMsg := fmt.Sprintf("Transaction '%d' cannot %s as it is not bound to a connection", obj.transactionId, operation)
+		return NewTGTransactionInBadState(errMsg)
+	}
+	if obj.state != TransactionActive {
+		errMsg := fmt.Sprintf("Transaction '%d' cannot %s as it is already %s", obj.transactionId, operation, transactionStateName(obj.state))
+		return NewTGTransactionInBadState(errMsg)
+	}
+	return nil
+}
+
+// end moves the transaction out of the active state and lets the connection begin the next one. The caller
+// holds the lock and has checked that the transaction is active.
+func (obj *TransactionImpl) end(state int) {
+	obj.state = state
+	obj.conn.endTransaction()
+}
+
+func transactionStateName(state int) string {
+	switch state {
+	case TransactionActive:
+		return "active"
+	case TransactionCommitted:
+		return "committed"
+	case TransactionRolledBack:
+		return "rolled back"
+	}
+	return "invalid"
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGTransaction
+/////////////////////////////////////////////////////////////////
+
+// GetTransactionId gets the id the server assigned to this transaction
 func (obj *TransactionImpl) GetTransactionId() int64 {
 	return obj.transactionId
 }
 
+// IsActive checks whether the transaction can still be used
+func (obj *TransactionImpl) IsActive() bool {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.state == TransactionActive
+}
+
+// Insert marks an entity for insert when this transaction commits
+func (obj *TransactionImpl) Insert(entity tgdb.TGEntity) tgdb.TGError {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if err := obj.checkActive("insert"); err != nil {
+		return err
+	}
+	obj.changes.addedList[entity.GetVirtualId()] = entity
+	return nil
+}
+
+// Update marks an entity for update when this transaction commits
+func (obj *TransactionImpl) Update(entity tgdb.TGEntity) tgdb.TGError {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if err := obj.checkActive("update"); err != nil {
+		return err
+	}
+	if entity.GetIsNew() {
+		// Inserted in this transaction - the insert already carries the latest attribute values
+		return nil
+	}
+	obj.changes.changedList[entity.GetVirtualId()] = entity
+	return nil
+}
+
+// Delete marks an entity for delete when this transaction commits
+func (obj *TransactionImpl) Delete(entity tgdb.TGEntity) tgdb.TGError {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if err := obj.checkActive("delete"); err != nil {
+		return err
+	}
+	if entity.GetIsNew() {
+		// The server has never seen this entity, so only the pending insert needs to be dropped
+		delete(obj.changes.addedList, entity.GetVirtualId())
+		return nil
+	}
+	delete(obj.changes.changedList, entity.GetVirtualId())
+	obj.changes.removedList[entity.GetVirtualId()] = entity
+	return nil
+}
+
+// Get gets an entity by key as this transaction sees it - pending updates are returned and pending deletes are not
+func (obj *TransactionImpl) Get(key tgdb.TGKey, options tgdb.TGQueryOption) (tgdb.TGEntity, tgdb.TGError) {
+	obj.lock.Lock()
+	if err := obj.checkActive("get"); err != nil {
+		obj.lock.Unlock()
+		return nil, err
+	}
+	conn, ctx := obj.conn, obj.ctx
+	obj.lock.Unlock()
+
+	entity, err := conn.GetEntityContext(ctx, key, options)
+	if err != nil || entity == nil {
+		return entity, err
+	}
+
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if _, ok := obj.changes.removedList[entity.GetVirtualId()]; ok {
+		return nil, nil
+	}
+	if pending, ok := obj.changes.changedList[entity.GetVirtualId()]; ok {
+		return pending, nil
+	}
+	return entity, nil
+}
+
+// Commit sends the changes of this transaction to the server. A rejected commit leaves the transaction open so
+// that the caller can fix the changes and commit again, unless tgdb.connection.rollbackOnCommitFailure is set.
+func (obj *TransactionImpl) Commit() (tgdb.TGResultSet, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TransactionImpl:Commit for transaction '%d'", obj.transactionId))
+	}
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if err := obj.checkActive("commit"); err != nil {
+		return nil, err
+	}
+	err := commitChanges(obj.ctx, obj.conn, obj.changes)
+	if err != nil {
+		if _, ok := err.(tgdb.TGTransactionError); ok && obj.conn.rollbackOnCommitFailure() {
+			obj.end(TransactionRolledBack)
+		}
+		logger.Error(fmt.Sprintf("ERROR: Returning TransactionImpl:Commit for transaction '%d' w/ error: '%s'", obj.transactionId, err.Error()))
+		return nil, err
+	}
+	obj.end(TransactionCommitted)
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TransactionImpl:Commit for transaction '%d'", obj.transactionId))
+	}
+	return nil, nil
+}
+
+// Rollback discards the changes of this transaction and asks the server to forget it. Rolling back a transaction
+// that is no longer active is a no-op.
+func (obj *TransactionImpl) Rollback() tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TransactionImpl:Rollback for transaction '%d'", obj.transactionId))
+	}
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if obj.conn == nil || obj.state != TransactionActive {
+		return nil
+	}
+	obj.end(TransactionRolledBack)
+	obj.changes.addedList = make(map[int64]tgdb.TGEntity, 0)
+	obj.changes.changedList = make(map[int64]tgdb.TGEntity, 0)
+	obj.changes.removedList = make(map[int64]tgdb.TGEntity, 0)
+
+	msgRequest, channelResponse, err := createChannelRequest(obj.conn, VerbRollbackTransactionRequest)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TransactionImpl:Rollback - unable to createChannelRequest(VerbRollbackTransactionRequest w/ error: '%s'", err.Error()))
+		return err
+	}
+	_, channelErr := obj.conn.GetChannel().SendRequestContext(obj.ctx, msgRequest, channelResponse.(*BlockingChannelResponse))
+	if channelErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TransactionImpl:Rollback - unable to channel.SendRequest() w/ error: '%s'", channelErr.Error()))
+		return channelErr
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TransactionImpl:Rollback for transaction '%d'", obj.transactionId))
+	}
+	return nil
+}
+
 func (obj *TransactionImpl) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("TransactionImpl:{")
 	buffer.WriteString(fmt.Sprintf("TransactionId: '%d'", obj.transactionId))
+	buffer.WriteString(fmt.Sprintf(", State: '%s'", transactionStateName(obj.state)))
 	buffer.WriteString("}")
 	return buffer.String()
 }
@@ -4128,6 +5800,70 @@ func (obj *TransactionImpl) UnmarshalBinary(data []byte) error {
 	return nil
 }
 
+// WithTransaction runs fn in a transaction on conn and commits it. If fn fails the transaction is rolled back and
+// the error returned. When the commit fails with an error the retry policy of conn retries, e.g. an optimistic lock
+// failure, fn is run again in a new transaction, so fn should read what it changes through tx.Get.
+func WithTransaction(conn tgdb.TGConnection, fn func(tx tgdb.TGTransaction) error) tgdb.TGError {
+	return WithTransactionContext(context.Background(), conn, fn)
+}
+
+// WithTransactionContext is WithTransaction that bounds the transactions and the waits between retries with ctx
+func WithTransactionContext(ctx context.Context, conn tgdb.TGConnection, fn func(tx tgdb.TGTransaction) error) tgdb.TGError {
+	return retryCommit(ctx, conn, "WithTransaction", func() tgdb.TGError {
+		tx, err := conn.Begin(&tgdb.TGTransactionOptions{Context: ctx})
+		if err != nil {
+			return err
+		}
+		return runTransaction(tx, fn)
+	})
+}
+
+// WithCommit is WithTransaction for the implicit transaction of conn. fn marks its changes with InsertEntity,
+// UpdateEntity and DeleteEntity, and they are committed when it returns. Whenever fn or the commit fails the
+// pending changes are rolled back, and fn is run again if the retry policy of conn retries the commit error.
+func WithCommit(conn tgdb.TGConnection, fn func(conn tgdb.TGConnection) error) (tgdb.TGResultSet, tgdb.TGError) {
+	return WithCommitContext(context.Background(), conn, fn)
+}
+
+// WithCommitContext is WithCommit that bounds the commits and the waits between retries with ctx
+func WithCommitContext(ctx context.Context, conn tgdb.TGConnection, fn func(conn tgdb.TGConnection) error) (tgdb.TGResultSet, tgdb.TGError) {
+	var rSet tgdb.TGResultSet
+	err := retryCommit(ctx, conn, "WithCommit", func() tgdb.TGError {
+		if err := fn(conn); err != nil {
+			_ = conn.Rollback()
+			if tgErr, ok := err.(tgdb.TGError); ok {
+				return tgErr
+			}
+			return GetErrorByType(TGErrorGeneralException, "", err.Error(), "")
+		}
+		var cErr tgdb.TGError
+		rSet, cErr = conn.CommitContext(ctx)
+		if cErr != nil {
+			_ = conn.Rollback()
+		}
+		return cErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rSet, nil
+}
+
+func runTransaction(tx tgdb.TGTransaction, fn func(tx tgdb.TGTransaction) error) tgdb.TGError {
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		if tgErr, ok := err.(tgdb.TGError); ok {
+			return tgErr
+		}
+		return GetErrorByType(TGErrorGeneralException, "", err.Error(), "")
+	}
+	if _, err := tx.Commit(); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return nil
+}
+
 
 
 type TGConnectionFactoryImpl struct {
@@ -4137,7 +5873,7 @@ type TGConnectionFactoryImpl struct {
 // @throws com.tibco.tgdb.exception.TGException - If it cannot create a connection to the server successfully
 func (obj *TGConnectionFactoryImpl) CreateConnection(url, user, pwd string, env map[string]string) (tgdb.TGConnection, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateConnection w/ URL: '%s' User: '%s', and Pwd: '%s'", url, user, pwd))
+		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateConnection w/ URL: '%s' User: '%s'", redactUrl(url), user))
 	}
 	connPool, err := obj.CreateConnectionPool(url, user, pwd, 1, env)
 	if err != nil {
@@ -4161,7 +5897,7 @@ func (obj *TGConnectionFactoryImpl) CreateConnection(url, user, pwd string, env
 // Create an admin connection on the url using the Name and password
 func (obj *TGConnectionFactoryImpl) CreateAdminConnection(url, user, pwd string, env map[string]string) (tgdb.TGConnection, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateAdminConnection w/ URL: '%s' User: '%s', and Pwd: '%s'", url, user, pwd))
+		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateAdminConnection w/ URL: '%s' User: '%s'", redactUrl(url), user))
 	}
 	connPool, err := obj.CreateConnectionPoolWithType(url, user, pwd, 1, env, tgdb.TypeAdmin)
 	if err != nil {
@@ -4198,8 +5934,67 @@ func (obj *TGConnectionFactoryImpl) CreateConnectionPool(url, user, pwd string,
 // @throws com.tibco.tgdb.exception.TGException - If it cannot create a connection pool to the server successfully
 func (obj *TGConnectionFactoryImpl) CreateConnectionPoolWithType(url, user, pwd string, poolSize int, env map[string]string, connType tgdb.TypeConnection) (tgdb.TGConnectionPool, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateConnectionPool w/ URL: '%s' User: '%s', Pwd: '%s' and Environment: '%+v'", url, user, pwd, env))
+		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateConnectionPool w/ URL: '%s' User: '%s' and Environment: '%+v'", redactUrl(url), user, env))
+	}
+	return obj.createConnectionPool(url, user, pwd, nil, poolSize, env, connType)
+}
+
+// Create a connection on the url that authenticates with the credentials the provider hands out
+func (obj *TGConnectionFactoryImpl) CreateConnectionWithCredentials(url string, credentials tgdb.TGCredentialProvider, env map[string]string) (tgdb.TGConnection, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateConnectionWithCredentials w/ URL: '%s' Credentials: '%+v'", redactUrl(url), credentials))
 	}
+	connPool, err := obj.CreateConnectionPoolWithCredentials(url, credentials, 1, env, tgdb.TypeConventional)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGConnectionFactory:CreateConnectionWithCredentials - unable to create connection pool - '%+v", err.Error()))
+		return nil, err
+	}
+	conn, err := connPool.Get()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGConnectionFactory:CreateConnectionWithCredentials - unable to connPool.Get() - '%+v", err.Error()))
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Create an admin connection on the url that authenticates with the credentials the provider hands out
+func (obj *TGConnectionFactoryImpl) CreateAdminConnectionWithCredentials(url string, credentials tgdb.TGCredentialProvider, env map[string]string) (tgdb.TGConnection, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateAdminConnectionWithCredentials w/ URL: '%s' Credentials: '%+v'", redactUrl(url), credentials))
+	}
+	connPool, err := obj.CreateConnectionPoolWithCredentials(url, credentials, 1, env, tgdb.TypeAdmin)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGConnectionFactory:CreateAdminConnectionWithCredentials - unable to create connection pool - '%+v", err.Error()))
+		return nil, err
+	}
+	conn, err := connPool.Get()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGConnectionFactory:CreateAdminConnectionWithCredentials - unable to connPool.Get() - '%+v", err.Error()))
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Create a connection Pool of pool size on the url whose channels authenticate with the credentials the provider hands
+// out. The provider is asked again on every reconnect, so a rotated password is picked up without recreating the pool
+func (obj *TGConnectionFactoryImpl) CreateConnectionPoolWithCredentials(url string, credentials tgdb.TGCredentialProvider, poolSize int, env map[string]string, connType tgdb.TypeConnection) (tgdb.TGConnectionPool, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TGConnectionFactory:CreateConnectionPoolWithCredentials w/ URL: '%s' Credentials: '%+v' and Environment: '%+v'", redactUrl(url), credentials, env))
+	}
+	if credentials == nil {
+		errMsg := "TGConnectionFactory:CreateConnectionPoolWithCredentials requires a credential provider"
+		return nil, GetErrorByType(TGErrorBadAuthentication, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	return obj.createConnectionPool(url, "", "", credentials, poolSize, env, connType)
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for TGConnectionFactoryImpl
+/////////////////////////////////////////////////////////////////
+
+// createConnectionPool consolidates the pool properties and strips any password settings from them. Unless credentials
+// are given, the pool authenticates with pwd or else the password settings found in env and the url
+func (obj *TGConnectionFactoryImpl) createConnectionPool(url, user, pwd string, credentials tgdb.TGCredentialProvider, poolSize int, env map[string]string, connType tgdb.TypeConnection) (tgdb.TGConnectionPool, tgdb.TGError) {
 	if poolSize <= 0 {
 		poolSize = NewTGEnvironment().GetConnectionPoolDefaultPoolSize()
 	}
@@ -4223,13 +6018,21 @@ func (obj *TGConnectionFactoryImpl) CreateConnectionPoolWithType(url, user, pwd
 		for _, kvp := range channelProps.(*SortedProperties).GetAllProperties() {
 			props.AddProperty(kvp.KeyName, kvp.KeyValue)
 		}
+		// The url keeps its own copy of the properties, which must not hold on to a password either
+		_ = takeCredentialProvider(channelProps.(*SortedProperties), "")
+	}
+	if user != "" {
+		_ = props.SetUser(user) // Ignore Error Handling
+	}
+	propCredentials := takeCredentialProvider(props, pwd)
+	if credentials == nil {
+		credentials = propCredentials
 	}
-	_ = SetUserAndPassword(props, user, pwd) // Ignore Error Handling
 	// At this point, the consolidated property set is already sorted by key a.k.a. property Name
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Returning TGConnectionFactory:CreateConnectionPool about to initiate NewTGConnectionPool() for URL: '%+v'",  channelUrl.String()))
 	}
-	return NewTGConnectionPool(channelUrl, poolSize, props, connType), nil
+	return NewTGConnectionPoolWithCredentials(channelUrl, poolSize, props, connType, credentials), nil
 }
 
 
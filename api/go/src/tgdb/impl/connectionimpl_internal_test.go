@@ -0,0 +1,86 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: connectionimpl_internal_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"context"
+	"testing"
+	"tgdb"
+	"time"
+)
+
+type acquireResult struct {
+	conn tgdb.TGConnection
+	err  tgdb.TGError
+}
+
+func TestAcquireKeepsConnectionHandedOverWhileCancelling(t *testing.T) {
+	pool := NewTGConnectionPool(NewLinkUrl("tcp://127.0.0.1:8222"), 1, NewSortedProperties(), tgdb.TypeConventional)
+	// Take the connection the pool starts with, so that the next caller has to wait
+	conn, _, err := pool.acquire(context.Background(), nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	pool.adminLock.Lock()
+	delete(pool.consumers, conn.GetConnectionId())
+	pool.adminLock.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results := make(chan acquireResult, 1)
+	go func() {
+		got, _, err := pool.acquire(ctx, nil)
+		results <- acquireResult{conn: got, err: err}
+	}()
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Stats().Waiting != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for acquire to wait in line")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Hand the connection over while the waiter is giving up, so that it finds itself gone from the line
+	pool.adminLock.Lock()
+	cancel()
+	time.Sleep(50 * time.Millisecond)
+	pool.handOff(conn)
+	pool.adminLock.Unlock()
+
+	select {
+	case result := <-results:
+		if result.err != nil {
+			t.Fatalf("Expected the handed over connection, got '%s'", result.err.Error())
+		}
+		if result.conn != conn {
+			t.Fatal("Expected acquire to return the handed over connection")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for acquire to return")
+	}
+	pool.adminLock.RLock()
+	defer pool.adminLock.RUnlock()
+	if _, ok := pool.consumers[conn.GetConnectionId()]; !ok {
+		t.Fatal("Expected the connection to be counted as in use")
+	}
+	if pool.waiters.Len() != 0 || len(pool.idleConns) != 0 {
+		t.Fatal("Expected the connection to be neither idle nor waited for")
+	}
+}
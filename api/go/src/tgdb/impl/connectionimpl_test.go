@@ -0,0 +1,570 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: connectionimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/mockserver"
+	"time"
+)
+
+// connectToMock starts a mock server and returns a client connected to it as scott
+func connectToMock(t *testing.T) (*mockserver.Server, tgdb.TGConnection) {
+	return connectToMockWithProperties(t, nil)
+}
+
+// connectToMockWithProperties is connectToMock for a client created with the connection properties in env
+func connectToMockWithProperties(t *testing.T, env map[string]string) (*mockserver.Server, tgdb.TGConnection) {
+	server := mockserver.DefaultServer()
+	server.SetCredentials("scott", "scott")
+	if err := server.Start(); err != nil {
+		t.Fatalf("Unable to start the mock server: %s", err.Error())
+	}
+	conn, err := impl.NewTGConnectionFactory().CreateConnection(server.GetUrl(), "scott", "scott", env)
+	if err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	if err := conn.Connect(); err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	return server, conn
+}
+
+// addPeople adds a person node type and one node per name, and returns the ids of the nodes
+func addPeople(t *testing.T, store *mockserver.Store, names ...string) []int64 {
+	store.AddAttributeDescriptor("name", impl.AttributeTypeString, false)
+	if _, err := store.AddNodeType("person", "name"); err != nil {
+		t.Fatal(err.Error())
+	}
+	ids := make([]int64, 0)
+	for _, name := range names {
+		node, err := store.AddNode("person", map[string]interface{}{"name": name})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		ids = append(ids, node.GetVirtualId())
+	}
+	return ids
+}
+
+func TestConnectionExecutesQuery(t *testing.T) {
+	server, conn := connectToMock(t)
+	defer server.Close()
+	defer conn.Disconnect()
+
+	ids := addPeople(t, server.GetStore(), "a", "b")
+	server.GetStore().SetQueryResult("people", ids...)
+
+	resultSet, err := conn.ExecuteQuery("tgql://people", impl.NewQueryOption())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resultSet == nil {
+		t.Fatal("Expected a result set")
+	}
+	if count := len(resultSet.ToCollection()); count != 2 {
+		t.Fatalf("Expected 2 nodes, got %d", count)
+	}
+	if count := server.GetRequestCount(impl.VerbQueryRequest); count != 1 {
+		t.Fatalf("Expected 1 query request, got %d", count)
+	}
+}
+
+func TestConnectionExecutesGremlinQuery(t *testing.T) {
+	server, conn := connectToMock(t)
+	defer server.Close()
+	defer conn.Disconnect()
+
+	ids := addPeople(t, server.GetStore(), "a", "b", "c")
+	server.GetStore().SetQueryResult("g.V()", ids...)
+
+	resultSet, err := conn.ExecuteQuery("gremlin://g.V()", impl.NewQueryOption())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if resultSet == nil {
+		t.Fatal("Expected a result set")
+	}
+	results := resultSet.ToCollection()
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 nodes, got %d", len(results))
+	}
+	for _, result := range results {
+		if _, ok := result.(tgdb.TGNode); !ok {
+			t.Fatalf("Expected a node, got '%T'", result)
+		}
+	}
+
+	collection, err := conn.ExecuteGremlinQuery("g.V()", make([]interface{}, 0), impl.NewQueryOption())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(collection) != 3 {
+		t.Fatalf("Expected 3 nodes from ExecuteGremlinQuery, got %d", len(collection))
+	}
+}
+
+// startMockPool starts a mock server and returns a connected pool of poolSize connections to it, created with the
+// connection properties in env
+func startMockPool(t *testing.T, poolSize int, env map[string]string) (*mockserver.Server, tgdb.TGConnectionPool) {
+	server := mockserver.DefaultServer()
+	server.SetCredentials("scott", "scott")
+	if err := server.Start(); err != nil {
+		t.Fatalf("Unable to start the mock server: %s", err.Error())
+	}
+	pool, err := impl.NewTGConnectionFactory().CreateConnectionPool(server.GetUrl(), "scott", "scott", poolSize, env)
+	if err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	if err := pool.Connect(); err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	return server, pool
+}
+
+// waitFor polls condition until it holds, and fails the test once timeout elapses
+func waitFor(t *testing.T, timeout time.Duration, what string, condition func() bool) {
+	deadline := time.Now().Add(timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestPoolHandsOutConnectionsInArrivalOrder(t *testing.T) {
+	server, pool := startMockPool(t, 1, nil)
+	defer server.Close()
+	defer pool.Disconnect()
+
+	held, err := pool.Get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	order := make(chan int, 3)
+	for i := 1; i <= 3; i++ {
+		go func(caller int) {
+			conn, err := pool.Get()
+			if err != nil {
+				t.Error(err.Error())
+				order <- -caller
+				return
+			}
+			order <- caller
+			_, _ = pool.ReleaseConnection(conn)
+		}(i)
+		// Queue the callers one after the other
+		waitFor(t, 5*time.Second, "the caller to wait", func() bool { return pool.Stats().Waiting == i })
+	}
+	_, _ = pool.ReleaseConnection(held)
+	for want := 1; want <= 3; want++ {
+		select {
+		case got := <-order:
+			if got != want {
+				t.Fatalf("Expected caller %d to get the connection next, got caller %d", want, got)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for caller %d", want)
+		}
+	}
+}
+
+func TestPoolGetTimesOutAfterReserveTimeout(t *testing.T) {
+	server, pool := startMockPool(t, 1, map[string]string{"tgdb.connectionpool.connectionReserveTimeoutSeconds": "1"})
+	defer server.Close()
+	defer pool.Disconnect()
+
+	held, err := pool.Get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer pool.ReleaseConnection(held)
+	started := time.Now()
+	_, err = pool.Get()
+	if err == nil {
+		t.Fatal("Expected Get to time out")
+	}
+	if err.GetErrorType() != impl.TGErrorConnectionTimeout {
+		t.Fatalf("Expected a connection timeout, got '%s'", err.Error())
+	}
+	if elapsed := time.Since(started); elapsed < time.Second || elapsed > 5*time.Second {
+		t.Fatalf("Expected Get to give up after the 1s reserve timeout, waited %v", elapsed)
+	}
+}
+
+func TestPoolReplacesConnectionsPastMaxLifetime(t *testing.T) {
+	server, pool := startMockPool(t, 1, map[string]string{"tgdb.connectionpool.maxLifetimeSeconds": "1"})
+	defer server.Close()
+	defer pool.Disconnect()
+
+	first, err := pool.Get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	time.Sleep(1100 * time.Millisecond)
+	// The expired connection is evicted when it comes back, and a new one takes its place
+	_, _ = pool.ReleaseConnection(first)
+	second, err := pool.Get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer pool.ReleaseConnection(second)
+	if second == first {
+		t.Fatal("Expected the expired connection to be replaced")
+	}
+	members := pool.(*impl.ConnectionPoolImpl).GetConnectionList()
+	if len(members) != 1 || members[0] != second {
+		t.Fatalf("Expected the pool to hold just the replacement, got %d connections", len(members))
+	}
+	if _, err := second.GetGraphMetadata(true); err != nil {
+		t.Fatalf("Expected the replacement to be connected: %s", err.Error())
+	}
+}
+
+func TestPoolConnectFailureLeavesPoolReconnectable(t *testing.T) {
+	server := mockserver.DefaultServer()
+	server.SetCredentials("scott", "tiger")
+	if err := server.Start(); err != nil {
+		t.Fatal(err.Error())
+	}
+	defer server.Close()
+	env := map[string]string{"tgdb.channel.ftRetryCount": "1", "tgdb.channel.ftRetryIntervalSeconds": "0"}
+	pool, err := impl.NewTGConnectionFactory().CreateConnectionPool(server.GetUrl(), "scott", "scott", 1, env)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := pool.Connect(); err == nil {
+		t.Fatal("Expected Connect to fail with the wrong password")
+	}
+	if state := pool.(*impl.ConnectionPoolImpl).GetPoolState(); state != impl.ConnectionPoolInitialized {
+		t.Fatalf("Expected the pool to be back in the initialized state, got %d", state)
+	}
+
+	server.SetCredentials("scott", "scott")
+	if err := pool.Connect(); err != nil {
+		t.Fatalf("Expected Connect to succeed once the password is accepted: %s", err.Error())
+	}
+	defer pool.Disconnect()
+	if state := pool.(*impl.ConnectionPoolImpl).GetPoolState(); state != impl.ConnectionPoolConnected {
+		t.Fatalf("Expected the pool to be connected, got state %d", state)
+	}
+}
+
+func TestPoolHealthCheckEvictsConnectionsTheServerDoesNotAnswer(t *testing.T) {
+	server, pool := startMockPool(t, 1, map[string]string{"tgdb.connectionpool.healthCheckIntervalSeconds": "1"})
+	defer server.Close()
+	defer pool.Disconnect()
+	poolImpl := pool.(*impl.ConnectionPoolImpl)
+	first := poolImpl.GetConnectionList()[0]
+
+	// A server that answers keeps the connection in the pool
+	waitFor(t, 5*time.Second, "a health check", func() bool {
+		return server.GetRequestCount(impl.VerbMetadataRequest) > 0
+	})
+	if members := poolImpl.GetConnectionList(); len(members) != 1 || members[0] != first {
+		t.Fatal("Expected the answered health check to keep the connection")
+	}
+
+	// A server that hangs gets the connection replaced
+	server.InjectFault(impl.VerbMetadataRequest, mockserver.Fault{Kind: mockserver.FaultDelay, Delay: 3 * time.Second, Count: 1})
+	waitFor(t, 5*time.Second, "the unanswered connection to be replaced", func() bool {
+		members := poolImpl.GetConnectionList()
+		return len(members) == 1 && members[0] != first
+	})
+}
+
+// poolEventRecorder keeps the pool listener callbacks in the order they were made
+type poolEventRecorder struct {
+	lock   sync.Mutex
+	events []string
+	waits  []time.Duration
+}
+
+func (obj *poolEventRecorder) record(event string, conn tgdb.TGConnection) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.events = append(obj.events, fmt.Sprintf("%s %d", event, conn.GetConnectionId()))
+}
+
+func (obj *poolEventRecorder) OnAcquire(conn tgdb.TGConnection, wait time.Duration) {
+	obj.record("acquire", conn)
+	obj.lock.Lock()
+	obj.waits = append(obj.waits, wait)
+	obj.lock.Unlock()
+}
+
+func (obj *poolEventRecorder) OnRelease(conn tgdb.TGConnection) {
+	obj.record("release", conn)
+}
+
+func (obj *poolEventRecorder) OnEvict(conn tgdb.TGConnection, reason string) {
+	obj.record("evict", conn)
+}
+
+func (obj *poolEventRecorder) OnReconnect(conn tgdb.TGConnection) {
+	obj.record("reconnect", conn)
+}
+
+func (obj *poolEventRecorder) getEvents() ([]string, []time.Duration) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return append([]string(nil), obj.events...), append([]time.Duration(nil), obj.waits...)
+}
+
+func expectEvents(t *testing.T, recorder *poolEventRecorder, expected ...string) {
+	events, _ := recorder.getEvents()
+	if fmt.Sprint(events) != fmt.Sprint(expected) {
+		t.Fatalf("Expected the pool events %v, got %v", expected, events)
+	}
+}
+
+func TestPoolStatsCountAcquisitionsWaitsAndTimeouts(t *testing.T) {
+	server, pool := startMockPool(t, 1, map[string]string{"tgdb.connectionpool.connectionReserveTimeoutSeconds": "1"})
+	defer server.Close()
+	defer pool.Disconnect()
+	recorder := &poolEventRecorder{}
+	pool.AddPoolListener(recorder)
+
+	conn, err := pool.Get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	id := conn.GetConnectionId()
+	stats := pool.Stats()
+	if stats.PoolSize != 1 || stats.InUse != 1 || stats.Idle != 0 || stats.Acquisitions != 1 || stats.Waits != 0 {
+		t.Fatalf("Unexpected stats after the first Get: %+v", stats)
+	}
+
+	// A second caller waits for the connection to be released
+	acquired := make(chan tgdb.TGConnection, 1)
+	go func() {
+		next, err := pool.Get()
+		if err != nil {
+			t.Error(err.Error())
+		}
+		acquired <- next
+	}()
+	waitFor(t, 5*time.Second, "the caller to wait", func() bool { return pool.Stats().Waiting == 1 })
+	time.Sleep(20 * time.Millisecond)
+	_, _ = pool.ReleaseConnection(conn)
+	conn = <-acquired
+	if conn == nil {
+		t.FailNow()
+	}
+	stats = pool.Stats()
+	if stats.InUse != 1 || stats.Waiting != 0 || stats.Acquisitions != 2 || stats.Waits != 1 || stats.WaitTime < 20*time.Millisecond {
+		t.Fatalf("Unexpected stats after the waiting Get: %+v", stats)
+	}
+
+	// A third caller gives up
+	if _, err := pool.Get(); err == nil {
+		t.Fatal("Expected Get to time out")
+	}
+	stats = pool.Stats()
+	if stats.Timeouts != 1 || stats.Acquisitions != 2 {
+		t.Fatalf("Unexpected stats after the timed out Get: %+v", stats)
+	}
+
+	_, _ = pool.ReleaseConnection(conn)
+	stats = pool.Stats()
+	if stats.InUse != 0 || stats.Idle != 1 {
+		t.Fatalf("Unexpected stats after the release: %+v", stats)
+	}
+	expectEvents(t, recorder,
+		fmt.Sprintf("acquire %d", id), fmt.Sprintf("release %d", id), fmt.Sprintf("acquire %d", id), fmt.Sprintf("release %d", id))
+	if _, waits := recorder.getEvents(); waits[0] != 0 || waits[1] < 20*time.Millisecond {
+		t.Fatalf("Expected OnAcquire to report no wait and then the wait of the second caller, got %v", waits)
+	}
+}
+
+func TestPoolListenerIsToldOfReconnectsAndEvictions(t *testing.T) {
+	server, pool := startMockPool(t, 1, map[string]string{
+		"tgdb.channel.ftRetryCount":              "1",
+		"tgdb.channel.ftRetryIntervalSeconds":    "0",
+		"tgdb.connectionpool.maxLifetimeSeconds": "1",
+	})
+	defer server.Close()
+	defer pool.Disconnect()
+	recorder := &poolEventRecorder{}
+	pool.AddPoolListener(recorder)
+	ids := addPeople(t, server.GetStore(), "a")
+	server.GetStore().SetQueryResult("people", ids...)
+
+	conn, err := pool.Get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	id := conn.GetConnectionId()
+	// The query loses the connection once, and gets through after the channel reconnects
+	server.InjectFault(impl.VerbQueryRequest, mockserver.Fault{Kind: mockserver.FaultDropConnection, Count: 1})
+	if _, err := conn.ExecuteQuery("tgql://people", impl.NewQueryOption()); err != nil {
+		t.Fatal(err.Error())
+	}
+	if stats := pool.Stats(); stats.Reconnects != 1 {
+		t.Fatalf("Expected 1 reconnect, got %+v", stats)
+	}
+
+	// Released past its max lifetime, the connection is evicted
+	time.Sleep(1100 * time.Millisecond)
+	_, _ = pool.ReleaseConnection(conn)
+	if stats := pool.Stats(); stats.Evictions != 1 {
+		t.Fatalf("Expected 1 eviction, got %+v", stats)
+	}
+	expectEvents(t, recorder, fmt.Sprintf("acquire %d", id), fmt.Sprintf("reconnect %d", id),
+		fmt.Sprintf("release %d", id), fmt.Sprintf("evict %d", id))
+
+	pool.RemovePoolListener(recorder)
+	next, err := pool.Get()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	_, _ = pool.ReleaseConnection(next)
+	if events, _ := recorder.getEvents(); len(events) != 4 {
+		t.Fatalf("Expected no events once the listener is removed, got %v", events)
+	}
+}
+
+// startBulkServer starts a mock server with a person node type and a knows edge type, holding the given
+// bulk records, and returns an admin connection to it
+func startBulkServer(t *testing.T, bulkTypes ...mockserver.BulkType) (*mockserver.Server, tgdb.TGAdminConnection) {
+	server := mockserver.DefaultServer()
+	store := server.GetStore()
+	addPeople(t, store)
+	if _, err := store.AddEdgeType("knows", tgdb.DirectionTypeDirected, "person", "person"); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, bulkType := range bulkTypes {
+		store.SetBulkType(bulkType)
+	}
+	if err := server.Start(); err != nil {
+		t.Fatalf("Unable to start the mock server: %s", err.Error())
+	}
+	conn, err := impl.NewTGConnectionFactory().CreateAdminConnection(server.GetUrl(), "scott", "scott", nil)
+	if err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	if err := conn.Connect(); err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	return server, conn.(tgdb.TGAdminConnection)
+}
+
+// bulkPeople returns count person records of about 150 bytes each, the last one quoting a comma and a new line
+func bulkPeople(count int) mockserver.BulkType {
+	people := mockserver.BulkType{Name: "person", IsNode: true, Attributes: []string{"id", "name", "note"}}
+	for i := 0; i < count-1; i++ {
+		people.Records = append(people.Records, fmt.Sprintf("%d,person %d,%s", i, i, strings.Repeat("x", 130)))
+	}
+	people.Records = append(people.Records, fmt.Sprintf("%d,\"Smith, \"\"Jr\"\"\nsecond line\",y", count-1))
+	return people
+}
+
+func TestExportImportRoundTripInBatches(t *testing.T) {
+	knows := mockserver.BulkType{Name: "knows", Attributes: []string{"from", "to"}, Records: []string{"1,2", "2,3", "3,1"}}
+	source, sourceConn := startBulkServer(t, bulkPeople(300), mockserver.BulkType{Name: "empty", IsNode: true}, knows)
+	defer source.Close()
+	defer sourceConn.Disconnect()
+
+	var stream bytes.Buffer
+	exported := make([]tgdb.TGBulkProgress, 0)
+	err := sourceConn.ExportDatabase(&stream, &tgdb.TGExportOptions{
+		BatchSize: 64,
+		Progress:  func(progress tgdb.TGBulkProgress) { exported = append(exported, progress) },
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// 300 people in batches of 64 and the knows edges in one, the empty type is never asked for
+	if count := source.GetRequestCount(impl.VerbPartialExportRequest); count != 6 {
+		t.Fatalf("Expected 6 partial export requests, got %d", count)
+	}
+	last := exported[len(exported)-1]
+	if last.Entities != 303 || last.TotalEntities != 303 {
+		t.Fatalf("Expected 303 of 303 entities to be exported, got %+v", last)
+	}
+
+	target, targetConn := startBulkServer(t)
+	defer target.Close()
+	defer targetConn.Disconnect()
+	imported := 0
+	results, err := targetConn.ImportDatabase(&stream, &tgdb.TGImportOptions{
+		Progress: func(progress tgdb.TGBulkProgress) { imported = progress.Batches },
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	// The people are over 32000 bytes, so they take more than one import request
+	if count := target.GetRequestCount(impl.VerbPartialImportRequest); count < 3 || count != imported {
+		t.Fatalf("Expected at least 3 partial import requests, one per reported batch, got %d and %d batches", count, imported)
+	}
+	counts := make(map[string]int64, 0)
+	for _, result := range results {
+		counts[result.GetTypeName()] = result.GetNumInstances()
+	}
+	if len(counts) != 2 || counts["person"] != 300 || counts["knows"] != 3 {
+		t.Fatalf("Expected 300 people and 3 knows edges to be imported, got %+v", counts)
+	}
+
+	sourceTypes := source.GetStore().GetBulkTypes()
+	expected := []mockserver.BulkType{sourceTypes[0], sourceTypes[2]}
+	if got := target.GetStore().GetBulkTypes(); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Expected the imported records to equal the exported ones, got %d types", len(got))
+	}
+}
+
+func TestExportCancelledByContextCancelsOnServer(t *testing.T) {
+	server, conn := startBulkServer(t, bulkPeople(300))
+	defer server.Close()
+	defer conn.Disconnect()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := conn.ExportDatabase(ioutil.Discard, &tgdb.TGExportOptions{
+		Context:   ctx,
+		BatchSize: 64,
+		Progress:  func(progress tgdb.TGBulkProgress) { cancel() },
+	})
+	if err == nil || err.GetErrorType() != impl.TGErrorOperationCancelled {
+		t.Fatalf("Expected the export to be cancelled, got %v", err)
+	}
+	waitFor(t, 5*time.Second, "the cancel export request", func() bool {
+		return server.GetRequestCount(impl.VerbCancelExportRequest) == 1
+	})
+	if count := server.GetRequestCount(impl.VerbPartialExportRequest); count >= 5 {
+		t.Fatalf("Expected the export to stop before its last batch, got %d partial export requests", count)
+	}
+}
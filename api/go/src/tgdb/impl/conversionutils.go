@@ -26,15 +26,19 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"reflect"
 	"tgdb"
 	"strconv"
 	"time"
 )
 
-func BigDecimalToByteArray(bd float64) ([]byte, error) {
-	strVal := NewTGDecimalFromFloat(bd).String()
-	buf := []byte(strVal)
-	return buf, nil
+// BigDecimalToByteArray serializes the decimal as its scale followed by the big-endian two's complement
+// unscaled value, the same layout ByteArrayToBigDecimal reads back
+func BigDecimalToByteArray(bd TGDecimal) ([]byte, error) {
+	oStream := DefaultProtocolDataOutputStream()
+	oStream.WriteInt(int(-bd.exp))
+	_ = oStream.WriteBytes(bigIntToTwosComplement(bd.value))
+	return oStream.ToByteArray()
 }
 
 func ByteArrayToBigDecimal(buf []byte) (*TGDecimal, error) {
@@ -55,11 +59,44 @@ func ByteArrayToBigDecimal(buf []byte) (*TGDecimal, error) {
 		errMsg := fmt.Sprint("Unable to iStream.ReadFully(buf)")
 		return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, "")
 	}
-	bd := big.NewInt(1).SetBytes(buf1)
-	dec := NewTGDecimalFromBigInt(bd, int32(scale))
+	bd := twosComplementToBigInt(buf1)
+	dec := NewTGDecimalFromBigInt(bd, int32(-scale))
 	return &dec, nil
 }
 
+// bigIntToTwosComplement returns the minimal big-endian two's complement form of v
+func bigIntToTwosComplement(v *big.Int) []byte {
+	if v == nil || v.Sign() == 0 {
+		return []byte{0}
+	}
+	if v.Sign() > 0 {
+		buf := v.Bytes()
+		if buf[0]&0x80 != 0 {
+			buf = append([]byte{0}, buf...)
+		}
+		return buf
+	}
+	// -v = ^(v-1), so the two's complement of v is the bitwise inverse of |v|-1
+	m := new(big.Int).Sub(new(big.Int).Neg(v), big.NewInt(1))
+	buf := m.Bytes()
+	if len(buf) == 0 || buf[0]&0x80 != 0 {
+		buf = append([]byte{0}, buf...)
+	}
+	for i := range buf {
+		buf[i] = ^buf[i]
+	}
+	return buf
+}
+
+// twosComplementToBigInt is the inverse of bigIntToTwosComplement
+func twosComplementToBigInt(buf []byte) *big.Int {
+	v := new(big.Int).SetBytes(buf)
+	if len(buf) > 0 && buf[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(big.NewInt(1), uint(len(buf)*8)))
+	}
+	return v
+}
+
 func CalendarToString(t time.Time) (string, error) {
 	env := NewTGEnvironment()
 	return t.Format(env.GetDefaultDateTimeFormat()), nil
@@ -97,7 +134,7 @@ func InputStreamToByteArray(is tgdb.TGInputStream) ([]byte, error) {
 			return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, "")
 		}
 	}
-	return oStream.GetBuffer(), nil
+	return oStream.ToByteArray()
 }
 
 func LongToCalendar(l int64) time.Time {
@@ -151,7 +188,12 @@ func ObjectFromByteArray(value []byte, attrType int) (interface{}, tgdb.TGError)
 	case AttributeTypeByte:
 		return iStream.ReadByte()
 	case AttributeTypeChar:
-		return iStream.ReadChar()
+		c, err := iStream.ReadChar()
+		if err != nil {
+			return nil, err
+		}
+		// CharAttribute holds its value as a rune
+		return []rune(c)[0], nil
 	case AttributeTypeShort:
 		return iStream.ReadShort()
 	case AttributeTypeInteger:
@@ -179,7 +221,7 @@ func ObjectFromByteArray(value []byte, attrType int) (interface{}, tgdb.TGError)
 			errMsg := fmt.Sprint("Unable to ByteArrayToBigDecimal(buf1)")
 			return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, "")
 		}
-		return bd, nil
+		return *bd, nil
 	case AttributeTypeString:
 		return iStream.ReadUTF()
 	case AttributeTypeDate:
@@ -229,26 +271,50 @@ func ObjectToByteArray(value interface{}, attrType int) ([]byte, tgdb.TGError) {
 	case AttributeTypeBoolean:
 		oStream.WriteBoolean(value.(bool))
 	case AttributeTypeByte:
-		oStream.WriteByte(value.(int))
+		oStream.WriteByte(int(reflect.ValueOf(value).Uint()))
 	case AttributeTypeChar:
-		oStream.WriteChar(value.(int))
+		// Values read off the wire are single character strings, locally set ones are runes
+		if c, ok := value.(string); ok {
+			if len(c) == 0 {
+				errMsg := fmt.Sprint("Unable to convert empty object of type Char into byte array")
+				return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, "")
+			}
+			oStream.WriteChar(int([]rune(c)[0]))
+		} else {
+			oStream.WriteChar(int(reflect.ValueOf(value).Int()))
+		}
 	case AttributeTypeShort:
-		oStream.WriteShort(value.(int))
+		oStream.WriteShort(int(reflect.ValueOf(value).Int()))
 	case AttributeTypeInteger:
-		oStream.WriteInt(value.(int))
+		oStream.WriteInt(int(reflect.ValueOf(value).Int()))
 	case AttributeTypeLong:
-		oStream.WriteLong(value.(int64))
+		oStream.WriteLong(reflect.ValueOf(value).Int())
 	case AttributeTypeFloat:
-		oStream.WriteFloat(value.(float32))
+		oStream.WriteFloat(float32(reflect.ValueOf(value).Float()))
 	case AttributeTypeDouble:
-		oStream.WriteDouble(value.(float64))
+		oStream.WriteDouble(reflect.ValueOf(value).Float())
 	case AttributeTypeNumber:
-		buf, err := BigDecimalToByteArray(value.(float64))
+		// NumberAttribute keeps its value as the decimal's string form
+		var bd TGDecimal
+		switch v := value.(type) {
+		case TGDecimal:
+			bd = v
+		case string:
+			d, err := NewTGDecimalFromString(v)
+			if err != nil {
+				errMsg := fmt.Sprintf("Unable to parse '%s' as an object of type Number", v)
+				return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, err.Error())
+			}
+			bd = d
+		default:
+			errMsg := fmt.Sprintf("Unable to convert object of type '%T' into Number", value)
+			return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, "")
+		}
+		buf, err := BigDecimalToByteArray(bd)
 		if err != nil {
 			errMsg := fmt.Sprint("Unable to convert object of type Number into byte array")
 			return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, "")
 		}
-		oStream.WriteInt(len(buf))
 		_ = oStream.WriteBytes(buf)
 	case AttributeTypeString:
 		_ = oStream.WriteUTF(value.(string))
@@ -264,14 +330,12 @@ func ObjectToByteArray(value interface{}, attrType int) ([]byte, tgdb.TGError) {
 		}
 		_ = oStream.WriteUTF(strVal)
 	case AttributeTypeBlob:
-		buf := value.([]byte)
-		oStream.WriteInt(len(buf))
-		_ = oStream.WriteBytes(buf)
+		_ = oStream.WriteBytes(value.([]byte))
 	case AttributeTypeClob:
 		_ = oStream.WriteUTF(value.(string))
 	default:
 		errMsg := fmt.Sprint("Unable to convert object into byte array")
 		return nil, GetErrorByType(TGErrorTypeCoercionNotSupported, TGDB_CLIENT_READEXTERNAL, errMsg, "")
 	}
-	return oStream.GetBuffer(), nil
+	return oStream.ToByteArray()
 }
@@ -0,0 +1,198 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: credentialimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"tgdb"
+	"time"
+)
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for StaticCredentialProvider
+/////////////////////////////////////////////////////////////////
+
+// StaticCredentialProvider hands out a fixed user name and password, as passed to the connection factory
+type StaticCredentialProvider struct {
+	user     string
+	password []byte
+}
+
+// NewStaticCredentialProvider returns a provider of a fixed user name and password. An empty user name keeps the one
+// configured for the channel
+func NewStaticCredentialProvider(user string, password []byte) *StaticCredentialProvider {
+	pwd := make([]byte, len(password))
+	copy(pwd, password)
+	return &StaticCredentialProvider{user: user, password: pwd}
+}
+
+// GetCredentials returns the user name and password
+func (obj *StaticCredentialProvider) GetCredentials() (string, []byte, tgdb.TGError) {
+	return obj.user, obj.password, nil
+}
+
+func (obj *StaticCredentialProvider) String() string {
+	return fmt.Sprintf("StaticCredentialProvider:{User: %s}", obj.user)
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for EnvCredentialProvider
+/////////////////////////////////////////////////////////////////
+
+// EnvCredentialProvider reads the password from an environment variable each time the channel authenticates
+type EnvCredentialProvider struct {
+	user        string
+	passwordVar string
+}
+
+// NewEnvCredentialProvider returns a provider that reads the password from the environment variable passwordVar.
+// An empty user name keeps the one configured for the channel
+func NewEnvCredentialProvider(user, passwordVar string) *EnvCredentialProvider {
+	return &EnvCredentialProvider{user: user, passwordVar: passwordVar}
+}
+
+// GetCredentials returns the user name and the current value of the environment variable
+func (obj *EnvCredentialProvider) GetCredentials() (string, []byte, tgdb.TGError) {
+	pwd, ok := os.LookupEnv(obj.passwordVar)
+	if !ok {
+		errMsg := fmt.Sprintf("Password environment variable '%s' is not set", obj.passwordVar)
+		return "", nil, GetErrorByType(TGErrorBadAuthentication, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	return obj.user, []byte(pwd), nil
+}
+
+func (obj *EnvCredentialProvider) String() string {
+	return fmt.Sprintf("EnvCredentialProvider:{User: %s, PasswordVar: %s}", obj.user, obj.passwordVar)
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for FileCredentialProvider
+/////////////////////////////////////////////////////////////////
+
+// FileCredentialProvider reads the password from a file, such as a mounted secret. The file is read again whenever
+// its size or modification time changes, so the password can be rotated while the connection stays open
+type FileCredentialProvider struct {
+	user     string
+	path     string
+	lock     sync.Mutex
+	modTime  time.Time
+	size     int64
+	password []byte
+}
+
+// NewFileCredentialProvider returns a provider that reads the password from the file at path, without its trailing
+// line break. An empty user name keeps the one configured for the channel
+func NewFileCredentialProvider(user, path string) *FileCredentialProvider {
+	return &FileCredentialProvider{user: user, path: path}
+}
+
+// GetCredentials returns the user name and the password currently in the file
+func (obj *FileCredentialProvider) GetCredentials() (string, []byte, tgdb.TGError) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	// Stat follows symbolic links, so secrets rotated by swapping a link are noticed too
+	info, err := os.Stat(obj.path)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to access password file '%s'", obj.path)
+		return "", nil, GetErrorByType(TGErrorBadAuthentication, INTERNAL_SERVER_ERROR, errMsg, err.Error())
+	}
+	if obj.password != nil && info.ModTime().Equal(obj.modTime) && info.Size() == obj.size {
+		return obj.user, obj.password, nil
+	}
+	buf, err := ioutil.ReadFile(obj.path)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to read password file '%s'", obj.path)
+		return "", nil, GetErrorByType(TGErrorBadAuthentication, INTERNAL_SERVER_ERROR, errMsg, err.Error())
+	}
+	if obj.password != nil && logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside FileCredentialProvider:GetCredentials - password file '%s' has changed, using the new password", obj.path))
+	}
+	obj.password = bytes.TrimRight(buf, "\r\n")
+	obj.modTime = info.ModTime()
+	obj.size = info.Size()
+	return obj.user, obj.password, nil
+}
+
+func (obj *FileCredentialProvider) String() string {
+	return fmt.Sprintf("FileCredentialProvider:{User: %s, Path: %s}", obj.user, obj.path)
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for CallbackCredentialProvider
+/////////////////////////////////////////////////////////////////
+
+// CallbackCredentialProvider asks the application for the credentials each time the channel authenticates, e.g. to
+// fetch them from a vault
+type CallbackCredentialProvider struct {
+	callback func() (string, []byte, error)
+}
+
+// NewCallbackCredentialProvider returns a provider that calls back for the user name and password. The callback may
+// return an empty user name to keep the one configured for the channel
+func NewCallbackCredentialProvider(callback func() (string, []byte, error)) *CallbackCredentialProvider {
+	return &CallbackCredentialProvider{callback: callback}
+}
+
+// GetCredentials returns the user name and password the callback returns
+func (obj *CallbackCredentialProvider) GetCredentials() (string, []byte, tgdb.TGError) {
+	user, pwd, err := obj.callback()
+	if err != nil {
+		errMsg := "Credential callback failed"
+		return "", nil, GetErrorByType(TGErrorBadAuthentication, INTERNAL_SERVER_ERROR, errMsg, err.Error())
+	}
+	return user, pwd, nil
+}
+
+func (obj *CallbackCredentialProvider) String() string {
+	return "CallbackCredentialProvider:{}"
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for credential providers
+/////////////////////////////////////////////////////////////////
+
+// takeCredentialProvider removes every password setting from props and returns the provider they configure.
+// An explicit password wins over one set with SetPassword, then a password property, and then the password
+// environment variable and file
+func takeCredentialProvider(props *SortedProperties, pwd string) tgdb.TGCredentialProvider {
+	setCredentials := props.credentials
+	props.credentials = nil
+	propPwd := props.RemoveProperty(GetConfigFromKey(ChannelPassword))
+	pwdEnv := props.RemoveProperty(GetConfigFromKey(ChannelPasswordEnv))
+	pwdFile := props.RemoveProperty(GetConfigFromKey(ChannelPasswordFile))
+	switch {
+	case pwd != "":
+		return NewStaticCredentialProvider("", []byte(pwd))
+	case setCredentials != nil:
+		return setCredentials
+	case propPwd != "":
+		return NewStaticCredentialProvider("", []byte(propPwd))
+	case pwdEnv != "":
+		return NewEnvCredentialProvider("", pwdEnv)
+	case pwdFile != "":
+		return NewFileCredentialProvider("", pwdFile)
+	}
+	return NewStaticCredentialProvider("", nil)
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: credentialimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"tgdb"
+	"time"
+)
+
+func expectPassword(t *testing.T, credentials tgdb.TGCredentialProvider, expected string) {
+	t.Helper()
+	_, pwd, err := credentials.GetCredentials()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if string(pwd) != expected {
+		t.Fatalf("Expected password '%s', got '%s'", expected, pwd)
+	}
+}
+
+func writePasswordFile(t *testing.T, path, pwd string, modTime time.Time) {
+	t.Helper()
+	if err := ioutil.WriteFile(path, []byte(pwd+"\n"), 0600); err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestFileCredentialProviderRereadsChangedFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tgdb-credentials")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "password")
+	modTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	writePasswordFile(t, path, "first", modTime)
+	credentials := NewFileCredentialProvider("scott", path)
+	expectPassword(t, credentials, "first")
+
+	// Neither the size nor the modification time changed, so the cached password is kept
+	writePasswordFile(t, path, "other", modTime)
+	expectPassword(t, credentials, "first")
+
+	// A new modification time alone is enough to read the file again
+	writePasswordFile(t, path, "other", modTime.Add(time.Minute))
+	expectPassword(t, credentials, "other")
+
+	// So is a new size, even when the modification time is unchanged
+	writePasswordFile(t, path, "rotated", modTime.Add(time.Minute))
+	expectPassword(t, credentials, "rotated")
+
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, _, err := credentials.GetCredentials(); err == nil {
+		t.Fatal("Expected an error once the password file is gone")
+	}
+}
+
+func TestTakeCredentialProviderPrecedence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tgdb-credentials")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "password")
+	writePasswordFile(t, path, "from-file", time.Now())
+	const envVar = "TGDB_TEST_CHANNEL_PASSWORD"
+	os.Setenv(envVar, "from-env")
+	defer os.Unsetenv(envVar)
+
+	tests := []struct {
+		name     string
+		pwd      string
+		setPwd   string
+		propPwd  string
+		pwdEnv   string
+		pwdFile  string
+		expected string
+	}{
+		{"explicit password", "explicit", "set", "prop", envVar, path, "explicit"},
+		{"SetPassword", "", "set", "prop", envVar, path, "set"},
+		{"password property", "", "", "prop", envVar, path, "prop"},
+		{"password environment variable", "", "", "", envVar, path, "from-env"},
+		{"password file", "", "", "", "", path, "from-file"},
+		{"no password", "", "", "", "", "", ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			props := NewSortedProperties()
+			if test.propPwd != "" {
+				props.AddProperty(GetConfigFromKey(ChannelPassword).GetName(), test.propPwd)
+			}
+			if test.pwdEnv != "" {
+				props.AddProperty(GetConfigFromKey(ChannelPasswordEnv).GetAlias(), test.pwdEnv)
+			}
+			if test.pwdFile != "" {
+				props.AddProperty(GetConfigFromKey(ChannelPasswordFile).GetName(), test.pwdFile)
+			}
+			_ = props.SetPassword(test.setPwd)
+
+			expectPassword(t, takeCredentialProvider(props, test.pwd), test.expected)
+			// Every password setting is taken out of the properties
+			for _, key := range []int{ChannelPassword, ChannelPasswordEnv, ChannelPasswordFile} {
+				if DoesPropertyExist(props, GetConfigFromKey(key).GetName()) || DoesPropertyExist(props, GetConfigFromKey(key).GetAlias()) {
+					t.Fatalf("Expected '%s' to be removed from the properties", GetConfigFromKey(key).GetName())
+				}
+			}
+			if props.credentials != nil {
+				t.Fatal("Expected the provider set by SetPassword to be taken from the properties")
+			}
+		})
+	}
+}
+
+func TestDeprecatedPasswordSettersUseCredentialProvider(t *testing.T) {
+	props := NewSortedProperties()
+	if err := SetUserAndPassword(props, "scott", "tiger"); err != nil {
+		t.Fatal(err.Error())
+	}
+	if DoesPropertyExist(props, GetConfigFromKey(ChannelPassword).GetName()) {
+		t.Fatal("Expected SetUserAndPassword to keep the password out of the properties")
+	}
+	expectPassword(t, props.credentials, "tiger")
+
+	option := NewQueryOption()
+	if err := option.SetUserAndPassword("scott", "tiger"); err != nil {
+		t.Fatal(err.Error())
+	}
+	expectPassword(t, option.optionProperties.credentials, "tiger")
+
+	channel := DefaultAbstractChannel()
+	channel.channelProperties = props
+	channel.SetChannelPassword([]byte("lion"))
+	if pwd := string(channel.GetChannelPassword()); pwd != "lion" {
+		t.Fatalf("Expected password 'lion', got '%s'", pwd)
+	}
+	expectPassword(t, channel.GetCredentialProvider(), "lion")
+}
@@ -790,6 +790,31 @@ func EntityTypeReadExternal(obj tgdb.TGEntityType, is tgdb.TGInputStream) tgdb.T
 	return nil
 }
 
+// EntityTypeWriteExternal writes the entity type the way the server sends it, so that EntityTypeReadExternal can read it
+func EntityTypeWriteExternal(obj tgdb.TGEntityType, os tgdb.TGOutputStream) tgdb.TGError {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering EntityType:EntityTypeWriteExternal for entityType: '%+v'", obj))
+	}
+	os.(*ProtocolDataOutputStream).WriteByte(int(obj.GetSystemType()))
+	os.(*ProtocolDataOutputStream).WriteInt(obj.GetEntityTypeId())
+	err := os.(*ProtocolDataOutputStream).WriteUTF(obj.GetName())
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning EntityType:EntityTypeWriteExternal - unable to write eName w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(0) // pagesize
+	attrDescs := obj.GetAttributeDescriptors()
+	os.(*ProtocolDataOutputStream).WriteShort(len(attrDescs))
+	for _, attrDesc := range attrDescs {
+		err := os.(*ProtocolDataOutputStream).WriteUTF(attrDesc.GetName())
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning EntityType:EntityTypeWriteExternal - unable to write attrName w/ Error: '%+v'", err.Error()))
+			return err
+		}
+	}
+	return nil
+}
+
 func EntityTypeUpdateMetadata(obj tgdb.TGEntityType, gmd *GraphMetadata) tgdb.TGError {
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprint("Entering EntityType:EntityTypeUpdateMetadata"))
@@ -908,10 +933,7 @@ func (obj *EntityType) ReadExternal(is tgdb.TGInputStream) tgdb.TGError {
 
 // WriteExternal writes a system object into an appropriate byte format onto an external output stream
 func (obj *EntityType) WriteExternal(os tgdb.TGOutputStream) tgdb.TGError {
-	logger.Warning(fmt.Sprint("WARNING: Returning EntityType:WriteExternal is not implemented"))
-	//errMsg := fmt.Sprint("EntityType WriteExternal message is not implemented")
-	//return exception.GetErrorByType(types.TGErrorIOException, types.INTERNAL_SERVER_ERROR, errMsg, "")
-	return nil
+	return EntityTypeWriteExternal(obj, os)
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1206,7 +1228,30 @@ func (obj *NodeType) ReadExternal(is tgdb.TGInputStream) tgdb.TGError {
 
 // WriteExternal writes a system object into an appropriate byte format onto an external output stream
 func (obj *NodeType) WriteExternal(os tgdb.TGOutputStream) tgdb.TGError {
-	logger.Warning(fmt.Sprint("WARNING: Returning NodeType:WriteExternal is not implemented"))
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering NodeType:WriteExternal"))
+	}
+	// Base Class EntityType's WriteExternal()
+	err := EntityTypeWriteExternal(obj, os)
+	if err != nil {
+		return err
+	}
+	os.(*ProtocolDataOutputStream).WriteShort(len(obj.pKeys))
+	for _, pKey := range obj.pKeys {
+		err := os.(*ProtocolDataOutputStream).WriteUTF(pKey.GetName())
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning NodeType:WriteExternal - unable to write attrName w/ Error: '%+v'", err.Error()))
+			return err
+		}
+	}
+	os.(*ProtocolDataOutputStream).WriteShort(len(obj.idxIds))
+	for _, indexId := range obj.idxIds {
+		os.(*ProtocolDataOutputStream).WriteInt(indexId)
+	}
+	os.(*ProtocolDataOutputStream).WriteLong(obj.numEntries)
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning NodeType:WriteExternal w/ NO error, for NodeType: '%+v'", obj))
+	}
 	return nil
 }
 
@@ -2310,9 +2355,21 @@ func (obj *EdgeType) ReadExternal(is tgdb.TGInputStream) tgdb.TGError {
 
 // WriteExternal writes a system object into an appropriate byte format onto an external output stream
 func (obj *EdgeType) WriteExternal(os tgdb.TGOutputStream) tgdb.TGError {
-	logger.Warning(fmt.Sprint("WARNING: Returning EdgeType:WriteExternal is not implemented"))
-	//errMsg := fmt.Sprint("EdgeType WriteExternal message is not implemented")
-	//return exception.GetErrorByType(types.TGErrorIOException, types.INTERNAL_SERVER_ERROR, errMsg, "")
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering EdgeType:WriteExternal"))
+	}
+	// Base Class EntityType's WriteExternal()
+	err := EntityTypeWriteExternal(obj, os)
+	if err != nil {
+		return err
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(obj.GetFromTypeId())
+	os.(*ProtocolDataOutputStream).WriteInt(obj.GetToTypeId())
+	os.(*ProtocolDataOutputStream).WriteByte(int(obj.GetDirectionType()))
+	os.(*ProtocolDataOutputStream).WriteLong(obj.GetNumEntries())
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning EdgeType:WriteExternal w/ NO error, for entityType: '%+v'", obj))
+	}
 	return nil
 }
 
@@ -2554,8 +2611,59 @@ func (obj *CompositeKey) SetOrCreateAttribute(name string, value interface{}) tg
 
 // ReadExternal reads the byte format from an external input stream and constructs a system object
 func (obj *CompositeKey) ReadExternal(is tgdb.TGInputStream) tgdb.TGError {
-	errMsg := "Not Supported operation"
-	return GetErrorByType(TGErrorIOException, "TGErrorIOException", errMsg, "")
+	// Clients never read a key back - this mirrors WriteExternal for a mock server,
+	// resolving attribute ids against the key's graph metadata
+	if obj.graphMetadata == nil {
+		errMsg := "Not Supported operation"
+		return GetErrorByType(TGErrorIOException, "TGErrorIOException", errMsg, "")
+	}
+	hasKeyName, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning CompositeKey:ReadExternal - unable to read hasKeyName w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	if hasKeyName {
+		keyName, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning CompositeKey:ReadExternal - unable to read keyName w/ Error: '%+v'", err.Error()))
+			return err
+		}
+		obj.keyName = keyName
+	}
+	attrCount, err := is.(*ProtocolDataInputStream).ReadShort()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning CompositeKey:ReadExternal - unable to read attrCount w/ Error: '%+v'", err.Error()))
+		return err
+	}
+	for i := 0; i < int(attrCount); i++ {
+		attrId, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning CompositeKey:ReadExternal - unable to read attrId w/ Error: '%+v'", err.Error()))
+			return err
+		}
+		attrDesc, err := obj.graphMetadata.GetAttributeDescriptorById(int64(attrId))
+		if err != nil {
+			return err
+		}
+		if attrDesc == nil {
+			errMsg := fmt.Sprintf("Invalid attributeId:'%d' encountered while deserialized", attrId)
+			return GetErrorByType(TGErrorIOException, TGDB_CLIENT_READEXTERNAL, errMsg, "")
+		}
+		attr, err := CreateAttributeWithDesc(nil, attrDesc.(*AttributeDescriptor), nil)
+		if err != nil {
+			return err
+		}
+		err = attr.ReadExternal(is)
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning CompositeKey:ReadExternal - unable to read attr w/ Error: '%+v'", err.Error()))
+			return err
+		}
+		obj.attributes[attrDesc.GetName()] = attr
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning CompositeKey:ReadExternal w/ NO error, for key: '%+v'", obj))
+	}
+	return nil
 }
 
 // WriteExternal writes a system object into an appropriate byte format onto an external output stream
@@ -62,6 +62,7 @@ const (
     TGQryInternalDataMismatchError
     TGQryStepSignatureNotSupported
     TGQryInvalidDataType
+	TGErrorOperationCancelled
 )
 
 const (
@@ -133,6 +134,7 @@ var PreDefinedErrors = map[int]TGDBError{
 	TGErrorVersionMismatchException: {ErrorCode: "TGErrorVersionMismatchException", ErrorType: TGErrorVersionMismatchException, ErrorMsg: "", ErrorDetails: "", ErrorServerErrorCode:-1},
 	TGErrorInvalidErrorCode:         {ErrorCode: "TGErrorInvalidErrorCode", ErrorType: TGErrorInvalidErrorCode, ErrorMsg: "", ErrorDetails: "", ErrorServerErrorCode:-1},
 	TGSuccess:                       {ErrorCode: "TGSuccess", ErrorType: TGSuccess, ErrorMsg: "", ErrorDetails: "", ErrorServerErrorCode:-1},
+	TGErrorOperationCancelled:       {ErrorCode: "TGErrorOperationCancelled", ErrorType: TGErrorOperationCancelled, ErrorMsg: "", ErrorDetails: "", ErrorServerErrorCode:-1},
 }
 
 func DefaultTGDBError() *TGDBError {
@@ -213,6 +215,8 @@ func CreateExceptionByType(excpTypeId int) tgdb.TGError {
 		return DefaultTGInvalidMessageLength()
 	case TGErrorIOException:
 		return DefaultTGIOException()
+	case TGErrorOperationCancelled:
+		return DefaultTGOperationCancelled()
 	case TGErrorProtocolNotSupported:
 		return DefaultTGProtocolNotSupported()
 	case TGErrorRetryIOException:
@@ -267,6 +271,8 @@ func GetErrorByType(excpTypeId int, errorCode, errorMsg, errorDetails string) tg
 		return NewTGInvalidMessageLength(errorCode, excpTypeId, errorMsg, errorDetails)
 	case TGErrorIOException:
 		return NewTGIOException(errorCode, excpTypeId, errorMsg, errorDetails)
+	case TGErrorOperationCancelled:
+		return NewTGOperationCancelled(errorCode, excpTypeId, errorMsg, errorDetails)
 	case TGErrorProtocolNotSupported:
 		return NewTGProtocolNotSupported(errorCode, excpTypeId, errorMsg, errorDetails)
 	case TGErrorRetryIOException:
@@ -282,6 +288,16 @@ func GetErrorByType(excpTypeId int, errorCode, errorMsg, errorDetails string) tg
 	case TGErrorVersionMismatchException:
 		return NewTGVersionMismatchException(errorCode, excpTypeId, errorMsg, errorDetails)
 
+	case TGQryError, TGQryProviderNotInitialized, TGQryParsingError, TGQryStepNotSupported, TGQryStepNotAllowed,
+		TGQryStepArgMissing, TGQryStepArgNotSupported, TGQryStepMissing, TGQryNotDefined, TGQryAttrDescNotFound,
+		TGQryEdgeTypeNotFound, TGQryNodeTypeNotFound, TGQryInternalDataMismatchError, TGQryStepSignatureNotSupported,
+		TGQryInvalidDataType:
+		// Query errors raised on the client carry no server error code
+		queryError := NewQueryError(-1, excpTypeId, errorMsg)
+		queryError.ErrorCode = errorCode
+		queryError.ErrorDetails = errorDetails
+		return queryError
+
 	case TGErrorInvalidErrorCode:
 		fallthrough
 	default:
@@ -857,6 +873,64 @@ func (e *IOException) Error() string {
 }
 
 
+type OperationCancelled struct {
+	*TGDBError
+}
+
+// Create New OperationCancelled Instance
+func DefaultTGOperationCancelled() *OperationCancelled {
+	newException := OperationCancelled{
+		TGDBError: DefaultTGDBError(),
+	}
+	newException.ErrorType = TGErrorOperationCancelled
+	return &newException
+}
+
+func NewTGOperationCancelled(eCode string, eType int, eMsg, eDetails string) *OperationCancelled {
+	newException := DefaultTGOperationCancelled()
+	newException.ErrorCode = eCode
+	newException.ErrorType = eType
+	newException.ErrorMsg = eMsg
+	newException.ErrorDetails = eDetails
+	return newException
+}
+
+func NewTGOperationCancelledWithMsg(msg string) *OperationCancelled {
+	newException := DefaultTGOperationCancelled()
+	newException.ErrorMsg = msg
+	return newException
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGError
+/////////////////////////////////////////////////////////////////
+
+func (e *OperationCancelled) GetErrorCode() string {
+	return e.ErrorCode
+}
+
+func (e *OperationCancelled) GetErrorType() int {
+	return e.ErrorType
+}
+
+func (e *OperationCancelled) GetErrorMsg() string {
+	return e.ErrorMsg
+}
+
+func (e *OperationCancelled) GetErrorDetails() string {
+	return e.ErrorDetails
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> error
+/////////////////////////////////////////////////////////////////
+
+func (e *OperationCancelled) Error() string {
+	errMsg := fmt.Sprintf("ErrorCode: %s, ErrorType: %d, ErrorMessage: %s, ErrorDetails: %s", e.ErrorCode, e.ErrorType, e.ErrorMsg, e.ErrorDetails)
+	return errMsg
+}
+
+
 type ProtocolNotSupported struct {
 	*TGDBError
 }
@@ -1034,6 +1108,9 @@ func (e *SecurityException) Error() string {
 
 type TransactionException struct {
 	*TGDBError
+	status         tgdb.TGTransactionStatus
+	entityIds      []int64
+	attributeNames []string
 }
 
 // Create New TransactionException Instance
@@ -1089,11 +1166,41 @@ func (e *TransactionException) Error() string {
 	return errMsg
 }
 
-func BuildException(ts tgdb.TGTransactionStatus, msg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(msg)
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGTransactionError
+/////////////////////////////////////////////////////////////////
+
+// GetTransactionStatus returns the status the server reported for the failed transaction
+func (e *TransactionException) GetTransactionStatus() tgdb.TGTransactionStatus {
+	return e.status
+}
+
+// GetEntityIds returns the virtual ids of the candidate entities for the failure
+func (e *TransactionException) GetEntityIds() []int64 {
+	return e.entityIds
+}
+
+// GetAttributeNames returns the names of the candidate attributes for the failure
+func (e *TransactionException) GetAttributeNames() []string {
+	return e.attributeNames
+}
+
+// BuildException maps a transaction status to its own exception type
+func BuildException(ts tgdb.TGTransactionStatus, msg string) tgdb.TGTransactionError {
+	return BuildCommitException(ts, msg, nil, nil)
+}
+
+// BuildCommitException is BuildException for a rejected commit, listing the entities and attributes it most likely tripped over
+func BuildCommitException(ts tgdb.TGTransactionStatus, msg string, entityIds []int64, attributeNames []string) tgdb.TGTransactionError {
+	var newException interface {
+		tgdb.TGTransactionError
+		candidates() *TransactionException
+	}
 	switch ts {
 	case tgdb.TGTransactionAlreadyInProgress:
 		newException = NewTGTransactionAlreadyInProgressException(msg)
+	case tgdb.TGTransactionClientDisconnected:
+		newException = NewTGTransactionClientDisconnected(msg)
 	case tgdb.TGTransactionMalFormed:
 		newException = NewTGTransactionMalFormed(msg)
 	case tgdb.TGTransactionGeneralError:
@@ -1108,22 +1215,36 @@ func BuildException(ts tgdb.TGTransactionStatus, msg string) *TransactionExcepti
 		newException = NewTGTransactionOptimisticLockFailed(msg)
 	case tgdb.TGTransactionResourceExceeded:
 		newException = NewTGTransactionResourceExceeded(msg)
+	case tgdb.TGCurrentThreadNotInTransaction:
+		newException = NewTGCurrentThreadNotInTransaction(msg)
 	case tgdb.TGTransactionUniqueIndexKeyAttributeNullError:
 		newException = NewTGTransactionUniqueIndexKeyAttributeNullError(msg)
 	default:
-		newException = NewTGTransactionExceptionWithMsg(msg)
+		txnException := NewTGTransactionExceptionWithMsg(msg)
+		txnException.status = ts
+		newException = txnException
 	}
+	txnException := newException.candidates()
+	txnException.entityIds = entityIds
+	txnException.attributeNames = attributeNames
 	return newException
 }
 
+func (e *TransactionException) candidates() *TransactionException {
+	return e
+}
+
 ////////// TGTransactionAlreadyInProgressException //////////
 type TGTransactionAlreadyInProgressException struct {
 	*TransactionException
 }
 
-func NewTGTransactionAlreadyInProgressException(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionAlreadyInProgressException(eMsg string) *TGTransactionAlreadyInProgressException {
+	newException := TGTransactionAlreadyInProgressException{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionAlreadyInProgress
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1155,14 +1276,59 @@ func (e *TGTransactionAlreadyInProgressException) Error() string {
 	return errMsg
 }
 
+////////// TGTransactionClientDisconnected //////////
+type TGTransactionClientDisconnected struct {
+	*TransactionException
+}
+
+func NewTGTransactionClientDisconnected(eMsg string) *TGTransactionClientDisconnected {
+	newException := TGTransactionClientDisconnected{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionClientDisconnected
+	return &newException
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGError
+/////////////////////////////////////////////////////////////////
+
+func (e *TGTransactionClientDisconnected) GetErrorCode() string {
+	return e.ErrorCode
+}
+
+func (e *TGTransactionClientDisconnected) GetErrorType() int {
+	return e.ErrorType
+}
+
+func (e *TGTransactionClientDisconnected) GetErrorMsg() string {
+	return e.ErrorMsg
+}
+
+func (e *TGTransactionClientDisconnected) GetErrorDetails() string {
+	return e.ErrorDetails
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> error
+/////////////////////////////////////////////////////////////////
+
+func (e *TGTransactionClientDisconnected) Error() string {
+	errMsg := fmt.Sprintf("ErrorCode: %s, ErrorType: %d, ErrorMessage: %s, ErrorDetails: %s", e.ErrorCode, e.ErrorType, e.ErrorMsg, e.ErrorDetails)
+	return errMsg
+}
+
 ////////// TGTransactionMalFormed //////////
 type TGTransactionMalFormed struct {
 	*TransactionException
 }
 
-func NewTGTransactionMalFormed(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionMalFormed(eMsg string) *TGTransactionMalFormed {
+	newException := TGTransactionMalFormed{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionMalFormed
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1199,9 +1365,12 @@ type TGTransactionGeneralError struct {
 	*TransactionException
 }
 
-func NewTGTransactionGeneralError(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionGeneralError(eMsg string) *TGTransactionGeneralError {
+	newException := TGTransactionGeneralError{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionGeneralError
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1238,9 +1407,12 @@ type TGTransactionVerificationError struct {
 	*TransactionException
 }
 
-func NewTGTransactionVerificationError(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionVerificationError(eMsg string) *TGTransactionVerificationError {
+	newException := TGTransactionVerificationError{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionVerificationError
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1277,9 +1449,12 @@ type TGTransactionInBadState struct {
 	*TransactionException
 }
 
-func NewTGTransactionInBadState(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionInBadState(eMsg string) *TGTransactionInBadState {
+	newException := TGTransactionInBadState{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionInBadState
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1316,9 +1491,12 @@ type TGTransactionUniqueConstraintViolation struct {
 	*TransactionException
 }
 
-func NewTGTransactionUniqueConstraintViolation(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionUniqueConstraintViolation(eMsg string) *TGTransactionUniqueConstraintViolation {
+	newException := TGTransactionUniqueConstraintViolation{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionUniqueConstraintViolation
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1355,9 +1533,12 @@ type TGTransactionOptimisticLockFailed struct {
 	*TransactionException
 }
 
-func NewTGTransactionOptimisticLockFailed(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionOptimisticLockFailed(eMsg string) *TGTransactionOptimisticLockFailed {
+	newException := TGTransactionOptimisticLockFailed{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionOptimisticLockFailed
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1394,9 +1575,12 @@ type TGTransactionResourceExceeded struct {
 	*TransactionException
 }
 
-func NewTGTransactionResourceExceeded(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionResourceExceeded(eMsg string) *TGTransactionResourceExceeded {
+	newException := TGTransactionResourceExceeded{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionResourceExceeded
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1428,14 +1612,59 @@ func (e *TGTransactionResourceExceeded) Error() string {
 	return errMsg
 }
 
+////////// TGCurrentThreadNotInTransaction //////////
+type TGCurrentThreadNotInTransaction struct {
+	*TransactionException
+}
+
+func NewTGCurrentThreadNotInTransaction(eMsg string) *TGCurrentThreadNotInTransaction {
+	newException := TGCurrentThreadNotInTransaction{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGCurrentThreadNotInTransaction
+	return &newException
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGError
+/////////////////////////////////////////////////////////////////
+
+func (e *TGCurrentThreadNotInTransaction) GetErrorCode() string {
+	return e.ErrorCode
+}
+
+func (e *TGCurrentThreadNotInTransaction) GetErrorType() int {
+	return e.ErrorType
+}
+
+func (e *TGCurrentThreadNotInTransaction) GetErrorMsg() string {
+	return e.ErrorMsg
+}
+
+func (e *TGCurrentThreadNotInTransaction) GetErrorDetails() string {
+	return e.ErrorDetails
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> error
+/////////////////////////////////////////////////////////////////
+
+func (e *TGCurrentThreadNotInTransaction) Error() string {
+	errMsg := fmt.Sprintf("ErrorCode: %s, ErrorType: %d, ErrorMessage: %s, ErrorDetails: %s", e.ErrorCode, e.ErrorType, e.ErrorMsg, e.ErrorDetails)
+	return errMsg
+}
+
 ////////// TGTransactionUniqueIndexKeyAttributeNullError //////////
 type TGTransactionUniqueIndexKeyAttributeNullError struct {
 	*TransactionException
 }
 
-func NewTGTransactionUniqueIndexKeyAttributeNullError(eMsg string) *TransactionException {
-	newException := NewTGTransactionExceptionWithMsg(eMsg)
-	return newException
+func NewTGTransactionUniqueIndexKeyAttributeNullError(eMsg string) *TGTransactionUniqueIndexKeyAttributeNullError {
+	newException := TGTransactionUniqueIndexKeyAttributeNullError{
+		TransactionException: NewTGTransactionExceptionWithMsg(eMsg),
+	}
+	newException.status = tgdb.TGTransactionUniqueIndexKeyAttributeNullError
+	return &newException
 }
 
 /////////////////////////////////////////////////////////////////
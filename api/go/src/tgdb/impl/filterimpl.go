@@ -0,0 +1,602 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: filterimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"tgdb"
+	"time"
+)
+
+// Filters are small trees of predicates. The same tree is evaluated on the client by Matches and
+// rendered for the server by ToTGQL and ToGremlin, so one filter can drive both in-memory filtering
+// (Graph, GraphManager) and ExecuteQuery / ExecuteGremlinStrQuery.
+
+type filterOp int
+
+const (
+	filterOpEquals filterOp = iota
+	filterOpLessThan
+	filterOpLessOrEqual
+	filterOpGreaterThan
+	filterOpGreaterOrEqual
+	filterOpBetween
+	filterOpIn
+	filterOpStartsWith
+	filterOpIsNull
+	filterOpIsNotNull
+)
+
+var tgqlOperators = map[filterOp]string{
+	filterOpEquals:         "=",
+	filterOpLessThan:       "<",
+	filterOpLessOrEqual:    "<=",
+	filterOpGreaterThan:    ">",
+	filterOpGreaterOrEqual: ">=",
+}
+
+var gremlinPredicates = map[filterOp]string{
+	filterOpEquals:         "eq",
+	filterOpLessThan:       "lt",
+	filterOpLessOrEqual:    "lte",
+	filterOpGreaterThan:    "gt",
+	filterOpGreaterOrEqual: "gte",
+}
+
+var tgqlIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// Make sure that the filters implement the TGFilter interface
+var _ tgdb.TGFilter = (*AttributeFilter)(nil)
+var _ tgdb.TGFilter = (*NodeTypeFilter)(nil)
+var _ tgdb.TGFilter = (*CompositeFilter)(nil)
+var _ tgdb.TGFilter = (*NotFilter)(nil)
+
+/////////////////////////////////////////////////////////////////
+// Constructors
+/////////////////////////////////////////////////////////////////
+
+// NewEqualsFilter matches entities whose attribute equals the value
+func NewEqualsFilter(attrName string, value interface{}) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpEquals, value)
+}
+
+// NewLessThanFilter matches entities whose attribute is less than the value
+func NewLessThanFilter(attrName string, value interface{}) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpLessThan, value)
+}
+
+// NewLessOrEqualFilter matches entities whose attribute is less than or equal to the value
+func NewLessOrEqualFilter(attrName string, value interface{}) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpLessOrEqual, value)
+}
+
+// NewGreaterThanFilter matches entities whose attribute is greater than the value
+func NewGreaterThanFilter(attrName string, value interface{}) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpGreaterThan, value)
+}
+
+// NewGreaterOrEqualFilter matches entities whose attribute is greater than or equal to the value
+func NewGreaterOrEqualFilter(attrName string, value interface{}) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpGreaterOrEqual, value)
+}
+
+// NewBetweenFilter matches entities whose attribute lies within [low, high], both ends included
+func NewBetweenFilter(attrName string, low, high interface{}) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpBetween, low, high)
+}
+
+// NewInFilter matches entities whose attribute equals one of the values
+func NewInFilter(attrName string, values ...interface{}) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpIn, values...)
+}
+
+// NewStartsWithFilter matches entities whose string attribute starts with the prefix
+func NewStartsWithFilter(attrName string, prefix string) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpStartsWith, prefix)
+}
+
+// NewIsNullFilter matches entities where the attribute is not set or is null
+func NewIsNullFilter(attrName string) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpIsNull)
+}
+
+// NewIsNotNullFilter matches entities where the attribute has a value
+func NewIsNotNullFilter(attrName string) *AttributeFilter {
+	return newAttributeFilter(attrName, filterOpIsNotNull)
+}
+
+// NewNodeTypeFilter matches entities of any of the named types
+func NewNodeTypeFilter(typeNames ...string) *NodeTypeFilter {
+	return &NodeTypeFilter{typeNames: typeNames}
+}
+
+// NewAndFilter matches entities that match every filter
+func NewAndFilter(filters ...tgdb.TGFilter) *CompositeFilter {
+	return &CompositeFilter{isAnd: true, filters: filters}
+}
+
+// NewOrFilter matches entities that match at least one filter
+func NewOrFilter(filters ...tgdb.TGFilter) *CompositeFilter {
+	return &CompositeFilter{isAnd: false, filters: filters}
+}
+
+// NewNotFilter matches entities that do not match the filter
+func NewNotFilter(filter tgdb.TGFilter) *NotFilter {
+	return &NotFilter{filter: filter}
+}
+
+// ToGremlinQuery renders a filter as a complete vertex query for ExecuteGremlinStrQuery
+func ToGremlinQuery(filter tgdb.TGFilter) (string, tgdb.TGError) {
+	if filter == nil {
+		return "g.V()", nil
+	}
+	steps, err := filter.ToGremlin()
+	if err != nil {
+		return "", err
+	}
+	return "g.V()." + steps, nil
+}
+
+/////////////////////////////////////////////////////////////////
+// AttributeFilter
+/////////////////////////////////////////////////////////////////
+
+type AttributeFilter struct {
+	attrName string
+	op       filterOp
+	values   []interface{}
+}
+
+func newAttributeFilter(attrName string, op filterOp, values ...interface{}) *AttributeFilter {
+	return &AttributeFilter{attrName: attrName, op: op, values: values}
+}
+
+// GetAttributeName gets the name of the attribute this filter tests
+func (obj *AttributeFilter) GetAttributeName() string {
+	return obj.attrName
+}
+
+// Matches evaluates the filter against an entity on the client side
+func (obj *AttributeFilter) Matches(entity tgdb.TGEntity) bool {
+	if entity == nil {
+		return false
+	}
+	var value interface{}
+	if attr := entity.GetAttribute(obj.attrName); attr != nil && !attr.IsNull() {
+		value = attr.GetValue()
+	}
+	switch obj.op {
+	case filterOpIsNull:
+		return value == nil
+	case filterOpIsNotNull:
+		return value != nil
+	}
+	if value == nil {
+		return false
+	}
+	switch obj.op {
+	case filterOpEquals:
+		return valuesEqual(value, obj.values[0])
+	case filterOpIn:
+		for _, candidate := range obj.values {
+			if valuesEqual(value, candidate) {
+				return true
+			}
+		}
+		return false
+	case filterOpStartsWith:
+		s, ok := value.(string)
+		return ok && strings.HasPrefix(s, obj.values[0].(string))
+	case filterOpBetween:
+		low, ok1 := compareValues(value, obj.values[0])
+		high, ok2 := compareValues(value, obj.values[1])
+		return ok1 && ok2 && low >= 0 && high <= 0
+	}
+	cmp, ok := compareValues(value, obj.values[0])
+	if !ok {
+		return false
+	}
+	switch obj.op {
+	case filterOpLessThan:
+		return cmp < 0
+	case filterOpLessOrEqual:
+		return cmp <= 0
+	case filterOpGreaterThan:
+		return cmp > 0
+	case filterOpGreaterOrEqual:
+		return cmp >= 0
+	}
+	return false
+}
+
+// ToTGQL renders the filter as a TGQL expression
+func (obj *AttributeFilter) ToTGQL() (string, tgdb.TGError) {
+	if !tgqlIdentifier.MatchString(obj.attrName) {
+		errMsg := fmt.Sprintf("Attribute name '%s' cannot be used in a TGQL filter", obj.attrName)
+		return "", GetErrorByType(TGQryParsingError, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	if obj.op == filterOpIn && len(obj.values) == 0 {
+		return "", GetErrorByType(TGQryParsingError, INTERNAL_SERVER_ERROR, "An in-list filter needs at least one value", "")
+	}
+	switch obj.op {
+	case filterOpIsNull:
+		return fmt.Sprintf("%s is null", obj.attrName), nil
+	case filterOpIsNotNull:
+		return fmt.Sprintf("%s is not null", obj.attrName), nil
+	}
+	literals, err := renderLiterals(obj.values, tgqlLiteral)
+	if err != nil {
+		return "", err
+	}
+	switch obj.op {
+	case filterOpBetween:
+		return fmt.Sprintf("%s between %s and %s", obj.attrName, literals[0], literals[1]), nil
+	case filterOpIn:
+		return fmt.Sprintf("%s in (%s)", obj.attrName, strings.Join(literals, ", ")), nil
+	case filterOpStartsWith:
+		prefix := obj.values[0].(string)
+		if strings.ContainsAny(prefix, "%_") {
+			errMsg := fmt.Sprintf("Prefix '%s' of a TGQL filter cannot contain '%%' or '_'", prefix)
+			return "", GetErrorByType(TGQryParsingError, INTERNAL_SERVER_ERROR, errMsg, "")
+		}
+		pattern, _ := tgqlLiteral(prefix + "%")
+		return fmt.Sprintf("%s like %s", obj.attrName, pattern), nil
+	}
+	return fmt.Sprintf("%s %s %s", obj.attrName, tgqlOperators[obj.op], literals[0]), nil
+}
+
+// ToGremlin renders the filter as a Gremlin filter step
+func (obj *AttributeFilter) ToGremlin() (string, tgdb.TGError) {
+	if obj.op == filterOpIn && len(obj.values) == 0 {
+		return "", GetErrorByType(TGQryParsingError, INTERNAL_SERVER_ERROR, "An in-list filter needs at least one value", "")
+	}
+	name, _ := gremlinLiteral(obj.attrName)
+	switch obj.op {
+	case filterOpIsNull:
+		return fmt.Sprintf("hasNot(%s)", name), nil
+	case filterOpIsNotNull:
+		return fmt.Sprintf("has(%s)", name), nil
+	}
+	literals, err := renderLiterals(obj.values, gremlinLiteral)
+	if err != nil {
+		return "", err
+	}
+	switch obj.op {
+	case filterOpBetween:
+		return fmt.Sprintf("has(%s, gte(%s).and(lte(%s)))", name, literals[0], literals[1]), nil
+	case filterOpIn:
+		return fmt.Sprintf("has(%s, within(%s))", name, strings.Join(literals, ", ")), nil
+	case filterOpStartsWith:
+		return fmt.Sprintf("has(%s, startingWith(%s))", name, literals[0]), nil
+	case filterOpEquals:
+		return fmt.Sprintf("has(%s, %s)", name, literals[0]), nil
+	}
+	return fmt.Sprintf("has(%s, %s(%s))", name, gremlinPredicates[obj.op], literals[0]), nil
+}
+
+func (obj *AttributeFilter) String() string {
+	return fmt.Sprintf("AttributeFilter:{AttrName: %s, Op: %d, Values: %+v}", obj.attrName, obj.op, obj.values)
+}
+
+/////////////////////////////////////////////////////////////////
+// NodeTypeFilter
+/////////////////////////////////////////////////////////////////
+
+type NodeTypeFilter struct {
+	typeNames []string
+}
+
+// Matches evaluates the filter against an entity on the client side
+func (obj *NodeTypeFilter) Matches(entity tgdb.TGEntity) bool {
+	if entity == nil {
+		return false
+	}
+	entityType := entity.GetEntityType()
+	if entityType == nil || (reflect.ValueOf(entityType).Kind() == reflect.Ptr && reflect.ValueOf(entityType).IsNil()) {
+		return false
+	}
+	for _, typeName := range obj.typeNames {
+		if entityType.GetName() == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// ToTGQL renders the filter as a TGQL expression
+func (obj *NodeTypeFilter) ToTGQL() (string, tgdb.TGError) {
+	if len(obj.typeNames) == 0 {
+		return "", GetErrorByType(TGQryParsingError, INTERNAL_SERVER_ERROR, "A node type filter needs at least one type name", "")
+	}
+	terms := make([]string, 0, len(obj.typeNames))
+	for _, typeName := range obj.typeNames {
+		literal, _ := tgqlLiteral(typeName)
+		terms = append(terms, "@nodetype = "+literal)
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return "(" + strings.Join(terms, " or ") + ")", nil
+}
+
+// ToGremlin renders the filter as a Gremlin filter step
+func (obj *NodeTypeFilter) ToGremlin() (string, tgdb.TGError) {
+	if len(obj.typeNames) == 0 {
+		return "", GetErrorByType(TGQryParsingError, INTERNAL_SERVER_ERROR, "A node type filter needs at least one type name", "")
+	}
+	literals := make([]string, 0, len(obj.typeNames))
+	for _, typeName := range obj.typeNames {
+		literal, _ := gremlinLiteral(typeName)
+		literals = append(literals, literal)
+	}
+	return fmt.Sprintf("hasLabel(%s)", strings.Join(literals, ", ")), nil
+}
+
+func (obj *NodeTypeFilter) String() string {
+	return fmt.Sprintf("NodeTypeFilter:{TypeNames: %+v}", obj.typeNames)
+}
+
+/////////////////////////////////////////////////////////////////
+// CompositeFilter
+/////////////////////////////////////////////////////////////////
+
+type CompositeFilter struct {
+	isAnd   bool
+	filters []tgdb.TGFilter
+}
+
+// Matches evaluates the filter against an entity on the client side. An empty And matches everything
+// and an empty Or matches nothing
+func (obj *CompositeFilter) Matches(entity tgdb.TGEntity) bool {
+	for _, filter := range obj.filters {
+		if filter.Matches(entity) != obj.isAnd {
+			return !obj.isAnd
+		}
+	}
+	return obj.isAnd
+}
+
+// ToTGQL renders the filter as a TGQL expression
+func (obj *CompositeFilter) ToTGQL() (string, tgdb.TGError) {
+	terms, err := obj.render(func(filter tgdb.TGFilter) (string, tgdb.TGError) {
+		return filter.ToTGQL()
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	return "(" + strings.Join(terms, " "+obj.operator()+" ") + ")", nil
+}
+
+// ToGremlin renders the filter as a Gremlin filter step
+func (obj *CompositeFilter) ToGremlin() (string, tgdb.TGError) {
+	terms, err := obj.render(func(filter tgdb.TGFilter) (string, tgdb.TGError) {
+		steps, err := filter.ToGremlin()
+		return "__." + steps, err
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(terms) == 1 {
+		return strings.TrimPrefix(terms[0], "__."), nil
+	}
+	return fmt.Sprintf("%s(%s)", obj.operator(), strings.Join(terms, ", ")), nil
+}
+
+func (obj *CompositeFilter) operator() string {
+	if obj.isAnd {
+		return "and"
+	}
+	return "or"
+}
+
+func (obj *CompositeFilter) render(renderFilter func(filter tgdb.TGFilter) (string, tgdb.TGError)) ([]string, tgdb.TGError) {
+	if len(obj.filters) == 0 {
+		errMsg := fmt.Sprintf("An empty '%s' filter cannot be sent to the server", obj.operator())
+		return nil, GetErrorByType(TGQryParsingError, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	terms := make([]string, 0, len(obj.filters))
+	for _, filter := range obj.filters {
+		term, err := renderFilter(filter)
+		if err != nil {
+			return nil, err
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+func (obj *CompositeFilter) String() string {
+	return fmt.Sprintf("CompositeFilter:{Op: %s, Filters: %+v}", obj.operator(), obj.filters)
+}
+
+/////////////////////////////////////////////////////////////////
+// NotFilter
+/////////////////////////////////////////////////////////////////
+
+type NotFilter struct {
+	filter tgdb.TGFilter
+}
+
+// Matches evaluates the filter against an entity on the client side
+func (obj *NotFilter) Matches(entity tgdb.TGEntity) bool {
+	return !obj.filter.Matches(entity)
+}
+
+// ToTGQL renders the filter as a TGQL expression
+func (obj *NotFilter) ToTGQL() (string, tgdb.TGError) {
+	term, err := obj.filter.ToTGQL()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("not (%s)", term), nil
+}
+
+// ToGremlin renders the filter as a Gremlin filter step
+func (obj *NotFilter) ToGremlin() (string, tgdb.TGError) {
+	steps, err := obj.filter.ToGremlin()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("not(__.%s)", steps), nil
+}
+
+func (obj *NotFilter) String() string {
+	return fmt.Sprintf("NotFilter:{Filter: %+v}", obj.filter)
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for value comparison and rendering
+/////////////////////////////////////////////////////////////////
+
+func valuesEqual(a, b interface{}) bool {
+	if cmp, ok := compareValues(a, b); ok {
+		return cmp == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compareValues orders two attribute values. Numbers of any Go type compare with each other; strings,
+// times and booleans only compare with the same kind. ok is false when the values are not comparable
+func compareValues(a, b interface{}) (int, bool) {
+	if x, ok := a.(string); ok {
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), true
+		}
+		return 0, false
+	}
+	if x, ok := a.(time.Time); ok {
+		if y, ok := b.(time.Time); ok {
+			switch {
+			case x.Before(y):
+				return -1, true
+			case x.After(y):
+				return 1, true
+			}
+			return 0, true
+		}
+		return 0, false
+	}
+	if x, ok := a.(bool); ok {
+		if y, ok := b.(bool); ok && x == y {
+			return 0, true
+		}
+		return 0, false
+	}
+	x, ok1 := toBigFloat(a)
+	y, ok2 := toBigFloat(b)
+	if !ok1 || !ok2 {
+		return 0, false
+	}
+	return x.Cmp(y), true
+}
+
+func toBigFloat(value interface{}) (*big.Float, bool) {
+	switch v := value.(type) {
+	case *big.Float:
+		return v, v != nil
+	case big.Float:
+		return &v, true
+	case *big.Int:
+		if v == nil {
+			return nil, false
+		}
+		return new(big.Float).SetInt(v), true
+	case big.Int:
+		return new(big.Float).SetInt(&v), true
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Float).SetInt64(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Float).SetUint64(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(rv.Float()) {
+			return nil, false
+		}
+		return big.NewFloat(rv.Float()), true
+	}
+	return nil, false
+}
+
+func renderLiterals(values []interface{}, render func(value interface{}) (string, tgdb.TGError)) ([]string, tgdb.TGError) {
+	literals := make([]string, 0, len(values))
+	for _, value := range values {
+		literal, err := render(value)
+		if err != nil {
+			return nil, err
+		}
+		literals = append(literals, literal)
+	}
+	return literals, nil
+}
+
+func tgqlLiteral(value interface{}) (string, tgdb.TGError) {
+	if s, ok := value.(string); ok {
+		return "'" + strings.Replace(s, "'", "''", -1) + "'", nil
+	}
+	return numericLiteral(value)
+}
+
+func gremlinLiteral(value interface{}) (string, tgdb.TGError) {
+	if s, ok := value.(string); ok {
+		s = strings.Replace(s, `\`, `\\`, -1)
+		return "'" + strings.Replace(s, "'", `\'`, -1) + "'", nil
+	}
+	return numericLiteral(value)
+}
+
+func numericLiteral(value interface{}) (string, tgdb.TGError) {
+	switch v := value.(type) {
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case *big.Float:
+		if v != nil {
+			return v.Text('g', -1), nil
+		}
+	case *big.Int:
+		if v != nil {
+			return v.String(), nil
+		}
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	errMsg := fmt.Sprintf("Filter value '%+v' of type %T cannot be sent to the server", value, value)
+	return "", GetErrorByType(TGQryInvalidDataType, INTERNAL_SERVER_ERROR, errMsg, "")
+}
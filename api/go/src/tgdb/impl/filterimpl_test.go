@@ -0,0 +1,181 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: filterimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"math/big"
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"time"
+)
+
+var filterDay = time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+// newFilterNode returns a person node with a name, an age, a score, a birthday and a null nickname
+func newFilterNode(t *testing.T) tgdb.TGNode {
+	gmd := impl.DefaultGraphMetadata()
+	gmd.SetAttributeDescriptors(map[string]tgdb.TGAttributeDescriptor{
+		"name":     impl.NewAttributeDescriptorAsArray("name", impl.AttributeTypeString, false),
+		"nickname": impl.NewAttributeDescriptorAsArray("nickname", impl.AttributeTypeString, false),
+		"age":      impl.NewAttributeDescriptorAsArray("age", impl.AttributeTypeInteger, false),
+		"score":    impl.NewAttributeDescriptorAsArray("score", impl.AttributeTypeDouble, false),
+		"birthday": impl.NewAttributeDescriptorAsArray("birthday", impl.AttributeTypeTimeStamp, false),
+	})
+	node := impl.NewNodeWithType(gmd, impl.NewNodeType("person", nil))
+	values := map[string]interface{}{"name": "o'brien", "age": 42, "score": 7.5, "birthday": filterDay}
+	for name, value := range values {
+		if err := node.SetOrCreateAttribute(name, value); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	return node
+}
+
+func TestFilterMatches(t *testing.T) {
+	node := newFilterNode(t)
+	tests := []struct {
+		name     string
+		filter   tgdb.TGFilter
+		expected bool
+	}{
+		{"equals string", impl.NewEqualsFilter("name", "o'brien"), true},
+		{"equals other string", impl.NewEqualsFilter("name", "obrien"), false},
+		{"equals across number types", impl.NewEqualsFilter("age", int64(42)), true},
+		{"equals big int", impl.NewEqualsFilter("age", big.NewInt(42)), true},
+		{"equals string and number", impl.NewEqualsFilter("age", "42"), false},
+		{"less than", impl.NewLessThanFilter("age", 43), true},
+		{"less than equal value", impl.NewLessThanFilter("age", 42), false},
+		{"less or equal", impl.NewLessOrEqualFilter("age", 42), true},
+		{"greater than float", impl.NewGreaterThanFilter("score", 7), true},
+		{"greater or equal", impl.NewGreaterOrEqualFilter("score", 7.5), true},
+		{"between", impl.NewBetweenFilter("age", 40, 42), true},
+		{"outside between", impl.NewBetweenFilter("age", 43, 50), false},
+		{"between times", impl.NewBetweenFilter("birthday", filterDay.Add(-time.Hour), filterDay), true},
+		{"in", impl.NewInFilter("name", "alice", "o'brien"), true},
+		{"not in", impl.NewInFilter("name", "alice", "bob"), false},
+		{"empty in", impl.NewInFilter("name"), false},
+		{"starts with", impl.NewStartsWithFilter("name", "o'"), true},
+		{"starts with on a number", impl.NewStartsWithFilter("age", "4"), false},
+		{"is null", impl.NewIsNullFilter("nickname"), true},
+		{"is not null", impl.NewIsNotNullFilter("name"), true},
+		{"unset attribute compares false", impl.NewLessThanFilter("nickname", "z"), false},
+		{"node type", impl.NewNodeTypeFilter("company", "person"), true},
+		{"other node type", impl.NewNodeTypeFilter("company"), false},
+		{"and", impl.NewAndFilter(impl.NewEqualsFilter("age", 42), impl.NewNodeTypeFilter("person")), true},
+		{"and with a miss", impl.NewAndFilter(impl.NewEqualsFilter("age", 42), impl.NewNodeTypeFilter("company")), false},
+		{"or", impl.NewOrFilter(impl.NewEqualsFilter("age", 1), impl.NewEqualsFilter("age", 42)), true},
+		{"not", impl.NewNotFilter(impl.NewIsNullFilter("name")), true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.filter.Matches(node) != test.expected {
+				t.Fatalf("Expected %s to match %t", test.filter, test.expected)
+			}
+		})
+	}
+	if impl.NewIsNullFilter("name").Matches(nil) {
+		t.Fatal("Expected no filter to match a nil entity")
+	}
+}
+
+func TestFilterRendering(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  tgdb.TGFilter
+		tgql    string
+		gremlin string
+	}{
+		{"equals", impl.NewEqualsFilter("age", 42), "age = 42", "has('age', 42)"},
+		{"quote", impl.NewEqualsFilter("name", "o'brien"), "name = 'o''brien'", `has('name', 'o\'brien')`},
+		{"doubled quote", impl.NewEqualsFilter("name", "''"), "name = ''''''", `has('name', '\'\'')`},
+		{"escaped quote", impl.NewEqualsFilter("name", `\'`), `name = '\'''`, `has('name', '\\\'')`},
+		{"quote and paren", impl.NewEqualsFilter("name", "x') or 1=1 or ('"), "name = 'x'') or 1=1 or ('''", `has('name', 'x\') or 1=1 or (\'')`},
+		{"less than", impl.NewLessThanFilter("age", 10), "age < 10", "has('age', lt(10))"},
+		{"less or equal", impl.NewLessOrEqualFilter("score", 1.5), "score <= 1.5", "has('score', lte(1.5))"},
+		{"greater than", impl.NewGreaterThanFilter("age", uint8(3)), "age > 3", "has('age', gt(3))"},
+		{"greater or equal", impl.NewGreaterOrEqualFilter("age", big.NewInt(5)), "age >= 5", "has('age', gte(5))"},
+		{"between", impl.NewBetweenFilter("age", 1, 9), "age between 1 and 9", "has('age', gte(1).and(lte(9)))"},
+		{"in", impl.NewInFilter("name", "a", "b'"), "name in ('a', 'b''')", `has('name', within('a', 'b\''))`},
+		{"starts with", impl.NewStartsWithFilter("name", "o'"), "name like 'o''%'", `has('name', startingWith('o\''))`},
+		{"is null", impl.NewIsNullFilter("name"), "name is null", "hasNot('name')"},
+		{"is not null", impl.NewIsNotNullFilter("name"), "name is not null", "has('name')"},
+		{"node type", impl.NewNodeTypeFilter("person"), "@nodetype = 'person'", "hasLabel('person')"},
+		{"node types", impl.NewNodeTypeFilter("person", "o'corp"), "(@nodetype = 'person' or @nodetype = 'o''corp')", `hasLabel('person', 'o\'corp')`},
+		{"and", impl.NewAndFilter(impl.NewNodeTypeFilter("person"), impl.NewIsNotNullFilter("name")),
+			"(@nodetype = 'person' and name is not null)", "and(__.hasLabel('person'), __.has('name'))"},
+		{"single or", impl.NewOrFilter(impl.NewIsNullFilter("name")), "name is null", "hasNot('name')"},
+		{"not", impl.NewNotFilter(impl.NewEqualsFilter("age", 1)), "not (age = 1)", "not(__.has('age', 1))"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tgql, err := test.filter.ToTGQL()
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if tgql != test.tgql {
+				t.Fatalf("Expected TGQL %s, got %s", test.tgql, tgql)
+			}
+			gremlin, err := test.filter.ToGremlin()
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if gremlin != test.gremlin {
+				t.Fatalf("Expected Gremlin %s, got %s", test.gremlin, gremlin)
+			}
+		})
+	}
+}
+
+func TestFilterRenderingErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    tgdb.TGFilter
+		errorType int
+	}{
+		{"empty in", impl.NewInFilter("name"), impl.TGQryParsingError},
+		{"empty node type", impl.NewNodeTypeFilter(), impl.TGQryParsingError},
+		{"empty and", impl.NewAndFilter(), impl.TGQryParsingError},
+		{"empty or inside not", impl.NewNotFilter(impl.NewOrFilter()), impl.TGQryParsingError},
+		{"unsupported value", impl.NewEqualsFilter("name", []string{"a"}), impl.TGQryInvalidDataType},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := test.filter.ToTGQL(); err == nil || err.GetErrorType() != test.errorType {
+				t.Fatalf("Expected ToTGQL to fail with error type %d, got %v", test.errorType, err)
+			}
+			if _, err := test.filter.ToGremlin(); err == nil || err.GetErrorType() != test.errorType {
+				t.Fatalf("Expected ToGremlin to fail with error type %d, got %v", test.errorType, err)
+			}
+		})
+	}
+
+	// Only TGQL restricts attribute names and like patterns, Gremlin quotes them
+	if _, err := impl.NewEqualsFilter("first name", "x").ToTGQL(); err == nil || err.GetErrorType() != impl.TGQryParsingError {
+		t.Fatal("Expected an attribute name that is not an identifier to be rejected in TGQL")
+	}
+	if _, err := impl.NewStartsWithFilter("name", "50%").ToTGQL(); err == nil || err.GetErrorType() != impl.TGQryParsingError {
+		t.Fatal("Expected a like wildcard in a prefix to be rejected in TGQL")
+	}
+	if query, err := impl.ToGremlinQuery(impl.NewEqualsFilter("first name", "x")); err != nil || query != "g.V().has('first name', 'x')" {
+		t.Fatalf("Unexpected Gremlin query %s", query)
+	}
+}
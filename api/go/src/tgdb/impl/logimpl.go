@@ -205,7 +205,7 @@ func (m *Logger) Fatal(logMsg string) {
 }
 
 func (m *Logger) formattedMsgOutput (formattedLogMsg string) {
-	formattedLogMsg = m.formatTimeString() + formattedLogMsg
+	formattedLogMsg = m.formatTimeString() + redactLogMessage(formattedLogMsg)
 	msgLength := len(formattedLogMsg)
 	if  msgLength == 0 || formattedLogMsg[msgLength-1] != '\n' {
 		formattedLogMsg += "\n"
@@ -214,8 +214,8 @@ func (m *Logger) formattedMsgOutput (formattedLogMsg string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.log.Writer().Write(msgBytes)
-	file := m.log.Writer().(*os.File)
-	if *file != *os.Stdout {
+	// Only log files roll over - any other writer set through SetLogWriter is written as is
+	if file, ok := m.log.Writer().(*os.File); ok && file != os.Stdout && file != os.Stderr {
 		m.CheckAndUpdateFileHandle()
 	}
 }
@@ -306,8 +306,9 @@ func (m *Logger) CheckAndUpdateFileHandle () error {
 	if fileInfo.Size() < int64 (m.size) {
 		return nil
 	} else {
-		file := m.log.Writer().(*os.File)
-		file.Close()
+		if file, ok := m.log.Writer().(*os.File); ok {
+			file.Close()
+		}
 		m.currentIndex = m.currentIndex + 1
 		if m.currentIndex >= m.count {
 			m.currentIndex = 0
@@ -0,0 +1,43 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: logimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"tgdb"
+)
+
+func TestLoggerWritesToAnyWriter(t *testing.T) {
+	var buffer bytes.Buffer
+	log := defaultLogger()
+	log.SetLogLevel(tgdb.ErrorLog)
+	log.SetLogWriter(&buffer)
+	log.Error("ERROR: Returning TestLoggerWritesToAnyWriter")
+	if !strings.Contains(buffer.String(), "TestLoggerWritesToAnyWriter") {
+		t.Fatalf("Expected the message in the log writer, got '%s'", buffer.String())
+	}
+
+	log.SetLogWriter(ioutil.Discard)
+	log.Error("ERROR: Returning TestLoggerWritesToAnyWriter")
+}
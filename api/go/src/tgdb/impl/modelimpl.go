@@ -26,6 +26,7 @@ import (
 	"encoding/gob"
 	"fmt"
 	"strings"
+	"sync"
 	"tgdb"
 )
 
@@ -33,6 +34,7 @@ var logger = DefaultTGLogManager().GetLogger()
 
 
 type GraphMetadata struct {
+	lock            sync.RWMutex // guards the maps below, which every goroutine using the connection reads and updates
 	initialized     bool
 	descriptors     map[string]tgdb.TGAttributeDescriptor
 	descriptorsById map[int64]tgdb.TGAttributeDescriptor
@@ -132,6 +134,8 @@ func NewGraphMetadata(gof *GraphObjectFactory) *GraphMetadata {
 /////////////////////////////////////////////////////////////////
 
 func (obj *GraphMetadata) GetNewAttributeDescriptors() ([]tgdb.TGAttributeDescriptor, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.descriptors == nil || len(obj.descriptors) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetNewAttributeDescriptors as there are NO new AttrDesc`"))
 		return nil, nil
@@ -166,59 +170,83 @@ func (obj *GraphMetadata) GetNodeTypesById() map[int]tgdb.TGNodeType {
 }
 
 func (obj *GraphMetadata) IsInitialized() bool {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	return obj.initialized
 }
 
 func (obj *GraphMetadata) SetInitialized(flag bool) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	obj.initialized = flag
 }
 
 func (obj *GraphMetadata) SetAttributeDescriptors(attrDesc map[string]tgdb.TGAttributeDescriptor) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	obj.descriptors = attrDesc
 }
 
 func (obj *GraphMetadata) SetAttributeDescriptorsById(attrDescId map[int64]tgdb.TGAttributeDescriptor) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	obj.descriptorsById = attrDescId
 }
 
 func (obj *GraphMetadata) SetEdgeTypes(edgeTypes map[string]tgdb.TGEdgeType) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	obj.edgeTypes = edgeTypes
 }
 
 func (obj *GraphMetadata) SetEdgeTypesById(edgeTypesId map[int]tgdb.TGEdgeType) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	obj.edgeTypesById = edgeTypesId
 }
 
 func (obj *GraphMetadata) SetNodeTypes(nodeTypes map[string]tgdb.TGNodeType) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	obj.nodeTypes = nodeTypes
 }
 
 func (obj *GraphMetadata) SetNodeTypesById(nodeTypes map[int]tgdb.TGNodeType) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	obj.nodeTypesById = nodeTypes
 }
 
+// UpdateMetadata merges the types sent by the server. The lock is only held while the maps are written, since
+// resolving a type's descriptors reads the metadata back.
 func (obj *GraphMetadata) UpdateMetadata(attrDescList []tgdb.TGAttributeDescriptor, nodeTypeList []tgdb.TGNodeType, edgeTypeList []tgdb.TGEdgeType) tgdb.TGError {
 	if attrDescList != nil {
+		obj.lock.Lock()
 		for _, attrDesc := range attrDescList {
 			obj.descriptors[attrDesc.GetName()] = attrDesc.(*AttributeDescriptor)
 			obj.descriptorsById[attrDesc.GetAttributeId()] = attrDesc.(*AttributeDescriptor)
 		}
+		obj.lock.Unlock()
 	}
 	if nodeTypeList != nil {
 		for _, nodeType := range nodeTypeList {
 			nodeType.(*NodeType).UpdateMetadata(obj)
+			obj.lock.Lock()
 			obj.nodeTypes[nodeType.GetName()] = nodeType.(*NodeType)
 			obj.nodeTypesById[nodeType.GetEntityTypeId()] = nodeType.(*NodeType)
+			obj.lock.Unlock()
 		}
 	}
 	if edgeTypeList != nil {
 		for _, edgeType := range edgeTypeList {
 			edgeType.(*EdgeType).UpdateMetadata(obj)
+			obj.lock.Lock()
 			obj.edgeTypes[edgeType.GetName()] = edgeType.(*EdgeType)
 			obj.edgeTypesById[edgeType.GetEntityTypeId()] = edgeType.(*EdgeType)
+			obj.lock.Unlock()
 		}
 	}
-	obj.initialized = true
+	obj.SetInitialized(true)
 	return nil
 }
 
@@ -228,6 +256,8 @@ func (obj *GraphMetadata) UpdateMetadata(attrDescList []tgdb.TGAttributeDescript
 
 // CreateAttributeDescriptor creates Attribute Descriptor
 func (obj *GraphMetadata) CreateAttributeDescriptor(attrName string, attrType int, isArray bool) tgdb.TGAttributeDescriptor {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
 	newAttrDesc := NewAttributeDescriptorAsArray(attrName, attrType, isArray)
 	obj.descriptors[attrName] = newAttrDesc
 	return newAttrDesc
@@ -240,12 +270,16 @@ func (obj *GraphMetadata) CreateAttributeDescriptorForDataType(attrName string,
 		logger.Debug(fmt.Sprintf("GraphMetadata CreateAttributeDescriptorForDataType creating attribute descriptor for '%+v' w/ type '%+v'", attrName, attrType))
 	}
 	newAttrDesc := NewAttributeDescriptorAsArray(attrName, attrType.GetTypeId(), false)
+	obj.lock.Lock()
 	obj.descriptors[attrName] = newAttrDesc
+	obj.lock.Unlock()
 	return newAttrDesc
 }
 
 // GetAttributeDescriptor gets the Attribute Descriptor by Name
 func (obj *GraphMetadata) GetAttributeDescriptor(attrName string) (tgdb.TGAttributeDescriptor, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.descriptors == nil || len(obj.descriptors) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetAttributeDescriptor as there are NO AttrDesc`"))
 		return nil, nil
@@ -260,6 +294,8 @@ func (obj *GraphMetadata) GetAttributeDescriptor(attrName string) (tgdb.TGAttrib
 
 // GetAttributeDescriptorById gets the Attribute Descriptor by Name
 func (obj *GraphMetadata) GetAttributeDescriptorById(id int64) (tgdb.TGAttributeDescriptor, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.descriptorsById == nil || len(obj.descriptorsById) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetAttributeDescriptorById as there are NO AttrDesc`"))
 		return nil, nil
@@ -274,6 +310,8 @@ func (obj *GraphMetadata) GetAttributeDescriptorById(id int64) (tgdb.TGAttribute
 
 // GetAttributeDescriptors gets a list of Attribute Descriptors
 func (obj *GraphMetadata) GetAttributeDescriptors() ([]tgdb.TGAttributeDescriptor, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.descriptors == nil || len(obj.descriptors) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetAttributeDescriptors as there are NO AttrDesc`"))
 		return nil, nil
@@ -299,6 +337,8 @@ func (obj *GraphMetadata) CreateEdgeType(typeName string, parentEdgeType tgdb.TG
 
 // GetEdgeType returns the Edge by Name
 func (obj *GraphMetadata) GetEdgeType(typeName string) (tgdb.TGEdgeType, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.edgeTypes == nil || len(obj.edgeTypes) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetEdgeType as there are NO edges`"))
 		return nil, nil
@@ -313,6 +353,8 @@ func (obj *GraphMetadata) GetEdgeType(typeName string) (tgdb.TGEdgeType, tgdb.TG
 
 // GetEdgeTypeById returns the Edge Type by id
 func (obj *GraphMetadata) GetEdgeTypeById(id int) (tgdb.TGEdgeType, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.edgeTypesById == nil || len(obj.edgeTypesById) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetEdgeTypeById as there are NO edges`"))
 		return nil, nil
@@ -327,6 +369,8 @@ func (obj *GraphMetadata) GetEdgeTypeById(id int) (tgdb.TGEdgeType, tgdb.TGError
 
 // GetEdgeTypes returns a set of know edge Type
 func (obj *GraphMetadata) GetEdgeTypes() ([]tgdb.TGEdgeType, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.edgeTypes == nil || len(obj.edgeTypes) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetEdgeTypes as there are NO edges`"))
 		return nil, nil
@@ -346,6 +390,8 @@ func (obj *GraphMetadata) CreateNodeType(typeName string, parentNodeType tgdb.TG
 
 // GetNodeType gets Node Type by Name
 func (obj *GraphMetadata) GetNodeType(typeName string) (tgdb.TGNodeType, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.nodeTypes == nil || len(obj.nodeTypes) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetNodeType as there are NO nodes"))
 		return nil, nil
@@ -360,6 +406,8 @@ func (obj *GraphMetadata) GetNodeType(typeName string) (tgdb.TGNodeType, tgdb.TG
 
 // GetNodeTypeById returns the Node types by id
 func (obj *GraphMetadata) GetNodeTypeById(id int) (tgdb.TGNodeType, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.nodeTypesById == nil || len(obj.nodeTypesById) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetNodeTypeById as there are NO nodes"))
 		return nil, nil
@@ -377,6 +425,8 @@ func (obj *GraphMetadata) GetNodeTypeById(id int) (tgdb.TGNodeType, tgdb.TGError
 
 // GetNodeTypes returns a set of Node Type defined in the System
 func (obj *GraphMetadata) GetNodeTypes() ([]tgdb.TGNodeType, tgdb.TGError) {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
 	if obj.nodeTypes == nil || len(obj.nodeTypes) == 0 {
 		logger.Warning(fmt.Sprint("WARNING: Returning GraphMetadata:GetNodeTypes as there are NO nodes"))
 		return nil, nil
@@ -601,7 +651,11 @@ func (obj *GraphObjectFactory) UnmarshalBinary(data []byte) error {
 
 type Graph struct {
 	*Node
-	name string
+	name      string
+	parent    *Graph
+	nodes     []tgdb.TGNode
+	edges     []tgdb.TGEdge
+	subGraphs []*Graph
 }
 
 func DefaultGraph() *Graph {
@@ -611,7 +665,10 @@ func DefaultGraph() *Graph {
 	gob.Register(Graph{})
 
 	newGraph := Graph{
-		Node: DefaultNode(),
+		Node:      DefaultNode(),
+		nodes:     make([]tgdb.TGNode, 0),
+		edges:     make([]tgdb.TGEdge, 0),
+		subGraphs: make([]*Graph, 0),
 	}
 	newGraph.EntityKind = tgdb.EntityKindGraph
 	return &newGraph
@@ -645,41 +702,303 @@ func (obj *Graph) SetName(name string) {
 	obj.name = name
 }
 
-// TODO: Revisit later - Ask TGDB Engineering Team as to if-n-how implement these methods
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGGraph
 /////////////////////////////////////////////////////////////////
 
+// AddNode adds a node to this graph. A new node is also marked for insert on the owning connection
 func (obj *Graph) AddNode(node tgdb.TGNode) (tgdb.TGGraph, tgdb.TGError) {
+	if node == nil {
+		errMsg := fmt.Sprintf("Unable to add a nil node to graph '%s'", obj.name)
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	if obj.hasNode(node) {
+		return obj, nil
+	}
+	if node.GetIsNew() {
+		if conn := obj.getConnection(); conn != nil {
+			if err := conn.InsertEntity(node); err != nil {
+				return nil, err
+			}
+		}
+	}
+	obj.nodes = append(obj.nodes, node)
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Inside Graph:AddNode added node '%d' to graph '%s'", node.GetVirtualId(), obj.name))
+	}
 	return obj, nil
 }
 
+// AddEdges adds a collection of edges, and the nodes they connect, to this graph.
+// New edges are also marked for insert on the owning connection
 func (obj *Graph) AddEdges(edges []tgdb.TGEdge) (tgdb.TGGraph, tgdb.TGError) {
+	for _, edge := range edges {
+		if edge == nil {
+			errMsg := fmt.Sprintf("Unable to add a nil edge to graph '%s'", obj.name)
+			return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+		}
+		for _, node := range edge.GetVertices() {
+			if node == nil {
+				continue
+			}
+			if _, err := obj.AddNode(node); err != nil {
+				return nil, err
+			}
+		}
+		if obj.hasEdge(edge) {
+			continue
+		}
+		if edge.GetIsNew() {
+			if conn := obj.getConnection(); conn != nil {
+				if err := conn.InsertEntity(edge); err != nil {
+					return nil, err
+				}
+			}
+		}
+		obj.edges = append(obj.edges, edge)
+	}
 	return obj, nil
 }
 
+// GetNode gets the unique node of this graph that matches the filter. It returns nil when no node
+// matches and an error when more than one does
 func (obj *Graph) GetNode(filter tgdb.TGFilter) (tgdb.TGNode, tgdb.TGError) {
-	return nil, nil
+	matches := obj.findNodes(filter, false)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+	if len(matches) > 1 {
+		errMsg := fmt.Sprintf("%d nodes of graph '%s' match the filter where one was expected", len(matches), obj.name)
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	return matches[0], nil
 }
 
+// ListNodes lists the nodes that match the filter as an unnamed graph. The returned graph is only a
+// view: it is not attached to this graph and changing it does not mark anything on the connection
 func (obj *Graph) ListNodes(filter tgdb.TGFilter, recurseAllSubGraphs bool) (tgdb.TGNode, tgdb.TGError) {
-	return nil, nil
+	result := NewGraph(obj.graphMetadata)
+	result.nodes = obj.findNodes(filter, recurseAllSubGraphs)
+	return result, nil
 }
 
+// CreateGraph creates a sub graph within this graph
 func (obj *Graph) CreateGraph(name string) (tgdb.TGGraph, tgdb.TGError) {
-	return nil, nil
+	if name == "" {
+		errMsg := fmt.Sprintf("Unable to create a sub graph of graph '%s' without a name", obj.name)
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	if obj.getSubGraph(name) != nil {
+		errMsg := fmt.Sprintf("Graph '%s' already has a sub graph named '%s'", obj.name, name)
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	subGraph := NewGraphWithName(obj.graphMetadata, name)
+	subGraph.parent = obj
+	obj.subGraphs = append(obj.subGraphs, subGraph)
+	return subGraph, nil
 }
 
+// RemoveGraph removes the named sub graph. Its nodes stay in the database
 func (obj *Graph) RemoveGraph(name string) (tgdb.TGGraph, tgdb.TGError) {
-	return nil, nil
+	for i, subGraph := range obj.subGraphs {
+		if subGraph.name == name {
+			obj.subGraphs = append(obj.subGraphs[:i], obj.subGraphs[i+1:]...)
+			subGraph.parent = nil
+			return subGraph, nil
+		}
+	}
+	errMsg := fmt.Sprintf("Graph '%s' has no sub graph named '%s'", obj.name, name)
+	return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
 }
 
+// RemoveNode removes the node, and the edges that connect to it, from every graph in this graph's
+// tree and marks them for delete on the owning connection
 func (obj *Graph) RemoveNode(node tgdb.TGNode) (tgdb.TGGraph, tgdb.TGError) {
-	return nil, nil
+	if node == nil || !obj.containsNode(node) {
+		errMsg := fmt.Sprintf("Node is not part of graph '%s'", obj.name)
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	removedEdges := obj.getRoot().detachNode(node)
+	if conn := obj.getConnection(); conn != nil {
+		for _, edge := range removedEdges {
+			if err := conn.DeleteEntity(edge); err != nil {
+				return nil, err
+			}
+		}
+		if err := conn.DeleteEntity(node); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
 }
 
+// RemoveNodes removes the nodes of this graph and its sub graphs that match the filter, and returns how
+// many were removed
 func (obj *Graph) RemoveNodes(filter tgdb.TGFilter) int {
-	return 0
+	count := 0
+	for _, node := range obj.findNodes(filter, true) {
+		if _, err := obj.RemoveNode(node); err != nil {
+			logger.Warning(fmt.Sprintf("WARNING: Graph:RemoveNodes - unable to remove node '%d' w/ error: '%s'", node.GetVirtualId(), err.Error()))
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for Graph membership
+/////////////////////////////////////////////////////////////////
+
+// GetNodes returns the nodes that are direct members of this graph
+func (obj *Graph) GetNodes() []tgdb.TGNode {
+	return obj.nodes
+}
+
+// GetMemberEdges returns the edges that are direct members of this graph
+func (obj *Graph) GetMemberEdges() []tgdb.TGEdge {
+	return obj.edges
+}
+
+// GetSubGraphs returns the sub graphs created within this graph
+func (obj *Graph) GetSubGraphs() []tgdb.TGGraph {
+	subGraphs := make([]tgdb.TGGraph, 0, len(obj.subGraphs))
+	for _, subGraph := range obj.subGraphs {
+		subGraphs = append(subGraphs, subGraph)
+	}
+	return subGraphs
+}
+
+func (obj *Graph) getConnection() tgdb.TGConnection {
+	if obj.graphMetadata == nil || obj.graphMetadata.graphObjFactory == nil {
+		return nil
+	}
+	return obj.graphMetadata.GetConnection()
+}
+
+func (obj *Graph) getRoot() *Graph {
+	root := obj
+	for root.parent != nil {
+		root = root.parent
+	}
+	return root
+}
+
+func (obj *Graph) getSubGraph(name string) *Graph {
+	for _, subGraph := range obj.subGraphs {
+		if subGraph.name == name {
+			return subGraph
+		}
+	}
+	return nil
+}
+
+func (obj *Graph) hasNode(node tgdb.TGNode) bool {
+	for _, member := range obj.nodes {
+		if isSameEntity(member, node) {
+			return true
+		}
+	}
+	return false
+}
+
+func (obj *Graph) hasEdge(edge tgdb.TGEdge) bool {
+	for _, member := range obj.edges {
+		if isSameEntity(member, edge) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsNode checks this graph and all its sub graphs
+func (obj *Graph) containsNode(node tgdb.TGNode) bool {
+	if obj.hasNode(node) {
+		return true
+	}
+	for _, subGraph := range obj.subGraphs {
+		if subGraph.containsNode(node) {
+			return true
+		}
+	}
+	return false
+}
+
+// findNodes returns the matching nodes once each, in the order they were added
+func (obj *Graph) findNodes(filter tgdb.TGFilter, recurseAllSubGraphs bool) []tgdb.TGNode {
+	matches := make([]tgdb.TGNode, 0)
+	seen := make(map[tgdb.TGNode]bool)
+	var collect func(graph *Graph)
+	collect = func(graph *Graph) {
+		for _, node := range graph.nodes {
+			if seen[node] {
+				continue
+			}
+			seen[node] = true
+			if filter == nil || filter.Matches(node) {
+				matches = append(matches, node)
+			}
+		}
+		if recurseAllSubGraphs {
+			for _, subGraph := range graph.subGraphs {
+				collect(subGraph)
+			}
+		}
+	}
+	collect(obj)
+	return matches
+}
+
+// detachNode removes the node and its edges from this graph and its sub graphs, and returns the removed edges
+func (obj *Graph) detachNode(node tgdb.TGNode) []tgdb.TGEdge {
+	removedEdges := make([]tgdb.TGEdge, 0)
+	nodes := obj.nodes[:0]
+	for _, member := range obj.nodes {
+		if !isSameEntity(member, node) {
+			nodes = append(nodes, member)
+		}
+	}
+	obj.nodes = nodes
+	edges := obj.edges[:0]
+	for _, edge := range obj.edges {
+		connected := false
+		for _, vertex := range edge.GetVertices() {
+			if vertex != nil && isSameEntity(vertex, node) {
+				connected = true
+			}
+		}
+		if connected {
+			removedEdges = append(removedEdges, edge)
+		} else {
+			edges = append(edges, edge)
+		}
+	}
+	obj.edges = edges
+	for _, subGraph := range obj.subGraphs {
+		for _, edge := range subGraph.detachNode(node) {
+			if !containsEntity(removedEdges, edge) {
+				removedEdges = append(removedEdges, edge)
+			}
+		}
+	}
+	return removedEdges
+}
+
+// isSameEntity compares by identity and, for entities known to the server, by entity id
+func isSameEntity(a, b tgdb.TGEntity) bool {
+	if a == b {
+		return true
+	}
+	return !a.GetIsNew() && !b.GetIsNew() && a.GetEntityKind() == b.GetEntityKind() && a.GetVirtualId() == b.GetVirtualId()
+}
+
+func containsEntity(edges []tgdb.TGEdge, edge tgdb.TGEdge) bool {
+	for _, member := range edges {
+		if isSameEntity(member, edge) {
+			return true
+		}
+	}
+	return false
 }
 
 /////////////////////////////////////////////////////////////////
@@ -1021,15 +1340,21 @@ func (obj *Graph) UnmarshalBinary(data []byte) error {
 
 
 
+// GraphManager works on a client side root graph. Nodes and edges created or deleted through it are
+// tracked on the connection that owns the graph metadata, so that the next Commit persists them
 type GraphManager struct {
-	name string
+	name          string
+	graphMetadata *GraphMetadata
+	rootGraph     *Graph
 }
 
-func NewGraphManager(gmd GraphMetadata) GraphManager {
+func NewGraphManager(gmd *GraphMetadata) *GraphManager {
 	newGraphManager := GraphManager{
-		name: "TGDB Graph Manager",
+		name:          "TGDB Graph Manager",
+		graphMetadata: gmd,
+		rootGraph:     NewGraphWithName(gmd, "root"),
 	}
-	return newGraphManager
+	return &newGraphManager
 }
 
 ///////////////////////////////////////
@@ -1041,64 +1366,138 @@ func (obj *GraphManager) GetName() string {
 	return obj.name
 }
 
+// GetRootGraph gets the root graph that holds every node created through this manager
+func (obj *GraphManager) GetRootGraph() tgdb.TGGraph {
+	return obj.rootGraph
+}
+
 ///////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGGraphManager //
 ///////////////////////////////////////////////////////////
 
 // CreateNode creates Node within this Graph. There is a default Root Graph.
 func (obj *GraphManager) CreateNode() (tgdb.TGNode, tgdb.TGError) {
-	return nil, nil
+	node := NewNode(obj.graphMetadata)
+	if _, err := obj.rootGraph.AddNode(node); err != nil {
+		return nil, err
+	}
+	return node, nil
 }
 
 // CreateNodeForNodeType creates Node of particular Type
 func (obj *GraphManager) CreateNodeForNodeType(nodeType tgdb.TGNodeType) (tgdb.TGNode, tgdb.TGError) {
-	return nil, nil
+	if nodeType == nil {
+		errMsg := fmt.Sprint("Unable to create a node without a node type")
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	node := NewNodeWithType(obj.graphMetadata, nodeType)
+	if _, err := obj.rootGraph.AddNode(node); err != nil {
+		return nil, err
+	}
+	return node, nil
 }
 
-// CreateEdge creates an Edge
+// CreateEdge creates an Edge of the edge type with the given id
 func (obj *GraphManager) CreateEdge(fromNode tgdb.TGNode, toNode tgdb.TGNode, edgeType int) (tgdb.TGEdge, tgdb.TGError) {
-	return nil, nil
+	eType, err := obj.graphMetadata.GetEdgeTypeById(edgeType)
+	if err != nil {
+		return nil, err
+	}
+	if eType == nil {
+		errMsg := fmt.Sprintf("Edge type with id %d not found", edgeType)
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	return obj.addEdge(NewEdgeWithEdgeType(obj.graphMetadata, fromNode, toNode, eType))
 }
 
 // CreateEdgeWithDirection creates an Edge with direction
 func (obj *GraphManager) CreateEdgeWithDirection(fromNode tgdb.TGNode, toNode tgdb.TGNode, directionType tgdb.TGDirectionType) (tgdb.TGEdge, tgdb.TGError) {
-	return nil, nil
+	return obj.addEdge(NewEdgeWithDirection(obj.graphMetadata, fromNode, toNode, directionType))
 }
 
 // CreateGraph creates a SubGraph at the Root level.
 func (obj *GraphManager) CreateGraph(name string) (tgdb.TGGraph, tgdb.TGError) {
-	return nil, nil
+	return obj.rootGraph.CreateGraph(name)
 }
 
-// DeleteNode removes this node from the graph
+// DeleteNode removes the single node that matches the filter. It is an error if more than one node matches
 func (obj *GraphManager) DeleteNode(filter tgdb.TGFilter) (tgdb.TGGraphManager, tgdb.TGError) {
-	return nil, nil
+	matches := obj.rootGraph.findNodes(filter, true)
+	if len(matches) == 0 {
+		return obj, nil
+	}
+	if len(matches) > 1 {
+		errMsg := fmt.Sprintf("%d nodes match the filter where one was expected", len(matches))
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	if _, err := obj.rootGraph.RemoveNode(matches[0]); err != nil {
+		return nil, err
+	}
+	return obj, nil
 }
 
 // DeleteNodes removes the nodes from this graph that match the filter
 func (obj *GraphManager) DeleteNodes(filter tgdb.TGFilter) (tgdb.TGGraphManager, tgdb.TGError) {
-	return nil, nil
+	for _, node := range obj.rootGraph.findNodes(filter, true) {
+		if _, err := obj.rootGraph.RemoveNode(node); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
 }
 
-// CreateQuery creates a Reusable Query
+// CreateQuery creates a Reusable Query from the TGQL form of the filter
 func (obj *GraphManager) CreateQuery(filter tgdb.TGFilter) tgdb.TGQuery {
-	return nil
+	conn := obj.rootGraph.getConnection()
+	if filter == nil || conn == nil {
+		logger.Warning(fmt.Sprint("WARNING: Returning GraphManager:CreateQuery as there is NO filter or connection"))
+		return nil
+	}
+	expr, err := filter.ToTGQL()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning GraphManager:CreateQuery - unable to render filter w/ error: '%s'", err.Error()))
+		return nil
+	}
+	query, err := conn.CreateQuery(expr)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning GraphManager:CreateQuery - unable to create query w/ error: '%s'", err.Error()))
+		return nil
+	}
+	return query
 }
 
-// QueryNodes gets Nodes based on the Filter condition with a set of Arguments
+// QueryNodes gets the nodes of the root graph and its sub graphs that match the filter
 func (obj *GraphManager) QueryNodes(filter tgdb.TGFilter, args ...interface{}) tgdb.TGResultSet {
-	return nil
+	resultSet := NewResultSet(obj.rootGraph.getConnection(), 0)
+	for _, node := range obj.rootGraph.findNodes(filter, true) {
+		resultSet.AddEntityToResultSet(node)
+	}
+	return resultSet
 }
 
 // Traverse follows the graph using the traversal descriptor
 func (obj *GraphManager) Traverse(descriptor tgdb.TGTraversalDescriptor, startingPoints []tgdb.TGNode) tgdb.TGResultSet {
-	return nil
+	if descriptor == nil {
+		logger.Warning(fmt.Sprint("WARNING: Returning GraphManager:Traverse as there is NO traversal descriptor"))
+		return nil
+	}
+	return descriptor.Traverse(startingPoints)
 }
 
 // GetGraphMetadata gets the Graph Metadata
 func (obj *GraphManager) GetGraphMetadata() tgdb.TGGraphMetadata {
-	return nil
+	return obj.graphMetadata
 }
 
-
-
+func (obj *GraphManager) addEdge(edge *Edge) (tgdb.TGEdge, tgdb.TGError) {
+	if edge.FromNode == nil || edge.ToNode == nil {
+		errMsg := fmt.Sprint("Unable to create an edge without both a from and a to node")
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	edge.FromNode.AddEdge(edge)
+	edge.ToNode.AddEdge(edge)
+	if _, err := obj.rootGraph.AddEdges([]tgdb.TGEdge{edge}); err != nil {
+		return nil, err
+	}
+	return edge, nil
+}
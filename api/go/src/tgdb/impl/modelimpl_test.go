@@ -0,0 +1,295 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: modelimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/mockserver"
+)
+
+// newGraphManager connects to a mock server with a person node type, and returns a graph manager working
+// on the connection's metadata and the person node type
+func newGraphManager(t *testing.T) (*mockserver.Server, tgdb.TGConnection, *impl.GraphManager, tgdb.TGNodeType) {
+	server, conn := connectToMock(t)
+	addPeople(t, server.GetStore())
+	gmd, err := conn.GetGraphMetadata(true)
+	if err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	nodeType, err := gmd.GetNodeType("person")
+	if err != nil || nodeType == nil {
+		server.Close()
+		t.Fatal("Expected the person node type in the metadata")
+	}
+	return server, conn, impl.NewGraphManager(gmd.(*impl.GraphMetadata)), nodeType
+}
+
+// fetchPeople stores one person per name and reads them back through a query, so that they are known
+// to the server
+func fetchPeople(t *testing.T, server *mockserver.Server, conn tgdb.TGConnection, names ...string) []tgdb.TGNode {
+	ids := make([]int64, 0)
+	for _, name := range names {
+		node, err := server.GetStore().AddNode("person", map[string]interface{}{"name": name})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		ids = append(ids, node.GetVirtualId())
+	}
+	server.GetStore().SetQueryResult("people", ids...)
+	resultSet, err := conn.ExecuteQuery("people", impl.NewQueryOption())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	people := make([]tgdb.TGNode, 0)
+	for resultSet.HasNext() {
+		people = append(people, resultSet.Next().(tgdb.TGNode))
+	}
+	if len(people) != len(ids) {
+		t.Fatalf("Expected %d people, got %d", len(ids), len(people))
+	}
+	return people
+}
+
+func createPerson(t *testing.T, gm *impl.GraphManager, nodeType tgdb.TGNodeType, name string) tgdb.TGNode {
+	node, err := gm.CreateNodeForNodeType(nodeType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err := node.SetOrCreateAttribute("name", name); err != nil {
+		t.Fatal(err.Error())
+	}
+	return node
+}
+
+func memberNames(graph tgdb.TGNode) []string {
+	names := make([]string, 0)
+	for _, node := range graph.(*impl.Graph).GetNodes() {
+		names = append(names, node.GetAttribute("name").GetValue().(string))
+	}
+	return names
+}
+
+func TestGraphManagerCommitsCreatedNodesAndEdges(t *testing.T) {
+	server, conn, gm, person := newGraphManager(t)
+	defer server.Close()
+	defer conn.Disconnect()
+
+	a := createPerson(t, gm, person, "a")
+	b := createPerson(t, gm, person, "b")
+	if _, err := gm.CreateEdgeWithDirection(a, b, tgdb.DirectionTypeDirected); err != nil {
+		t.Fatal(err.Error())
+	}
+	// A node created and deleted before the commit is never sent
+	createPerson(t, gm, person, "c")
+	if _, err := gm.DeleteNode(impl.NewEqualsFilter("name", "c")); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := conn.Commit(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if count := server.GetStore().GetEntityCount(); count != 3 {
+		t.Fatalf("Expected the 2 nodes and the edge to be stored, got %d entities", count)
+	}
+	if a.GetIsNew() || b.GetIsNew() {
+		t.Fatal("Expected the committed nodes to have their server ids")
+	}
+	if names := memberNames(gm.GetRootGraph()); len(names) != 2 {
+		t.Fatalf("Expected a and b in the root graph, got %v", names)
+	}
+}
+
+func TestGraphRemoveNodeGoesThroughDeleteTracking(t *testing.T) {
+	server, conn, gm, _ := newGraphManager(t)
+	defer server.Close()
+	defer conn.Disconnect()
+	people := fetchPeople(t, server, conn, "a", "b", "c")
+	root := gm.GetRootGraph()
+	for _, node := range people {
+		if _, err := root.AddNode(node); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	// Adding a member again changes nothing
+	if _, err := root.AddNode(people[0]); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := gm.CreateEdgeWithDirection(people[0], people[1], tgdb.DirectionTypeDirected); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Removing a drops the new edge to b from the graph and from the pending inserts, and deletes a
+	if _, err := root.RemoveNode(people[0]); err != nil {
+		t.Fatal(err.Error())
+	}
+	if edges := root.(*impl.Graph).GetMemberEdges(); len(edges) != 0 {
+		t.Fatalf("Expected the edge of the removed node to leave the graph, got %d edges", len(edges))
+	}
+	if _, err := root.RemoveNode(people[0]); err == nil {
+		t.Fatal("Expected removing a node that is no longer a member to fail")
+	}
+	if _, err := conn.Commit(); err != nil {
+		t.Fatal(err.Error())
+	}
+	store := server.GetStore()
+	if store.GetEntity(people[0].GetVirtualId()) != nil || store.GetEntity(people[1].GetVirtualId()) == nil {
+		t.Fatal("Expected a to be deleted and b to stay")
+	}
+	if count := store.GetEntityCount(); count != 2 {
+		t.Fatalf("Expected b and c to be left, without the edge, got %d entities", count)
+	}
+	if names := memberNames(root); len(names) != 2 || names[0] != "b" || names[1] != "c" {
+		t.Fatalf("Expected b and c in the root graph, got %v", names)
+	}
+}
+
+func TestNestedSubGraphs(t *testing.T) {
+	server, conn, gm, person := newGraphManager(t)
+	defer server.Close()
+	defer conn.Disconnect()
+	root := gm.GetRootGraph()
+	friends, err := gm.CreateGraph("friends")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	closeFriends, err := friends.CreateGraph("close")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := gm.CreateGraph("friends"); err == nil {
+		t.Fatal("Expected a second sub graph of the same name to be rejected")
+	}
+	if _, err := friends.CreateGraph(""); err == nil {
+		t.Fatal("Expected a sub graph without a name to be rejected")
+	}
+
+	a := createPerson(t, gm, person, "a")
+	b := createPerson(t, gm, person, "b")
+	for _, graph := range []tgdb.TGGraph{friends, closeFriends} {
+		if _, err := graph.AddNode(b); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	if _, err := closeFriends.AddNode(a); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	tests := []struct {
+		name     string
+		graph    tgdb.TGGraph
+		recurse  bool
+		expected int
+	}{
+		{"root only", root, false, 2},
+		{"root and sub graphs, each node once", root, true, 2},
+		{"friends only", friends, false, 1},
+		{"friends and close", friends, true, 2},
+		{"close", closeFriends, false, 2},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			list, err := test.graph.ListNodes(nil, test.recurse)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if names := memberNames(list); len(names) != test.expected {
+				t.Fatalf("Expected %d nodes, got %v", test.expected, names)
+			}
+		})
+	}
+
+	// Removing through the innermost graph removes the node from the whole tree
+	if _, err := closeFriends.RemoveNode(b); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, graph := range []tgdb.TGGraph{root, friends, closeFriends} {
+		if _, err := graph.GetNode(impl.NewEqualsFilter("name", "b")); err != nil {
+			t.Fatal(err.Error())
+		}
+		if node, _ := graph.ListNodes(impl.NewEqualsFilter("name", "b"), true); len(memberNames(node)) != 0 {
+			t.Fatalf("Expected b to be gone from graph '%s'", graph.(*impl.Graph).GetName())
+		}
+	}
+
+	removed, err := friends.RemoveGraph("close")
+	if err != nil || removed != closeFriends {
+		t.Fatal("Expected the close sub graph to be removed")
+	}
+	if len(friends.(*impl.Graph).GetSubGraphs()) != 0 {
+		t.Fatal("Expected friends to have no sub graphs left")
+	}
+	if _, err := friends.RemoveGraph("close"); err == nil {
+		t.Fatal("Expected removing a missing sub graph to fail")
+	}
+}
+
+func TestGraphFilters(t *testing.T) {
+	server, conn, gm, person := newGraphManager(t)
+	defer server.Close()
+	defer conn.Disconnect()
+	for _, name := range []string{"alice", "albert", "bob", "carol"} {
+		createPerson(t, gm, person, name)
+	}
+	root := gm.GetRootGraph()
+
+	node, err := root.GetNode(impl.NewEqualsFilter("name", "bob"))
+	if err != nil || node == nil || node.GetAttribute("name").GetValue() != "bob" {
+		t.Fatal("Expected GetNode to find bob")
+	}
+	if node, err := root.GetNode(impl.NewEqualsFilter("name", "dave")); err != nil || node != nil {
+		t.Fatal("Expected GetNode to find nobody named dave")
+	}
+	if _, err := root.GetNode(impl.NewStartsWithFilter("name", "al")); err == nil {
+		t.Fatal("Expected GetNode to fail when two nodes match")
+	}
+
+	resultSet := gm.QueryNodes(impl.NewOrFilter(impl.NewStartsWithFilter("name", "al"), impl.NewEqualsFilter("name", "carol")))
+	if count := len(resultSet.ToCollection()); count != 3 {
+		t.Fatalf("Expected QueryNodes to return 3 nodes, got %d", count)
+	}
+	if count := len(gm.QueryNodes(impl.NewNodeTypeFilter("company")).ToCollection()); count != 0 {
+		t.Fatalf("Expected no node of another type, got %d", count)
+	}
+	if _, err := gm.DeleteNode(impl.NewStartsWithFilter("name", "al")); err == nil {
+		t.Fatal("Expected DeleteNode to fail when two nodes match")
+	}
+
+	if count := root.RemoveNodes(impl.NewNotFilter(impl.NewStartsWithFilter("name", "al"))); count != 2 {
+		t.Fatalf("Expected 2 nodes to be removed, got %d", count)
+	}
+	if _, err := gm.DeleteNodes(impl.NewEqualsFilter("name", "albert")); err != nil {
+		t.Fatal(err.Error())
+	}
+	if names := memberNames(root); len(names) != 1 || names[0] != "alice" {
+		t.Fatalf("Expected only alice to be left, got %v", names)
+	}
+
+	// Only alice is left to insert
+	if _, err := conn.Commit(); err != nil {
+		t.Fatal(err.Error())
+	}
+	if count := server.GetStore().GetEntityCount(); count != 1 {
+		t.Fatalf("Expected 1 stored node, got %d", count)
+	}
+}
@@ -26,7 +26,6 @@ import (
 	"encoding/binary"
 	"encoding/gob"
 	"fmt"
-	"io"
 	"math"
 	"reflect"
 	"tgdb"
@@ -82,7 +81,8 @@ const (
 	VerbPartialExportResponse int = 28
 	VerbCancelExportRequest   int = 29
 	VerbBeginImportRequest    int = 31
-	BeginImportResponse       int = 32
+	VerbBeginImportResponse   int = 32
+	BeginImportResponse       int = VerbBeginImportResponse // Deprecated: use VerbBeginImportResponse
 	VerbPartialImportRequest  int = 33
 	VerbPartialImportResponse int = 34
 	// Dump Stacktrace request verb
@@ -128,6 +128,15 @@ var PreDefinedVerbs = map[int]CommandVerbs{
 	VerbGetEntityResponse:           {id: VerbGetEntityResponse, name: "VerbGetEntityResponse", implementor: "pdu.VerbGetEntityResponse"}, //Represented in ms. Default Value is 10sec
 	VerbGetLargeObjectRequest:       {id: VerbGetLargeObjectRequest, name: "VerbGetLargeObjectRequest", implementor: "pdu.VerbGetLargeObjectRequest"},
 	VerbGetLargeObjectResponse:      {id: VerbGetLargeObjectResponse, name: "VerbGetLargeObjectResponse", implementor: "pdu.VerbGetLargeObjectResponse"},
+	VerbBeginExportRequest:          {id: VerbBeginExportRequest, name: "VerbBeginExportRequest", implementor: "admin.VerbBeginExportRequest"},
+	VerbBeginExportResponse:         {id: VerbBeginExportResponse, name: "VerbBeginExportResponse", implementor: "admin.VerbBeginExportResponse"},
+	VerbPartialExportRequest:        {id: VerbPartialExportRequest, name: "VerbPartialExportRequest", implementor: "admin.VerbPartialExportRequest"},
+	VerbPartialExportResponse:       {id: VerbPartialExportResponse, name: "VerbPartialExportResponse", implementor: "admin.VerbPartialExportResponse"},
+	VerbCancelExportRequest:         {id: VerbCancelExportRequest, name: "VerbCancelExportRequest", implementor: "admin.VerbCancelExportRequest"},
+	VerbBeginImportRequest:          {id: VerbBeginImportRequest, name: "VerbBeginImportRequest", implementor: "admin.VerbBeginImportRequest"},
+	VerbBeginImportResponse:         {id: VerbBeginImportResponse, name: "VerbBeginImportResponse", implementor: "admin.VerbBeginImportResponse"},
+	VerbPartialImportRequest:        {id: VerbPartialImportRequest, name: "VerbPartialImportRequest", implementor: "admin.VerbPartialImportRequest"},
+	VerbPartialImportResponse:       {id: VerbPartialImportResponse, name: "VerbPartialImportResponse", implementor: "admin.VerbPartialImportResponse"},
 	VerbDumpStacktraceRequest:       {id: VerbDumpStacktraceRequest, name: "VerbDumpStacktraceRequest", implementor: "pdu.VerbDumpStacktraceRequest"},
 	VerbDisconnectChannelRequest:    {id: VerbDisconnectChannelRequest, name: "VerbDisconnectChannelRequest", implementor: "pdu.VerbDisconnectChannelRequest"},
 	VerbSessionForcefullyTerminated: {id: VerbSessionForcefullyTerminated, name: "VerbSessionForcefullyTerminated", implementor: "pdu.VerbSessionForcefullyTerminated"},
@@ -386,7 +395,7 @@ func (msg *ProtocolDataInputStream) ReadUTF() (string, tgdb.TGError) {
 	}
 	str, err := msg.ReadUTFString(int(utfLen))
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::ReadUTF from the contents w/ ('%s') & error ('%+v')", str, err))
+		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::ReadUTF from the contents w/ ('%s') & error ('%+v')", redactSecret([]byte(str)), err))
 	}
 	return str, err
 }
@@ -477,7 +486,7 @@ func (msg *ProtocolDataInputStream) ReadUTFString(utfLen int) (string, tgdb.TGEr
 
 func (msg *ProtocolDataInputStream) SkipBytes(n int) (int, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::SkipBytes('%d'), contents are '%+v'", n, msg.Buf))
+		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::SkipBytes('%d'), contents are '%+v'", n, redactBytes(msg.Buf)))
 	}
 	if n < 0 {
 		logger.Error(fmt.Sprint("ERROR: Returning ProtocolDataInputStream::SkipBytes as n < 0"))
@@ -508,7 +517,7 @@ func (msg *ProtocolDataInputStream) String() string {
 	buffer.WriteString(fmt.Sprintf("Buffer Length: %d", msg.BufLen))
 	buffer.WriteString(fmt.Sprintf(", Current Position: %d", msg.iStreamCurPos))
 	buffer.WriteString(fmt.Sprintf(", Encoding: %s", msg.iStreamEncoding))
-	buffer.WriteString(fmt.Sprintf(", Buffer: %s", bytes.NewBuffer(msg.Buf).String()))
+	buffer.WriteString(fmt.Sprintf(", Buffer: %+v", redactBytes(msg.Buf)))
 	buffer.WriteString(fmt.Sprintf(", Reference Map: %+v", msg.iStreamReferenceMap))
 	buffer.WriteString("}")
 	return buffer.String()
@@ -553,7 +562,7 @@ func (msg *ProtocolDataInputStream) MarkSupported() bool {
 // Read reads the current byte
 func (msg *ProtocolDataInputStream) Read() (int, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::Read(), contents are '%+v'", msg.Buf))
+		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::Read(), contents are '%+v'", redactBytes(msg.Buf)))
 	}
 	if msg.iStreamCurPos > msg.BufLen {
 		logger.Error(fmt.Sprint("ERROR: Returning ProtocolDataInputStream::Read as msg.CurPos > msg.BufLen"))
@@ -582,7 +591,7 @@ func (msg *ProtocolDataInputStream) ReadIntoBuffer(b []byte) (int, tgdb.TGError)
 // ReadAtOffset is similar to readFully.
 func (msg *ProtocolDataInputStream) ReadAtOffset(b []byte, off int, length int) (int, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::ReadAtOffset('%d') for length '%d' from '%+v', contents are '%+v'", off, length, b, msg.Buf))
+		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::ReadAtOffset('%d') for length '%d' from '%+v', contents are '%+v'", off, length, redactBytes(b), redactBytes(msg.Buf)))
 	}
 	if b == nil {
 		logger.Error(fmt.Sprint("ERROR: Returning ProtocolDataInputStream::ReadAtOffset as b == nil"))
@@ -608,12 +617,8 @@ func (msg *ProtocolDataInputStream) ReadAtOffset(b []byte, off int, length int)
 		}
 		return 0, nil
 	}
-	// Copy from input buffer b at offset for length
-	// Create a slice of input buffer
-	temp := b[off:] // Slice starting at offset 'off' of input buffer 'b'
-	tempLen := len(temp) - length
-	temp = temp[:tempLen]
-	copy(temp, msg.Buf)
+	// Copy into input buffer b at offset for length from the current stream position
+	copy(b[off:off+length], msg.Buf[msg.iStreamCurPos:msg.iStreamCurPos+length])
 	msg.iStreamCurPos = msg.iStreamCurPos + length
 	if logger.IsDebug() {
 		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::ReadAtOffset('%d') for length '%d' from the contents w/ ('%d')", off, length, b))
@@ -625,7 +630,7 @@ func (msg *ProtocolDataInputStream) ReadAtOffset(b []byte, off int, length int)
 // This is equivalent to do a readInt, and read(byte[])
 func (msg *ProtocolDataInputStream) ReadBytes() ([]byte, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::ReadBytes(), contents are '%+v'", msg.Buf))
+		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::ReadBytes(), contents are '%+v'", redactBytes(msg.Buf)))
 	}
 	emptyByteArray := make([]byte, 0)
 	length, err := msg.ReadInt()
@@ -647,7 +652,7 @@ func (msg *ProtocolDataInputStream) ReadBytes() ([]byte, tgdb.TGError) {
 		return nil, err
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::ReadBytes from the contents w/ ('%+v')", buf))
+		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::ReadBytes from the contents w/ ('%+v')", redactBytes(buf)))
 	}
 	return buf, nil
 }
@@ -655,7 +660,7 @@ func (msg *ProtocolDataInputStream) ReadBytes() ([]byte, tgdb.TGError) {
 // ReadVarLong reads a Variable long field
 func (msg *ProtocolDataInputStream) ReadVarLong() (int64, tgdb.TGError) {
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::ReadVarLong(), contents are '%+v'", msg.Buf))
+		logger.Debug(fmt.Sprintf("Entering ProtocolDataInputStream::ReadVarLong(), contents are '%+v'", redactBytes(msg.Buf)))
 	}
 	if msg.iStreamCurPos >= msg.BufLen {
 		logger.Error(fmt.Sprint("ERROR: Returning ProtocolDataInputStream::ReadVarLong as msg.CurPos >= msg.BufLen"))
@@ -743,7 +748,7 @@ func (msg *ProtocolDataInputStream) Reset() {
 	}
 	msg.iStreamCurPos = msg.mark
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::Reset( Marker = '%d'), contents are '%+v'", msg.mark, msg.Buf))
+		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::Reset( Marker = '%d'), contents are '%+v'", msg.mark, redactBytes(msg.Buf)))
 	}
 }
 
@@ -755,7 +760,7 @@ func (msg *ProtocolDataInputStream) SetPosition(position int64) int64 {
 	oldPos := msg.iStreamCurPos
 	msg.iStreamCurPos = int(position)
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::SetPosition('%d'), contents are '%+v'", int64(oldPos), msg.Buf))
+		logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::SetPosition('%d'), contents are '%+v'", int64(oldPos), redactBytes(msg.Buf)))
 	}
 	return int64(oldPos)
 }
@@ -772,7 +777,7 @@ func (msg *ProtocolDataInputStream) Skip(n int64) (int64, tgdb.TGError) {
 	}
 	a, err := msg.SkipBytes(int(n))
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::Skip('%d'), contents are '%+v'", int64(a), msg.Buf))
+			logger.Debug(fmt.Sprintf("Returning ProtocolDataInputStream::Skip('%d'), contents are '%+v'", int64(a), redactBytes(msg.Buf)))
 	}
 	return int64(a), err
 }
@@ -846,10 +851,9 @@ func (msg *ProtocolDataOutputStream) String() string {
 	buffer.WriteString("ProtocolDataOutputStream:{")
 	buffer.WriteString(fmt.Sprintf("Buffer Length: %d", msg.oStreamBufLen))
 	buffer.WriteString(fmt.Sprintf(", Count: %d", msg.oStreamByteCount))
-	buffer.WriteString(fmt.Sprintf(", Buffer: "))
-	strArray := []string{buffer.String(), bytes.NewBuffer(msg.Buf).String()+"}"}
-	msgStr := strings.Join(strArray, ", ")
-	return  msgStr
+	buffer.WriteString(fmt.Sprintf(", Buffer: %+v", redactBytes(msg.Buf)))
+	buffer.WriteString("}")
+	return buffer.String()
 }
 
 func (msg *ProtocolDataOutputStream) WriteBoolean(value bool) {
@@ -969,7 +973,7 @@ func (msg *ProtocolDataOutputStream) WriteShort(value int)  {
 
 func (msg *ProtocolDataOutputStream) WriteBytesFromPos(value []byte, writePos, writeLen int) tgdb.TGError {
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Entering ProtocolDataOutputStream::WriteBytesFromPos('%d') for len '%d' from '%+v' in contents", writePos, writeLen, value))
+			logger.Debug(fmt.Sprintf("Entering ProtocolDataOutputStream::WriteBytesFromPos('%d') for len '%d' from '%+v' in contents", writePos, writeLen, redactBytes(value)))
 	}
 	if value == nil {
 		logger.Error(fmt.Sprintf("ERROR: Returning ProtocolDataOutputStream:WriteBytesFromPos - invalid byte value specified to be written at pos %d", writePos))
@@ -989,7 +993,7 @@ func (msg *ProtocolDataOutputStream) WriteBytesFromPos(value []byte, writePos, w
 	copy(msg.Buf[msg.oStreamByteCount:], tempBuf[:writeLen])
 	msg.oStreamByteCount += writeLen
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteBytesFromPos(''%d') for len '%d' from '%+v' in contents '%+v'", writePos, writeLen, value, msg.Buf))
+			logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteBytesFromPos(''%d') for len '%d' from '%+v' in contents '%+v'", writePos, writeLen, redactBytes(value), redactBytes(msg.Buf)))
 	}
 	return nil
 }
@@ -1053,10 +1057,7 @@ func (msg *ProtocolDataOutputStream) SkipNBytes(n int) {
 // ToByteArray returns a new constructed byte array of the data that is being streamed.
 func (msg *ProtocolDataOutputStream) ToByteArray() ([]byte, tgdb.TGError) {
 	buf := make([]byte, msg.oStreamByteCount)
-	_, err := io.Copy(bytes.NewBuffer(buf), bytes.NewBuffer(msg.Buf))
-	if err != nil {
-		return nil, GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, err.Error(), "")
-	}
+	copy(buf, msg.Buf[:msg.oStreamByteCount])
 	return buf, nil
 }
 
@@ -1338,7 +1339,7 @@ func (msg *ProtocolDataOutputStream) WriteVarLong(value int64) tgdb.TGError {
 		msg.Buf[msg.oStreamByteCount] = U64PACKED_NULL
 		msg.oStreamByteCount++
 		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, msg.Buf))
+					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, redactBytes(msg.Buf)))
 		}
 		return nil
 	}
@@ -1350,7 +1351,7 @@ func (msg *ProtocolDataOutputStream) WriteVarLong(value int64) tgdb.TGError {
 		msg.Buf[msg.oStreamByteCount] = byte(value)
 		msg.oStreamByteCount++
 		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, msg.Buf))
+					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, redactBytes(msg.Buf)))
 		}
 		return nil
 	}
@@ -1365,7 +1366,7 @@ func (msg *ProtocolDataOutputStream) WriteVarLong(value int64) tgdb.TGError {
 		msg.Buf[msg.oStreamByteCount] = byte(value)
 		msg.oStreamByteCount++
 		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, msg.Buf))
+					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, redactBytes(msg.Buf)))
 		}
 		return nil
 	}
@@ -1381,7 +1382,7 @@ func (msg *ProtocolDataOutputStream) WriteVarLong(value int64) tgdb.TGError {
 			value = value >> 8
 		}
 		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, msg.Buf))
+					logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, redactBytes(msg.Buf)))
 		}
 		return nil
 	}
@@ -1412,7 +1413,7 @@ func (msg *ProtocolDataOutputStream) WriteVarLong(value int64) tgdb.TGError {
 		value = value >> 8
 	}
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, msg.Buf))
+			logger.Debug(fmt.Sprintf("Returning ProtocolDataOutputStream::WriteVarLong('%+v') in contents are '%+v'", value, redactBytes(msg.Buf)))
 	}
 	return nil
 }
@@ -1742,7 +1743,7 @@ func (msg *AbstractProtocolMessage) APMMessageToString() string {
 	buffer.WriteString(fmt.Sprintf(", SequenceNo: %d", msg.HeaderGetSequenceNo()))
 	buffer.WriteString(fmt.Sprintf(", Timestamp: %d", msg.HeaderGetTimestamp()))
 	buffer.WriteString(fmt.Sprintf(", RequestId: %d", msg.HeaderGetRequestId()))
-	buffer.WriteString(fmt.Sprintf(", AuthToken: %d", msg.HeaderGetAuthToken()))
+	buffer.WriteString(fmt.Sprintf(", AuthToken: %s", redactToken(msg.HeaderGetAuthToken())))
 	buffer.WriteString(fmt.Sprintf(", SessionId: %d", msg.HeaderGetSessionId()))
 	buffer.WriteString(fmt.Sprintf(", DataOffset: %d", msg.HeaderGetDataOffset()))
 	buffer.WriteString(fmt.Sprintf(", IsUpdatable: %+v", msg.GetIsUpdatable()))
@@ -1833,7 +1834,7 @@ func APMReadHeader(msg tgdb.TGMessage, is tgdb.TGInputStream) tgdb.TGError {
 		return err
 	}
 	if logger.IsDebug() {
-		logger.Debug(fmt.Sprintf("Inside AbstractProtocolMessage:APMReadHeader read AuthToken as '%d'", authToken))
+		logger.Debug(fmt.Sprintf("Inside AbstractProtocolMessage:APMReadHeader read AuthToken as '%s'", redactToken(authToken)))
 	}
 
 	sessionId, err := is.(*ProtocolDataInputStream).ReadLong()
@@ -2498,7 +2499,7 @@ func (msg *AuthenticatedMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError
 		return err
 	}
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AuthenticatedMessage:ReadPayload read authToken as '%+v'", authToken))
+			logger.Debug(fmt.Sprintf("Inside AuthenticatedMessage:ReadPayload read authToken as '%s'", redactToken(authToken)))
 	}
 
 	sessionId, err := is.(*ProtocolDataInputStream).ReadLong()
@@ -2819,7 +2820,7 @@ func (msg *DisconnectChannelRequestMessage) ReadPayload(is tgdb.TGInputStream) t
 		return err
 	}
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("DisconnectChannelRequestMessage:ReadPayload read authToken as '%+v'", authToken))
+			logger.Debug(fmt.Sprintf("DisconnectChannelRequestMessage:ReadPayload read authToken as '%s'", redactToken(authToken)))
 	}
 
 	sessionId, err := is.(*ProtocolDataInputStream).ReadLong()
@@ -3152,7 +3153,7 @@ func (msg *AuthenticateRequestMessage) String() string {
 	buffer.WriteString(fmt.Sprintf("ClientId: %s", msg.clientId))
 	buffer.WriteString(fmt.Sprintf(", InboxAddr: %s", msg.inboxAddr))
 	buffer.WriteString(fmt.Sprintf(", UserName: %s", msg.userName))
-	buffer.WriteString(fmt.Sprintf(", Password: %s", string(msg.password)))
+	buffer.WriteString(fmt.Sprintf(", Password: %s", redactSecret(msg.password)))
 	buffer.WriteString(fmt.Sprintf(", BufLength: %d", msg.BufLength))
 	strArray := []string{buffer.String(), msg.APMMessageToString()+"}"}
 	msgStr := strings.Join(strArray, ", ")
@@ -3179,66 +3180,63 @@ func (msg *AuthenticateRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.
 // ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
 func (msg *AuthenticateRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprint("Entering AuthenticateRequestMessage:ReadPayload"))
+		logger.Debug(fmt.Sprint("Entering AuthenticateRequestMessage:ReadPayload"))
 	}
-	// For Testing purpose only.
-	bIsClientId, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	// For Testing purpose only - mirrors WritePayload so that a mock server can decode the request
+	dbName, err := readNullableUTF(is)
 	if err != nil {
-		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading clientId flag from message buffer"))
+		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading dbName from message buffer"))
 		return err
 	}
-	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AuthenticateRequestMessage:ReadPayload read bIsClientId as '%+v'", bIsClientId))
-	}
-	strClient := ""
-	if ! bIsClientId {
-		strClient, err = is.(*ProtocolDataInputStream).ReadUTF()
-		if err != nil {
-			logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading clientId from message buffer"))
-			return err
-		}
-		if logger.IsDebug() {
-					logger.Debug(fmt.Sprintf("Inside AuthenticateRequestMessage:ReadPayload read strClient as '%+v'", strClient))
-		}
+	strClient, err := readNullableUTF(is)
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading clientId from message buffer"))
+		return err
 	}
-
-	inboxAddr, err := is.(*ProtocolDataInputStream).ReadUTF()
+	inboxAddr, err := readNullableUTF(is)
 	if err != nil {
-		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading inboxAddr flag from message buffer"))
+		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading inboxAddr from message buffer"))
 		return err
 	}
-	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AuthenticateRequestMessage:ReadPayload read inboxAddr as '%+v'", inboxAddr))
+	_, err = is.(*ProtocolDataInputStream).ReadInt() // roles
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading roles from message buffer"))
+		return err
 	}
-
-	userName, err := is.(*ProtocolDataInputStream).ReadUTF()
+	userName, err := readNullableUTF(is)
 	if err != nil {
 		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading username from message buffer"))
 		return err
 	}
-	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AuthenticateRequestMessage:ReadPayload read userName as '%+v'", userName))
-	}
-
 	password, err := is.(*ProtocolDataInputStream).ReadBytes()
 	if err != nil {
 		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateRequestMessage:ReadPayload w/ Error in reading password from message buffer"))
 		return err
 	}
-	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("Inside AuthenticateRequestMessage:ReadPayload read password as '%+v'", password))
-	}
 
+	msg.SetDatabaseName(dbName)
 	msg.SetClientId(strClient)
 	msg.SetInboxAddr(inboxAddr)
 	msg.SetUserName(userName)
 	msg.SetPassword(password)
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprint("Returning AuthenticateRequestMessage:ReadPayload"))
+		logger.Debug(fmt.Sprintf("Returning AuthenticateRequestMessage:ReadPayload for user '%s'", userName))
 	}
 	return nil
 }
 
+// readNullableUTF reads a string preceded by the is-null flag that WritePayload methods emit for optional strings
+func readNullableUTF(is tgdb.TGInputStream) (string, tgdb.TGError) {
+	isNull, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	if err != nil {
+		return "", err
+	}
+	if isNull {
+		return "", nil
+	}
+	return is.(*ProtocolDataInputStream).ReadUTF()
+}
+
 
 //  SetDatabaseName sets the database-name on request message
 func (msg *AuthenticateRequestMessage) SetDatabaseName (dbname string) {
@@ -3392,6 +3390,10 @@ func NewAuthenticateResponseMessage(authToken, sessionId int64) *AuthenticateRes
 // Helper functions for AuthenticateResponseMessage
 /////////////////////////////////////////////////////////////////
 
+func (msg *AuthenticateResponseMessage) GetErrorStatus() int {
+	return msg.errorStatus
+}
+
 func (msg *AuthenticateResponseMessage) GetServerCertBuffer() []byte {
 	return msg.serverCertBuffer
 }
@@ -3654,7 +3656,7 @@ func (msg *AuthenticateResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.
 		return err
 	}
 	if logger.IsDebug() {
-			logger.Debug(fmt.Sprintf("AuthenticateResponseMessage:ReadPayload read authToken as '%+v'", authToken))
+			logger.Debug(fmt.Sprintf("AuthenticateResponseMessage:ReadPayload read authToken as '%s'", redactToken(authToken)))
 	}
 
 	sessionId, err := is.(*ProtocolDataInputStream).ReadLong()
@@ -3692,8 +3694,16 @@ func (msg *AuthenticateResponseMessage) WritePayload(os tgdb.TGOutputStream) tgd
 			logger.Debug(fmt.Sprintf("Entering AuthenticateResponseMessage:WritePayload at output buffer position: '%d'", startPos))
 	}
 	os.(*ProtocolDataOutputStream).WriteBoolean(msg.IsSuccess())
+	if !msg.IsSuccess() {
+		os.(*ProtocolDataOutputStream).WriteInt(msg.GetErrorStatus())
+	}
 	os.(*ProtocolDataOutputStream).WriteLong(msg.GetAuthToken())
 	os.(*ProtocolDataOutputStream).WriteLong(msg.GetSessionId())
+	err := os.(*ProtocolDataOutputStream).WriteBytes(msg.GetServerCertBuffer())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning AuthenticateResponseMessage:WritePayload w/ Error in writing certBuffer to message buffer"))
+		return err
+	}
 	currPos := os.GetPosition()
 	length := currPos - startPos
 	if logger.IsDebug() {
@@ -4344,6 +4354,7 @@ type CommitTransactionRequest struct {
 	updatedList map[int64]tgdb.TGEntity
 	removedList map[int64]tgdb.TGEntity
 	attrDescSet []tgdb.TGAttributeDescriptor
+	entityStream tgdb.TGInputStream
 }
 
 func DefaultCommitTransactionRequestMessage() *CommitTransactionRequest {
@@ -4639,11 +4650,27 @@ func (msg *CommitTransactionRequest) WriteHeader(os tgdb.TGOutputStream) tgdb.TG
 
 // ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
 func (msg *CommitTransactionRequest) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
-	//Commit response need to send back real id for all entities and descriptors.
-	// No-op for Now
+	// For Testing purpose only - the sections that follow reference attribute descriptors and entity types
+	// by id, so they are left on the stream for a reader that holds the graph metadata, see GetEntityStream.
+	_, err := is.(*ProtocolDataInputStream).ReadInt() // commit buffer length
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning CommitTransactionRequest:ReadPayload w/ Error in reading buffer length from message buffer"))
+		return err
+	}
+	_, err = is.(*ProtocolDataInputStream).ReadInt() // checksum
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning CommitTransactionRequest:ReadPayload w/ Error in reading checksum from message buffer"))
+		return err
+	}
+	msg.entityStream = is
 	return nil
 }
 
+// GetEntityStream returns the stream positioned at the first section of a request read by ReadPayload
+func (msg *CommitTransactionRequest) GetEntityStream() tgdb.TGInputStream {
+	return msg.entityStream
+}
+
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *CommitTransactionRequest) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
 	startPos := os.GetPosition()
@@ -4785,7 +4812,7 @@ type CommitTransactionResponse struct {
 	attrDescIdList []int64
 	attrDescCount  int
 	graphObjFact   tgdb.TGGraphObjectFactory
-	exception      *TransactionException
+	exception      tgdb.TGTransactionError
 	entityStream   tgdb.TGInputStream
 }
 
@@ -4827,7 +4854,7 @@ func NewCommitTransactionResponseMessage(authToken, sessionId int64) *CommitTran
 // Helper functions for VerbCommitTransactionResponse message
 /////////////////////////////////////////////////////////////////
 
-func ProcessTransactionStatus(is tgdb.TGInputStream, status int) *TransactionException {
+func ProcessTransactionStatus(is tgdb.TGInputStream, status int) tgdb.TGTransactionError {
 	txnStatus := tgdb.FromStatus(status)
 	if logger.IsDebug() {
 			logger.Debug(fmt.Sprintf("Entering CommitTransactionResponse:ProcessTransactionStatus read txnStatus as '%d'", txnStatus))
@@ -4912,7 +4939,7 @@ func (msg *CommitTransactionResponse) HasException() bool {
 	return msg.exception != nil
 }
 
-func (msg *CommitTransactionResponse) GetException() *TransactionException {
+func (msg *CommitTransactionResponse) GetException() tgdb.TGTransactionError {
 	return msg.exception
 }
 
@@ -4948,6 +4975,10 @@ func (msg *CommitTransactionResponse) SetRemovedIdList(list []int64) {
 	msg.removedIdList = list
 }
 
+func (msg *CommitTransactionResponse) SetException(txnErr tgdb.TGTransactionError) {
+	msg.exception = txnErr
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGMessage
 /////////////////////////////////////////////////////////////////
@@ -5218,8 +5249,11 @@ func (msg *CommitTransactionResponse) ReadPayload(is tgdb.TGInputStream) tgdb.TG
 	}
 	txnException := ProcessTransactionStatus(is, status)
 	if txnException != nil {
+		// Hand the exception to the waiting committer rather than failing the read, which would
+		// tear down the channel for what is only a rejected transaction
 		logger.Error(fmt.Sprint("ERROR: Returning CommitTransactionResponse:ReadPayload w/ txnException"))
-		return txnException
+		msg.exception = txnException
+		return nil
 	}
 
 	for {
@@ -5365,7 +5399,50 @@ func (msg *CommitTransactionResponse) ReadPayload(is tgdb.TGInputStream) tgdb.TG
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *CommitTransactionResponse) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	// No-op for Now
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering CommitTransactionResponse:WritePayload at output buffer position: '%d'", startPos))
+	}
+	// Client never writes out the response - this mirrors ReadPayload for a mock server
+	os.(*ProtocolDataOutputStream).WriteInt(0) // buf length
+	os.(*ProtocolDataOutputStream).WriteInt(0) // checksum
+	if msg.exception != nil {
+		os.(*ProtocolDataOutputStream).WriteInt(int(msg.exception.GetTransactionStatus()))
+		return os.(*ProtocolDataOutputStream).WriteUTF(msg.exception.GetErrorMsg())
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(int(tgdb.TGTransactionSuccess))
+	if len(msg.attrDescIdList) > 0 {
+		os.(*ProtocolDataOutputStream).WriteShort(0x1010)
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.attrDescIdList) / 2)
+		for _, id := range msg.attrDescIdList {
+			os.(*ProtocolDataOutputStream).WriteInt(int(id)) // tempId, realId pairs
+		}
+	}
+	if len(msg.addedIdList) > 0 {
+		os.(*ProtocolDataOutputStream).WriteShort(0x1011)
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.addedIdList) / 3)
+		for _, id := range msg.addedIdList {
+			os.(*ProtocolDataOutputStream).WriteLong(id) // tempId, realId, version triples
+		}
+	}
+	if len(msg.updatedIdList) > 0 {
+		os.(*ProtocolDataOutputStream).WriteShort(0x1012)
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.updatedIdList) / 2)
+		for _, id := range msg.updatedIdList {
+			os.(*ProtocolDataOutputStream).WriteLong(id) // id, version pairs
+		}
+	}
+	if len(msg.removedIdList) > 0 {
+		os.(*ProtocolDataOutputStream).WriteShort(0x1013)
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.removedIdList))
+		for _, id := range msg.removedIdList {
+			os.(*ProtocolDataOutputStream).WriteLong(id)
+		}
+	}
+	currPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning CommitTransactionResponse::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, currPos-startPos))
+	}
 	return nil
 }
 
@@ -6617,7 +6694,16 @@ func (msg *ExceptionMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *ExceptionMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	os.(*ProtocolDataOutputStream).WriteByte(msg.GetExceptionType())
+	// Mirrors ReadPayload - the server code, then the optional message when the code is set
+	os.(*ProtocolDataOutputStream).WriteInt(msg.GetServerErrorCode())
+	if msg.GetServerErrorCode() == 0 {
+		return nil
+	}
+	if msg.GetExceptionMsg() == "" {
+		os.(*ProtocolDataOutputStream).WriteBoolean(true)
+		return nil
+	}
+	os.(*ProtocolDataOutputStream).WriteBoolean(false)
 	return os.(*ProtocolDataOutputStream).WriteUTF(msg.GetExceptionMsg())
 }
 
@@ -6665,6 +6751,7 @@ type GetEntityRequestMessage struct {
 	edgeLimit      int
 	resultId       int
 	key            tgdb.TGKey
+	keyStream      tgdb.TGInputStream
 }
 
 func DefaultGetEntityRequestMessage() *GetEntityRequestMessage {
@@ -6755,6 +6842,12 @@ func (msg *GetEntityRequestMessage) SetFetchSize(size int) {
 	}
 }
 
+// GetKeyStream returns the stream positioned at the key of a request read by ReadPayload. Decoding the key needs
+// the graph metadata of the reader, see CompositeKey.ReadExternal.
+func (msg *GetEntityRequestMessage) GetKeyStream() tgdb.TGInputStream {
+	return msg.keyStream
+}
+
 func (msg *GetEntityRequestMessage) SetKey(key tgdb.TGKey) {
 	msg.key = key
 }
@@ -6986,7 +7079,48 @@ func (msg *GetEntityRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGE
 
 // ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
 func (msg *GetEntityRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
-	// No-op for Now
+	// For Testing purpose only - mirrors WritePayload so that a mock server can decode the request
+	command, err := is.(*ProtocolDataInputStream).ReadShort()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning GetEntityRequestMessage:ReadPayload w/ Error in reading command from message buffer"))
+		return err
+	}
+	resultId, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		return err
+	}
+	msg.SetCommand(command)
+	msg.SetResultId(resultId)
+	if command == 0 || command == 1 || command == 2 {
+		fetchSize, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			return err
+		}
+		batchSize, err := is.(*ProtocolDataInputStream).ReadShort()
+		if err != nil {
+			return err
+		}
+		traversalDepth, err := is.(*ProtocolDataInputStream).ReadShort()
+		if err != nil {
+			return err
+		}
+		edgeLimit, err := is.(*ProtocolDataInputStream).ReadShort()
+		if err != nil {
+			return err
+		}
+		_, err = is.(*ProtocolDataInputStream).ReadInt() // key length
+		if err != nil {
+			return err
+		}
+		msg.SetFetchSize(fetchSize)
+		msg.SetBatchSize(int(batchSize))
+		msg.SetTraversalDepth(int(traversalDepth))
+		msg.SetEdgeLimit(int(edgeLimit))
+		msg.keyStream = is
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning GetEntityRequestMessage:ReadPayload w/ command '%d'", command))
+	}
 	return nil
 }
 
@@ -7066,6 +7200,7 @@ func (msg *GetEntityRequestMessage) UnmarshalBinary(data []byte) error {
 type GetEntityResponseMessage struct {
 	*AbstractProtocolMessage
 	entityStream tgdb.TGInputStream
+	entities     []tgdb.TGEntity
 	hasResult    bool
 	resultId     int
 	totalCount   int
@@ -7143,6 +7278,11 @@ func (msg *GetEntityResponseMessage) SetEntityStream(eStream tgdb.TGInputStream)
 	msg.entityStream = eStream
 }
 
+// SetEntities sets the entities WritePayload streams out after the result id
+func (msg *GetEntityResponseMessage) SetEntities(entities []tgdb.TGEntity) {
+	msg.entities = entities
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGMessage
 /////////////////////////////////////////////////////////////////
@@ -7410,7 +7550,26 @@ func (msg *GetEntityResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGE
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *GetEntityResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	// No-op for Now
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering GetEntityResponseMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	// Client never writes out the response - this mirrors ReadPayload for a mock server
+	os.(*ProtocolDataOutputStream).WriteInt(msg.GetResultId())
+	os.(*ProtocolDataOutputStream).WriteInt(len(msg.entities))
+	for _, entity := range msg.entities {
+		os.(*ProtocolDataOutputStream).WriteByte(int(entity.GetEntityKind()))
+		os.(*ProtocolDataOutputStream).WriteLong(entity.GetVirtualId())
+		err := entity.WriteExternal(os)
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning GetEntityResponseMessage:WritePayload w/ Error in writing entity to message buffer"))
+			return err
+		}
+	}
+	currPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning GetEntityResponseMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, currPos-startPos))
+	}
 	return nil
 }
 
@@ -8870,6 +9029,13 @@ func (msg *HandShakeResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.T
 	//This is purely for testing. Client never writes out the response.
 	os.(*ProtocolDataOutputStream).WriteByte(msg.GetResponseStatus())
 	os.(*ProtocolDataOutputStream).WriteLong(msg.GetChallenge())
+	if msg.GetResponseStatus() == ResponseChallengeFailed {
+		err := os.(*ProtocolDataOutputStream).WriteBytesFromString(msg.GetErrorMessage())
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning HandShakeResponseMessage:WritePayload w/ Error in writing errMsgBytes to message buffer"))
+			return err
+		}
+	}
 	currPos := os.GetPosition()
 	length := currPos - startPos
 	if logger.IsDebug() {
@@ -9527,6 +9693,18 @@ func (msg *MetadataResponse) GetEdgeTypeList() []tgdb.TGEdgeType {
 	return msg.edgeTypeList
 }
 
+func (msg *MetadataResponse) SetAttrDescList(attrDescs []tgdb.TGAttributeDescriptor) {
+	msg.attrDescList = attrDescs
+}
+
+func (msg *MetadataResponse) SetNodeTypeList(nodeTypes []tgdb.TGNodeType) {
+	msg.nodeTypeList = nodeTypes
+}
+
+func (msg *MetadataResponse) SetEdgeTypeList(edgeTypes []tgdb.TGEdgeType) {
+	msg.edgeTypeList = edgeTypes
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGMessage
 /////////////////////////////////////////////////////////////////
@@ -9867,7 +10045,50 @@ func (msg *MetadataResponse) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *MetadataResponse) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	// No-Op for Now
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering MetadataResponse:WritePayload at output buffer position: '%d'", startPos))
+	}
+	// Client never writes out the response - this mirrors ReadPayload for a mock server.
+	// Empty groups are left out as ReadPayload counts down by the type count of each group.
+	os.(*ProtocolDataOutputStream).WriteInt(len(msg.attrDescList) + len(msg.nodeTypeList) + len(msg.edgeTypeList))
+	if len(msg.attrDescList) > 0 {
+		os.(*ProtocolDataOutputStream).WriteByte(int(tgdb.SystemTypeAttributeDescriptor))
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.attrDescList))
+		for _, attrDesc := range msg.attrDescList {
+			err := attrDesc.WriteExternal(os)
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning MetadataResponse:WritePayload w/ Error in writing AttrDesc to message buffer"))
+				return err
+			}
+		}
+	}
+	if len(msg.nodeTypeList) > 0 {
+		os.(*ProtocolDataOutputStream).WriteByte(int(tgdb.SystemTypeNode))
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.nodeTypeList))
+		for _, nodeType := range msg.nodeTypeList {
+			err := nodeType.WriteExternal(os)
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning MetadataResponse:WritePayload w/ Error in writing nodeType to message buffer"))
+				return err
+			}
+		}
+	}
+	if len(msg.edgeTypeList) > 0 {
+		os.(*ProtocolDataOutputStream).WriteByte(int(tgdb.SystemTypeEdge))
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.edgeTypeList))
+		for _, edgeType := range msg.edgeTypeList {
+			err := edgeType.WriteExternal(os)
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning MetadataResponse:WritePayload w/ Error in writing edgeType to message buffer"))
+				return err
+			}
+		}
+	}
+	currPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning MetadataResponse::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, currPos-startPos))
+	}
 	return nil
 }
 
@@ -10263,6 +10484,24 @@ func CreateMessageForVerb(verbId int) (tgdb.TGMessage, tgdb.TGError) {
 		return DefaultGetLargeObjectRequestMessage(), nil
 	case VerbGetLargeObjectResponse:
 		return DefaultGetLargeObjectResponseMessage(), nil
+	case VerbBeginExportRequest:
+		return DefaultBeginExportRequestMessage(), nil
+	case VerbBeginExportResponse:
+		return DefaultBeginExportResponseMessage(), nil
+	case VerbPartialExportRequest:
+		return DefaultPartialExportRequestMessage(), nil
+	case VerbPartialExportResponse:
+		return DefaultPartialExportResponseMessage(), nil
+	case VerbCancelExportRequest:
+		return DefaultCancelExportRequestMessage(), nil
+	case VerbBeginImportRequest:
+		return DefaultBeginImportRequestMessage(), nil
+	case VerbBeginImportResponse:
+		return DefaultBeginImportResponseMessage(), nil
+	case VerbPartialImportRequest:
+		return DefaultPartialImportRequestMessage(), nil
+	case VerbPartialImportResponse:
+		return DefaultPartialImportResponseMessage(), nil
 	case VerbDumpStacktraceRequest:
 		fallthrough
 		//return DefaultDumpStacktraceRequestMessage(), nil
@@ -10338,6 +10577,24 @@ func CreateMessageWithToken(verbId int, authToken, sessionId int64) (tgdb.TGMess
 		return NewGetLargeObjectRequestMessage(authToken, sessionId), nil
 	case VerbGetLargeObjectResponse:
 		return NewGetLargeObjectResponseMessage(authToken, sessionId), nil
+	case VerbBeginExportRequest:
+		return NewBeginExportRequestMessage(authToken, sessionId), nil
+	case VerbBeginExportResponse:
+		return NewBeginExportResponseMessage(authToken, sessionId), nil
+	case VerbPartialExportRequest:
+		return NewPartialExportRequestMessage(authToken, sessionId), nil
+	case VerbPartialExportResponse:
+		return NewPartialExportResponseMessage(authToken, sessionId), nil
+	case VerbCancelExportRequest:
+		return NewCancelExportRequestMessage(authToken, sessionId), nil
+	case VerbBeginImportRequest:
+		return NewBeginImportRequestMessage(authToken, sessionId), nil
+	case VerbBeginImportResponse:
+		return NewBeginImportResponseMessage(authToken, sessionId), nil
+	case VerbPartialImportRequest:
+		return NewPartialImportRequestMessage(authToken, sessionId), nil
+	case VerbPartialImportResponse:
+		return NewPartialImportResponseMessage(authToken, sessionId), nil
 	case VerbDumpStacktraceRequest:
 		fallthrough
 		//return NewDumpStacktraceRequestMessage(authToken, sessionId), nil
@@ -10996,7 +11253,91 @@ func (msg *QueryRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGError
 
 // ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
 func (msg *QueryRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
-	// No-Op for Now
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprint("Entering QueryRequestMessage:ReadPayload"))
+	}
+	// For Testing purpose only - mirrors WritePayload so that a mock server can decode the request
+	_, err := is.(*ProtocolDataInputStream).ReadInt() // datalength
+	if err != nil {
+		return err
+	}
+	_, err = is.(*ProtocolDataInputStream).ReadInt() // checksum
+	if err != nil {
+		return err
+	}
+	command, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning QueryRequestMessage:ReadPayload w/ Error in reading command from message buffer"))
+		return err
+	}
+	fetchSize, err := is.(*ProtocolDataInputStream).ReadInt()
+	if err != nil {
+		return err
+	}
+	batchSize, err := is.(*ProtocolDataInputStream).ReadShort()
+	if err != nil {
+		return err
+	}
+	traversalDepth, err := is.(*ProtocolDataInputStream).ReadShort()
+	if err != nil {
+		return err
+	}
+	edgeLimit, err := is.(*ProtocolDataInputStream).ReadShort()
+	if err != nil {
+		return err
+	}
+	hasSortAttr, err := is.(*ProtocolDataInputStream).ReadBoolean()
+	if err != nil {
+		return err
+	}
+	if hasSortAttr {
+		sortAttrName, err := is.(*ProtocolDataInputStream).ReadUTF()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning QueryRequestMessage:ReadPayload w/ Error in reading sortAttrName from message buffer"))
+			return err
+		}
+		sortOrderDsc, err := is.(*ProtocolDataInputStream).ReadBoolean()
+		if err != nil {
+			return err
+		}
+		sortResultLimit, err := is.(*ProtocolDataInputStream).ReadInt()
+		if err != nil {
+			return err
+		}
+		msg.SetSortAttrName(sortAttrName)
+		msg.SetSortOrderDsc(sortOrderDsc)
+		msg.SetSortResultLimit(sortResultLimit)
+	}
+
+	if command == 1 || command == 2 || command == 3 || command == 4 {
+		exprs := make([]string, 4)
+		for i := range exprs {
+			exprs[i], err = readNullableUTF(is)
+			if err != nil {
+				logger.Error(fmt.Sprint("ERROR: Returning QueryRequestMessage:ReadPayload w/ Error in reading query expressions from message buffer"))
+				return err
+			}
+		}
+		msg.SetQuery(exprs[0])
+		msg.SetEdgeFilter(exprs[1])
+		msg.SetTraversalCondition(exprs[2])
+		msg.SetEndCondition(exprs[3])
+	} else if command == 5 || command == 6 {
+		queryHashId, err := is.(*ProtocolDataInputStream).ReadLong()
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning QueryRequestMessage:ReadPayload w/ Error in reading queryHashId from message buffer"))
+			return err
+		}
+		msg.SetQueryHashId(queryHashId)
+	}
+	msg.SetCommand(command)
+	msg.SetFetchSize(fetchSize)
+	msg.SetBatchSize(int(batchSize))
+	msg.SetTraversalDepth(int(traversalDepth))
+	msg.SetEdgeLimit(int(edgeLimit))
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning QueryRequestMessage:ReadPayload w/ command '%d' and query '%s'", command, msg.GetQuery()))
+	}
 	return nil
 }
 
@@ -11125,6 +11466,8 @@ func (msg *QueryRequestMessage) UnmarshalBinary(data []byte) error {
 type QueryResponseMessage struct {
 	*AbstractProtocolMessage
 	entityStream tgdb.TGInputStream
+	entities     []tgdb.TGEntity
+	gremlinList  bool
 	hasResult    bool
 	totalCount   int
 	resultCount  int
@@ -11183,6 +11526,10 @@ func (msg *QueryResponseMessage) GetEntityStream() tgdb.TGInputStream {
 	return msg.entityStream
 }
 
+func (msg *QueryResponseMessage) GetException() tgdb.TGError {
+	return msg.exception
+}
+
 func (msg *QueryResponseMessage) GetHasResult() bool {
 	return msg.hasResult
 }
@@ -11222,6 +11569,21 @@ func (msg *QueryResponseMessage) SetTotalCount(count int) {
 func (msg *QueryResponseMessage) SetResultTypeAnnot(anon string) {
 	msg.resultTypeAnnot = anon
 }
+
+// SetEntities sets the entities WritePayload streams out after the result counts
+func (msg *QueryResponseMessage) SetEntities(entities []tgdb.TGEntity) {
+	msg.entities = entities
+}
+
+// SetGremlinEntities sets the entities WritePayload streams out as a gremlin result list
+func (msg *QueryResponseMessage) SetGremlinEntities(entities []tgdb.TGEntity) {
+	msg.entities = entities
+	msg.gremlinList = true
+}
+
+func (msg *QueryResponseMessage) SetException(err tgdb.TGError) {
+	msg.exception = err
+}
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGMessage
 /////////////////////////////////////////////////////////////////
@@ -11575,7 +11937,55 @@ func (msg *QueryResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *QueryResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	// No-Op for Now
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering QueryResponseMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	// Client never writes out the response - this mirrors ReadPayload for a mock server
+	os.(*ProtocolDataOutputStream).WriteInt(0) // buf length
+	os.(*ProtocolDataOutputStream).WriteInt(0) // checksum
+	os.(*ProtocolDataOutputStream).WriteInt(msg.GetResult())
+	os.(*ProtocolDataOutputStream).WriteLong(msg.GetQueryHashId())
+	os.(*ProtocolDataOutputStream).WriteByte(1) // syntax - total count follows the result count
+	if msg.GetResult() != 0 {
+		errMsg := ""
+		if msg.exception != nil {
+			errMsg = msg.exception.GetErrorMsg()
+		}
+		err := os.(*ProtocolDataOutputStream).WriteUTF(errMsg)
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning QueryResponseMessage:WritePayload w/ Error in writing error message to message buffer"))
+			return err
+		}
+	}
+	err := os.(*ProtocolDataOutputStream).WriteUTF(msg.GetResultTypeAnnot())
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning QueryResponseMessage:WritePayload w/ Error in writing annotation to message buffer"))
+		return err
+	}
+	os.(*ProtocolDataOutputStream).WriteInt(msg.GetResultCount())
+	os.(*ProtocolDataOutputStream).WriteInt(len(msg.entities))
+	if msg.gremlinList {
+		// Gremlin results are a collection - FillCollection reads a list of entity elements
+		os.(*ProtocolDataOutputStream).WriteByte(int(ElementTypeList))
+		os.(*ProtocolDataOutputStream).WriteInt(len(msg.entities))
+		os.(*ProtocolDataOutputStream).WriteByte(int(ElementTypeEntity))
+	}
+	for _, entity := range msg.entities {
+		os.(*ProtocolDataOutputStream).WriteByte(int(entity.GetEntityKind()))
+		if !msg.gremlinList {
+			os.(*ProtocolDataOutputStream).WriteLong(entity.GetVirtualId())
+		}
+		err := entity.WriteExternal(os)
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning QueryResponseMessage:WritePayload w/ Error in writing entity to message buffer"))
+			return err
+		}
+	}
+	currPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning QueryResponseMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, currPos-startPos))
+	}
 	return nil
 }
 
@@ -12493,6 +12903,16 @@ func (msg *SessionForcefullyTerminatedMessage) UnmarshalBinary(data []byte) erro
 
 type TraverseRequestMessage struct {
 	*AbstractProtocolMessage
+	direction   int
+	minDepth    int
+	maxDepth    int
+	uniqueness  int
+	resultShape int
+	edgeLimit   int
+	edgeTypes   []string
+	nodeFilter  string // TGQL expression every node stepped onto must match, empty for none
+	edgeFilter  string // TGQL expression every edge followed must match, empty for none
+	startIds    []int64
 }
 
 func DefaultTraverseRequestMessage() *TraverseRequestMessage {
@@ -12518,6 +12938,87 @@ func NewTraverseRequestMessage(authToken, sessionId int64) *TraverseRequestMessa
 	return newMsg
 }
 
+/////////////////////////////////////////////////////////////////
+// Helper functions for TraverseRequestMessage
+/////////////////////////////////////////////////////////////////
+
+func (msg *TraverseRequestMessage) GetDirection() int {
+	return msg.direction
+}
+
+func (msg *TraverseRequestMessage) GetMinDepth() int {
+	return msg.minDepth
+}
+
+func (msg *TraverseRequestMessage) GetMaxDepth() int {
+	return msg.maxDepth
+}
+
+func (msg *TraverseRequestMessage) GetUniqueness() int {
+	return msg.uniqueness
+}
+
+func (msg *TraverseRequestMessage) GetResultShape() int {
+	return msg.resultShape
+}
+
+func (msg *TraverseRequestMessage) GetEdgeLimit() int {
+	return msg.edgeLimit
+}
+
+func (msg *TraverseRequestMessage) GetEdgeTypes() []string {
+	return msg.edgeTypes
+}
+
+func (msg *TraverseRequestMessage) GetNodeFilter() string {
+	return msg.nodeFilter
+}
+
+func (msg *TraverseRequestMessage) GetEdgeFilter() string {
+	return msg.edgeFilter
+}
+
+func (msg *TraverseRequestMessage) GetStartIds() []int64 {
+	return msg.startIds
+}
+
+func (msg *TraverseRequestMessage) SetDirection(direction int) {
+	msg.direction = direction
+}
+
+func (msg *TraverseRequestMessage) SetDepth(minDepth, maxDepth int) {
+	msg.minDepth = minDepth
+	msg.maxDepth = maxDepth
+}
+
+func (msg *TraverseRequestMessage) SetUniqueness(uniqueness int) {
+	msg.uniqueness = uniqueness
+}
+
+func (msg *TraverseRequestMessage) SetResultShape(resultShape int) {
+	msg.resultShape = resultShape
+}
+
+func (msg *TraverseRequestMessage) SetEdgeLimit(edgeLimit int) {
+	msg.edgeLimit = edgeLimit
+}
+
+func (msg *TraverseRequestMessage) SetEdgeTypes(edgeTypes []string) {
+	msg.edgeTypes = edgeTypes
+}
+
+func (msg *TraverseRequestMessage) SetNodeFilter(expr string) {
+	msg.nodeFilter = expr
+}
+
+func (msg *TraverseRequestMessage) SetEdgeFilter(expr string) {
+	msg.edgeFilter = expr
+}
+
+func (msg *TraverseRequestMessage) SetStartIds(startIds []int64) {
+	msg.startIds = startIds
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGMessage
 /////////////////////////////////////////////////////////////////
@@ -12703,6 +13204,16 @@ func (msg *TraverseRequestMessage) String() string {
 	var buffer bytes.Buffer
 	buffer.WriteString("TraverseRequestMessage:{")
 	buffer.WriteString(fmt.Sprintf("BufLength: %d", msg.BufLength))
+	buffer.WriteString(fmt.Sprintf(", Direction: %d", msg.direction))
+	buffer.WriteString(fmt.Sprintf(", MinDepth: %d", msg.minDepth))
+	buffer.WriteString(fmt.Sprintf(", MaxDepth: %d", msg.maxDepth))
+	buffer.WriteString(fmt.Sprintf(", Uniqueness: %d", msg.uniqueness))
+	buffer.WriteString(fmt.Sprintf(", ResultShape: %d", msg.resultShape))
+	buffer.WriteString(fmt.Sprintf(", EdgeLimit: %d", msg.edgeLimit))
+	buffer.WriteString(fmt.Sprintf(", EdgeTypes: %+v", msg.edgeTypes))
+	buffer.WriteString(fmt.Sprintf(", NodeFilter: %s", msg.nodeFilter))
+	buffer.WriteString(fmt.Sprintf(", EdgeFilter: %s", msg.edgeFilter))
+	buffer.WriteString(fmt.Sprintf(", StartIds: %+v", msg.startIds))
 	strArray := []string{buffer.String(), msg.APMMessageToString()+"}"}
 	msgStr := strings.Join(strArray, ", ")
 	return  msgStr
@@ -12727,13 +13238,116 @@ func (msg *TraverseRequestMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGEr
 
 // ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
 func (msg *TraverseRequestMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
-	// No-Op for Now
+	// For Testing purpose only - mirrors WritePayload so that a mock server can decode the request
+	in := is.(*ProtocolDataInputStream)
+	direction, err := in.ReadByte()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning TraverseRequestMessage:ReadPayload w/ Error in reading direction from message buffer"))
+		return err
+	}
+	minDepth, err := in.ReadShort()
+	if err != nil {
+		return err
+	}
+	maxDepth, err := in.ReadShort()
+	if err != nil {
+		return err
+	}
+	uniqueness, err := in.ReadByte()
+	if err != nil {
+		return err
+	}
+	resultShape, err := in.ReadByte()
+	if err != nil {
+		return err
+	}
+	edgeLimit, err := in.ReadShort()
+	if err != nil {
+		return err
+	}
+	typeCount, err := in.ReadInt()
+	if err != nil {
+		return err
+	}
+	edgeTypes := make([]string, 0, typeCount)
+	for i := 0; i < typeCount; i++ {
+		edgeType, err := in.ReadUTF()
+		if err != nil {
+			return err
+		}
+		edgeTypes = append(edgeTypes, edgeType)
+	}
+	nodeFilter, err := in.ReadUTF()
+	if err != nil {
+		return err
+	}
+	edgeFilter, err := in.ReadUTF()
+	if err != nil {
+		return err
+	}
+	startCount, err := in.ReadInt()
+	if err != nil {
+		return err
+	}
+	startIds := make([]int64, 0, startCount)
+	for i := 0; i < startCount; i++ {
+		startId, err := in.ReadLong()
+		if err != nil {
+			return err
+		}
+		startIds = append(startIds, startId)
+	}
+	msg.SetDirection(int(direction))
+	msg.SetDepth(int(minDepth), int(maxDepth))
+	msg.SetUniqueness(int(uniqueness))
+	msg.SetResultShape(int(resultShape))
+	msg.SetEdgeLimit(int(edgeLimit))
+	msg.SetEdgeTypes(edgeTypes)
+	msg.SetNodeFilter(nodeFilter)
+	msg.SetEdgeFilter(edgeFilter)
+	msg.SetStartIds(startIds)
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TraverseRequestMessage:ReadPayload w/ '%d' starting point(s)", startCount))
+	}
 	return nil
 }
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *TraverseRequestMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	// No-Op for Now
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TraverseRequestMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	out := os.(*ProtocolDataOutputStream)
+	out.WriteByte(msg.GetDirection())
+	out.WriteShort(msg.GetMinDepth())
+	out.WriteShort(msg.GetMaxDepth())
+	out.WriteByte(msg.GetUniqueness())
+	out.WriteByte(msg.GetResultShape())
+	out.WriteShort(msg.GetEdgeLimit())
+	out.WriteInt(len(msg.GetEdgeTypes()))
+	for _, edgeType := range msg.GetEdgeTypes() {
+		if err := out.WriteUTF(edgeType); err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning TraverseRequestMessage:WritePayload w/ Error in writing edge type to message buffer"))
+			return err
+		}
+	}
+	if err := out.WriteUTF(msg.GetNodeFilter()); err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning TraverseRequestMessage:WritePayload w/ Error in writing node filter to message buffer"))
+		return err
+	}
+	if err := out.WriteUTF(msg.GetEdgeFilter()); err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning TraverseRequestMessage:WritePayload w/ Error in writing edge filter to message buffer"))
+		return err
+	}
+	out.WriteInt(len(msg.GetStartIds()))
+	for _, startId := range msg.GetStartIds() {
+		out.WriteLong(startId)
+	}
+	currPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TraverseRequestMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, currPos-startPos))
+	}
 	return nil
 }
 
@@ -12772,8 +13386,13 @@ func (msg *TraverseRequestMessage) UnmarshalBinary(data []byte) error {
 
 
 
+// TraverseResponseMessage carries the entities reached by a traversal, nodes before edges, followed by the
+// results as lists of entity ids: alternating node and edge ids for paths, a single id for nodes or edges
 type TraverseResponseMessage struct {
 	*AbstractProtocolMessage
+	entityStream tgdb.TGInputStream
+	entities     []tgdb.TGEntity
+	results      [][]int64
 }
 
 func DefaultTraverseResponseMessage() *TraverseResponseMessage {
@@ -12799,6 +13418,29 @@ func NewTraverseResponseMessage(authToken, sessionId int64) *TraverseResponseMes
 	return newMsg
 }
 
+/////////////////////////////////////////////////////////////////
+// Helper functions for TraverseResponseMessage
+/////////////////////////////////////////////////////////////////
+
+// GetEntityStream returns the stream positioned at the entities, for the connection to read them
+func (msg *TraverseResponseMessage) GetEntityStream() tgdb.TGInputStream {
+	return msg.entityStream
+}
+
+func (msg *TraverseResponseMessage) SetEntityStream(entityStream tgdb.TGInputStream) {
+	msg.entityStream = entityStream
+}
+
+// SetEntities sets the entities written out by WritePayload
+func (msg *TraverseResponseMessage) SetEntities(entities []tgdb.TGEntity) {
+	msg.entities = entities
+}
+
+// SetResults sets the results written out by WritePayload
+func (msg *TraverseResponseMessage) SetResults(results [][]int64) {
+	msg.results = results
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGMessage
 /////////////////////////////////////////////////////////////////
@@ -13008,13 +13650,52 @@ func (msg *TraverseResponseMessage) WriteHeader(os tgdb.TGOutputStream) tgdb.TGE
 
 // ReadPayload reads the bytes from input stream and constructs message specific payload Attributes
 func (msg *TraverseResponseMessage) ReadPayload(is tgdb.TGInputStream) tgdb.TGError {
-	// No-Op for Now
+	avail, err := is.(*ProtocolDataInputStream).Available()
+	if err != nil {
+		logger.Error(fmt.Sprint("ERROR: Returning TraverseResponseMessage:ReadPayload w/ Error in reading available bytes from message buffer"))
+		return err
+	}
+	if avail == 0 {
+		errMsg := fmt.Sprint("Traverse response has no data")
+		return GetErrorByType(TGErrorIOException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	// The entities refer to each other, so they are read by the connection that owns the graph object factory
+	msg.SetEntityStream(is)
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TraverseResponseMessage:ReadPayload w/ '%d' bytes available", avail))
+	}
 	return nil
 }
 
 // WritePayload exports the values of the message specific payload Attributes to output stream
 func (msg *TraverseResponseMessage) WritePayload(os tgdb.TGOutputStream) tgdb.TGError {
-	// No-Op for Now
+	startPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TraverseResponseMessage:WritePayload at output buffer position: '%d'", startPos))
+	}
+	// Client never writes out the response - this mirrors the connection's reading for a mock server
+	out := os.(*ProtocolDataOutputStream)
+	out.WriteInt(len(msg.entities))
+	for _, entity := range msg.entities {
+		out.WriteByte(int(entity.GetEntityKind()))
+		out.WriteLong(entity.GetVirtualId())
+		err := entity.WriteExternal(os)
+		if err != nil {
+			logger.Error(fmt.Sprint("ERROR: Returning TraverseResponseMessage:WritePayload w/ Error in writing entity to message buffer"))
+			return err
+		}
+	}
+	out.WriteInt(len(msg.results))
+	for _, result := range msg.results {
+		out.WriteInt(len(result))
+		for _, id := range result {
+			out.WriteLong(id)
+		}
+	}
+	currPos := os.GetPosition()
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TraverseResponseMessage::WritePayload at output buffer position at: %d after writing %d payload bytes", currPos, currPos-startPos))
+	}
 	return nil
 }
 
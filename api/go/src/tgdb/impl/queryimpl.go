@@ -31,6 +31,15 @@ import (
 	"time"
 )
 
+// resultCursor pulls the results of a get entities request that did not fit in the first batch from the server.
+// Query responses have no server cursor, so a query result set holds all its results, up to the prefetch size
+type resultCursor interface {
+	// fetchNext returns the next batch of results and whether the server has more after it
+	fetchNext() ([]interface{}, bool, tgdb.TGError)
+	// release frees the cursor on the server
+	release() tgdb.TGError
+}
+
 type ResultSet struct {
 	conn       tgdb.TGConnection
 	currPos    int
@@ -38,6 +47,9 @@ type ResultSet struct {
 	resultId   int
 	ResultList []interface{}
 	MetaData	tgdb.TGResultSetMetaData
+	exceptions []tgdb.TGError
+	cursor     resultCursor
+	batchStart int // Position of ResultList[0] once earlier batches have been released
 }
 
 func DefaultResultSet() *ResultSet {
@@ -90,6 +102,56 @@ func (obj *ResultSet) GetResults() []interface{} {
 	return obj.ResultList
 }
 
+// AddException records an error that happened while producing the results
+func (obj *ResultSet) AddException(err tgdb.TGError) {
+	obj.exceptions = append(obj.exceptions, err)
+}
+
+// IsStreaming checks whether more results are still to be fetched from the server
+func (obj *ResultSet) IsStreaming() bool {
+	return obj.cursor != nil
+}
+
+func (obj *ResultSet) setCursor(cursor resultCursor) {
+	obj.cursor = cursor
+}
+
+// fetchNextBatch replaces the buffered results with the next batch from the server cursor, so that only
+// one batch is held in memory at a time
+func (obj *ResultSet) fetchNextBatch() bool {
+	for obj.cursor != nil {
+		results, hasMore, err := obj.cursor.fetchNext()
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: ResultSet:fetchNextBatch - unable to fetch the next batch for result set '%d' w/ error: '%s'", obj.resultId, err.Error()))
+			obj.AddException(err)
+			obj.releaseCursor()
+			return false
+		}
+		if !hasMore {
+			// The server closes an exhausted cursor on its own
+			obj.cursor = nil
+		}
+		if len(results) > 0 {
+			obj.batchStart += len(obj.ResultList)
+			obj.ResultList = results
+			return true
+		}
+	}
+	return false
+}
+
+func (obj *ResultSet) releaseCursor() {
+	if obj.cursor == nil {
+		return
+	}
+	err := obj.cursor.release()
+	obj.cursor = nil
+	if err != nil {
+		logger.Warning(fmt.Sprintf("WARNING: ResultSet:releaseCursor - unable to release the server cursor for result set '%d' w/ error: '%s'", obj.resultId, err.Error()))
+		obj.AddException(err)
+	}
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> TGResultSet
 /////////////////////////////////////////////////////////////////
@@ -101,34 +163,41 @@ func (obj *ResultSet) AddEntityToResultSet(entity tgdb.TGEntity) tgdb.TGResultSe
 	return obj
 }
 
-// Close closes the result set
+// Close closes the result set and releases its cursor on the server if results are still pending
 func (obj *ResultSet) Close() tgdb.TGResultSet {
+	if obj.cursor != nil {
+		obj.releaseCursor()
+		obj.ResultList = make([]interface{}, 0)
+	}
 	obj.isOpen = false
 	return obj
 }
 
 // Count returns nos of entities returned by the query. The result set has a cursor which prefetches
 // "n" rows as per the query constraint. If the nos of entities returned by the query is less
-// than prefetch count, then all are returned.
+// than prefetch count, then all are returned. For a streaming result set this is the number of
+// entities fetched so far.
 func (obj *ResultSet) Count() int {
 	if obj.isOpen == false {
 		return 0
 	}
-	return len(obj.ResultList)
+	return obj.batchStart + len(obj.ResultList)
 }
 
-// First returns the first entity in the result set
+// First returns the first entity in the result set. It is no longer available once a streaming
+// result set has moved past its first batch.
 func (obj *ResultSet) First() interface{} {
 	if obj.isOpen == false {
 		return nil
 	}
-	if len(obj.ResultList) == 0 {
+	if len(obj.ResultList) == 0 || obj.batchStart > 0 {
 		return nil
 	}
 	return obj.ResultList[0]
 }
 
-// Last returns the last Entity in the result set
+// Last returns the last Entity in the result set, which is the last one of the current batch for a
+// streaming result set
 func (obj *ResultSet) Last() interface{} {
 	if obj.isOpen == false {
 		return nil
@@ -139,21 +208,22 @@ func (obj *ResultSet) Last() interface{} {
 	return obj.ResultList[len(obj.ResultList)-1]
 }
 
-// GetAt gets the entity at the position.
+// GetAt gets the entity at the position. Only positions in the current batch are available for a
+// streaming result set.
 func (obj *ResultSet) GetAt(position int) interface{} {
 	if obj.isOpen == false {
 		return nil
 	}
-	if position >= 0 && position < len(obj.ResultList) {
-		return obj.ResultList[position]
+	idx := position - obj.batchStart
+	if idx >= 0 && idx < len(obj.ResultList) {
+		return obj.ResultList[idx]
 	}
 	return nil
 }
 
 // GetExceptions gets the Exceptions in the result set
 func (obj *ResultSet) GetExceptions() []tgdb.TGError {
-	// TODO: Revisit later - No-Op for Now
-	return nil
+	return obj.exceptions
 }
 
 // GetPosition gets the Current cursor position. A result set upon creation is set to the position 0.
@@ -166,62 +236,57 @@ func (obj *ResultSet) GetPosition() int {
 
 // HasExceptions checks whether the result set has any exceptions
 func (obj *ResultSet) HasExceptions() bool {
-	// TODO: Revisit later - No-Op for Now
-	return false
+	return len(obj.exceptions) > 0
 }
 
-// HasNext Check whether there is next entry in result set
+// HasNext Check whether there is next entry in result set. A streaming result set fetches the next
+// batch from the server once the current one has been read.
 func (obj *ResultSet) HasNext() bool {
 	if obj.isOpen == false {
 		return false
 	}
-	if len(obj.ResultList) == 0 {
-		return false
-	} else if obj.currPos < (len(obj.ResultList)-1) {
+	if obj.currPos < (obj.batchStart+len(obj.ResultList)-1) {
 		return true
 	}
-	return false
+	return obj.fetchNextBatch()
 }
 
 // Next returns the next entity w.r.t to the current cursor position in the result set
 func (obj *ResultSet) Next() interface{} {
-	if obj.isOpen == false {
+	if !obj.HasNext() {
 		return nil
 	}
-	if len(obj.ResultList) == 0 {
-		return nil
-	} else if obj.currPos < (len(obj.ResultList)-1) {
-		obj.currPos++
-		return obj.ResultList[obj.currPos]
-	}
-	return nil
+	obj.currPos++
+	return obj.ResultList[obj.currPos-obj.batchStart]
 }
 
-// Skip skips a number of position
+// Prev returns the previous entity w.r.t to the current cursor position in the result set. A streaming
+// result set cannot move back past the start of its current batch.
 func (obj *ResultSet) Prev() interface{} {
 	if obj.isOpen == false {
 		return nil
 	}
-	if obj.currPos > 0 {
+	if obj.currPos > obj.batchStart {
 		obj.currPos--
-		return obj.ResultList[obj.currPos]
+		return obj.ResultList[obj.currPos-obj.batchStart]
 	}
 	return nil
 }
 
-// Skip skips a number of position
+// Skip skips a number of position within the current batch
 func (obj *ResultSet) Skip(position int) tgdb.TGResultSet {
 	if obj.isOpen == false {
 		return obj
 	}
 	newPos := obj.currPos + position
-	if newPos >= 0 && newPos < len(obj.ResultList) {
+	if newPos >= obj.batchStart && newPos < obj.batchStart+len(obj.ResultList) {
 		obj.currPos = newPos
 	}
 	return obj
 }
 
-// ToCollection converts the result set into a collection
+// ToCollection converts the result set into a collection. For a streaming result set only the current
+// batch is returned.
 func (obj *ResultSet) ToCollection() []interface{} {
 	return obj.ResultList
 }
@@ -841,6 +906,8 @@ func (obj *TGQueryOptionImpl) GetIsMutable() bool {
 	return obj.mutable
 }
 
+// Deprecated: SetUserAndPassword keeps the password in a credential provider on the option properties rather
+// than in a property
 func (obj *TGQueryOptionImpl) SetUserAndPassword(user, pwd string) tgdb.TGError {
 	return SetUserAndPassword(obj.optionProperties, user, pwd)
 }
@@ -0,0 +1,157 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: redactimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Secrets - passwords, auth tokens, the values of encrypted attributes and the raw protocol buffers that may
+// carry either - are masked in every log message and String() output, unless unsafe debug is switched on for
+// local troubleshooting, either with SetUnsafeDebug or by setting the environment variable TGDB_UNSAFE_DEBUG=true
+
+// RedactedValue is shown in place of a secret
+const RedactedValue = "********"
+
+// UnsafeDebugEnv is the environment variable that switches unsafe debug on at startup
+const UnsafeDebugEnv = "TGDB_UNSAFE_DEBUG"
+
+var unsafeDebug int32
+
+// Names of the properties, and of struct fields in %+v dumps, that are treated as secrets when they are not
+// predefined configurations
+var sensitiveNames = []string{"password", "passwd", "pwd", "passphrase", "secret", "token"}
+
+// Matches name=value and name: value pairs whose name looks sensitive, as written by %+v dumps and String()
+var sensitivePairPattern = regexp.MustCompile(`(?i)(\w*(?:password|passwd|pwd|passphrase|secret|token)\w*"?\s*[:=]\s*)('[^']*'|"[^"]*"|\[[^\]]*\]|[^\s,;'"}\])]+)`)
+
+// Matches the name=value properties of a channel URL, e.g. tcp://scott@localhost:8222/{dbName=demodb;password=x}
+var urlPropertyPattern = regexp.MustCompile(`([{;]\s*)([^=;{}]+)=([^;{}]*)`)
+
+func init() {
+	if enabled, err := strconv.ParseBool(os.Getenv(UnsafeDebugEnv)); err == nil && enabled {
+		atomic.StoreInt32(&unsafeDebug, 1)
+	}
+}
+
+// SetUnsafeDebug switches the masking of secrets in log messages and String() output off (true) or back on (false).
+// Only meant for troubleshooting on a local machine, as the logs then hold passwords and decrypted data
+func SetUnsafeDebug(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&unsafeDebug, 1)
+		logger.Warning(fmt.Sprint("WARNING: Unsafe debug is on - passwords, tokens and encrypted attribute values are written to the log"))
+	} else {
+		atomic.StoreInt32(&unsafeDebug, 0)
+	}
+}
+
+// IsUnsafeDebug checks whether secrets are shown in log messages and String() output
+func IsUnsafeDebug() bool {
+	return atomic.LoadInt32(&unsafeDebug) == 1
+}
+
+// IsSensitiveProperty checks whether the value of the property name or alias is a secret
+func IsSensitiveProperty(name string) bool {
+	cn := GetConfigFromName(strings.TrimSpace(name))
+	if cn.configPropName != "" {
+		return cn.sensitive
+	}
+	lowerName := strings.ToLower(name)
+	for _, sensitiveName := range sensitiveNames {
+		if strings.Contains(lowerName, sensitiveName) {
+			return true
+		}
+	}
+	return false
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for redaction
+/////////////////////////////////////////////////////////////////
+
+// redactProperty returns the value of the property name as it may be shown
+func redactProperty(name, value string) string {
+	if value == "" || IsUnsafeDebug() || !IsSensitiveProperty(name) {
+		return value
+	}
+	return RedactedValue
+}
+
+// redactSecret returns a password or other secret as it may be shown
+func redactSecret(secret []byte) string {
+	if len(secret) == 0 || IsUnsafeDebug() {
+		return string(secret)
+	}
+	return RedactedValue
+}
+
+// redactToken returns an auth token as it may be shown. A token of 0, i.e. not authenticated yet, is shown as is
+func redactToken(token int64) string {
+	if token == 0 || IsUnsafeDebug() {
+		return strconv.FormatInt(token, 10)
+	}
+	return RedactedValue
+}
+
+// redactValue returns a value, e.g. of an encrypted attribute, as it may be shown
+func redactValue(value interface{}) interface{} {
+	if value == nil || IsUnsafeDebug() {
+		return value
+	}
+	return RedactedValue
+}
+
+// redactBytes returns a raw buffer as it may be shown. Buffers are reduced to their length, as they may hold a
+// password or the decrypted value of an attribute
+func redactBytes(buf []byte) interface{} {
+	if IsUnsafeDebug() {
+		return buf
+	}
+	return fmt.Sprintf("<%d bytes>", len(buf))
+}
+
+// redactUrl masks the sensitive properties of a channel URL
+func redactUrl(sUrl string) string {
+	if IsUnsafeDebug() {
+		return sUrl
+	}
+	return urlPropertyPattern.ReplaceAllStringFunc(sUrl, func(kvp string) string {
+		parts := urlPropertyPattern.FindStringSubmatch(kvp)
+		if parts[3] == "" || !IsSensitiveProperty(parts[2]) {
+			return kvp
+		}
+		return parts[1] + parts[2] + "=" + RedactedValue
+	})
+}
+
+// redactLogMessage masks what looks like a secret in a formatted log message. It catches what the callers do not
+// mask themselves, e.g. the unexported fields of structures dumped with %+v
+func redactLogMessage(logMsg string) string {
+	if IsUnsafeDebug() {
+		return logMsg
+	}
+	return sensitivePairPattern.ReplaceAllString(logMsg, "${1}"+RedactedValue)
+}
@@ -0,0 +1,222 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: redactimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+const testSecret = "s3cret"
+
+// withUnsafeDebug runs the test body with the masking of secrets switched on or off, and restores it afterwards
+func withUnsafeDebug(t *testing.T, enabled bool, body func()) {
+	t.Helper()
+	previous := IsUnsafeDebug()
+	SetUnsafeDebug(enabled)
+	defer SetUnsafeDebug(previous)
+	body()
+}
+
+func TestLinkUrlKeepsRawUrlAndRedactsWhenShown(t *testing.T) {
+	withUnsafeDebug(t, false, func() {
+		rawUrl := "tcp://scott@localhost:8222/{dbName=demodb;password=" + testSecret + "}"
+		linkUrl := NewLinkUrl(rawUrl)
+		if linkUrl.GetUrlAsString() != rawUrl {
+			t.Fatalf("Expected GetUrlAsString to return the raw URL '%s', got '%s'", rawUrl, linkUrl.GetUrlAsString())
+		}
+		shown := linkUrl.String()
+		if strings.Contains(shown, testSecret) {
+			t.Fatalf("Expected the password to be masked in String(), got '%s'", shown)
+		}
+		if !strings.Contains(shown, "dbName=demodb") || !strings.Contains(shown, "password="+RedactedValue) {
+			t.Fatalf("Expected only the password to be masked in String(), got '%s'", shown)
+		}
+	})
+}
+
+func TestRedactUrl(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{"no properties", "tcp://scott@localhost:8222", "tcp://scott@localhost:8222"},
+		{"no sensitive property", "tcp://localhost:8222/{dbName=demodb;connectTimeout=10}", "tcp://localhost:8222/{dbName=demodb;connectTimeout=10}"},
+		{"password first", "tcp://localhost:8222/{password=" + testSecret + ";dbName=demodb}", "tcp://localhost:8222/{password=" + RedactedValue + ";dbName=demodb}"},
+		{"password last", "tcp://localhost:8222/{dbName=demodb;password=" + testSecret + "}", "tcp://localhost:8222/{dbName=demodb;password=" + RedactedValue + "}"},
+		{"configuration name", "tcp://localhost:8222/{tgdb.connection.password=" + testSecret + "}", "tcp://localhost:8222/{tgdb.connection.password=" + RedactedValue + "}"},
+		{"derived name", "tcp://localhost:8222/{keystorePassphrase=" + testSecret + "}", "tcp://localhost:8222/{keystorePassphrase=" + RedactedValue + "}"},
+		{"empty password", "tcp://localhost:8222/{password=;dbName=demodb}", "tcp://localhost:8222/{password=;dbName=demodb}"},
+		{"ft urls", "tcp://localhost:8222/{ftHosts=host2:8222,host3:8222;pwd=" + testSecret + "}", "tcp://localhost:8222/{ftHosts=host2:8222,host3:8222;pwd=" + RedactedValue + "}"},
+	}
+	withUnsafeDebug(t, false, func() {
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				if got := redactUrl(test.url); got != test.want {
+					t.Fatalf("Expected '%s', got '%s'", test.want, got)
+				}
+			})
+		}
+	})
+}
+
+func TestRedactLogMessage(t *testing.T) {
+	type session struct {
+		user      string
+		password  []byte
+		authToken int64
+	}
+	tests := []struct {
+		name string
+		msg  string
+		want string
+	}{
+		{"no secret", "Entering TGConnection:Connect w/ user 'scott'", "Entering TGConnection:Connect w/ user 'scott'"},
+		{"struct dump", fmt.Sprintf("%+v", session{"scott", []byte("ab"), 4711}), "{user:scott password:" + RedactedValue + " authToken:" + RedactedValue + "}"},
+		{"pointer dump", fmt.Sprintf("%+v", &struct{ Pwd string }{testSecret}), "&{Pwd:" + RedactedValue + "}"},
+		{"auth token", "Returning AuthenticateResponse w/ AuthToken: 4711, SessionId: 12", "Returning AuthenticateResponse w/ AuthToken: " + RedactedValue + ", SessionId: 12"},
+		{"property pair", "Properties {dbName=demodb;password=" + testSecret + "}", "Properties {dbName=demodb;password=" + RedactedValue + "}"},
+		{"quoted value", "Read secret='" + testSecret + " with blanks' from file", "Read secret=" + RedactedValue + " from file"},
+		{"json", `{"user":"scott","password":"` + testSecret + `"}`, `{"user":"scott","password":` + RedactedValue + `}`},
+	}
+	withUnsafeDebug(t, false, func() {
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				if got := redactLogMessage(test.msg); got != test.want {
+					t.Fatalf("Expected '%s', got '%s'", test.want, got)
+				}
+			})
+		}
+	})
+}
+
+func TestRedactSortedProperties(t *testing.T) {
+	withUnsafeDebug(t, false, func() {
+		props := NewSortedProperties()
+		props.AddProperty("dbName", "demodb")
+		props.AddProperty("password", testSecret)
+		shown := props.String()
+		if strings.Contains(shown, testSecret) || !strings.Contains(shown, "demodb") {
+			t.Fatalf("Expected only the password to be masked in the properties, got '%s'", shown)
+		}
+		if shown = fmt.Sprintf("%+v", props); strings.Contains(shown, testSecret) {
+			t.Fatalf("Expected the password to be masked in the %%+v dump, got '%s'", shown)
+		}
+	})
+}
+
+func TestRedactTokenAndSecrets(t *testing.T) {
+	withUnsafeDebug(t, false, func() {
+		if got := redactToken(0); got != "0" {
+			t.Fatalf("Expected a token of 0 to be shown as is, got '%s'", got)
+		}
+		if got := redactToken(4711); got != RedactedValue {
+			t.Fatalf("Expected the token to be masked, got '%s'", got)
+		}
+		if got := redactSecret(nil); got != "" {
+			t.Fatalf("Expected an empty secret to be shown as is, got '%s'", got)
+		}
+		if got := redactSecret([]byte(testSecret)); got != RedactedValue {
+			t.Fatalf("Expected the secret to be masked, got '%s'", got)
+		}
+		if got := redactBytes([]byte(testSecret)); got != "<6 bytes>" {
+			t.Fatalf("Expected the buffer to be reduced to its length, got '%v'", got)
+		}
+		if got := redactValue(nil); got != nil {
+			t.Fatalf("Expected a nil value to be shown as is, got '%v'", got)
+		}
+	})
+}
+
+func TestRedactEncryptedAttributeValue(t *testing.T) {
+	withUnsafeDebug(t, false, func() {
+		plainDesc := NewAttributeDescriptorAsArray("name", AttributeTypeString, false)
+		plain := NewStringAttributeWithDesc(nil, plainDesc, "Bruce")
+		if shown := plain.String(); !strings.Contains(shown, "AttrValue: Bruce") {
+			t.Fatalf("Expected the value of a plain attribute to be shown, got '%s'", shown)
+		}
+
+		encryptedDesc := NewAttributeDescriptorAsArray("ssn", AttributeTypeString, false)
+		encryptedDesc.SetIsEncrypted(true)
+		encrypted := NewStringAttributeWithDesc(nil, encryptedDesc, "078-05-1120")
+		if shown := encrypted.String(); strings.Contains(shown, "078-05-1120") || !strings.Contains(shown, "AttrValue: "+RedactedValue) {
+			t.Fatalf("Expected the value of an encrypted attribute to be masked, got '%s'", shown)
+		}
+	})
+}
+
+func TestSetUnsafeDebugShowsSecrets(t *testing.T) {
+	rawUrl := "tcp://localhost:8222/{password=" + testSecret + "}"
+	withUnsafeDebug(t, true, func() {
+		if !IsUnsafeDebug() {
+			t.Fatalf("Expected unsafe debug to be on")
+		}
+		if got := redactUrl(rawUrl); got != rawUrl {
+			t.Fatalf("Expected the URL as is, got '%s'", got)
+		}
+		if got := redactLogMessage("password=" + testSecret); got != "password="+testSecret {
+			t.Fatalf("Expected the log message as is, got '%s'", got)
+		}
+		if got := redactToken(4711); got != "4711" {
+			t.Fatalf("Expected the token as is, got '%s'", got)
+		}
+		if got := redactProperty("password", testSecret); got != testSecret {
+			t.Fatalf("Expected the property value as is, got '%s'", got)
+		}
+	})
+	withUnsafeDebug(t, false, func() {
+		if got := redactUrl(rawUrl); strings.Contains(got, testSecret) {
+			t.Fatalf("Expected the password to be masked again, got '%s'", got)
+		}
+	})
+}
+
+// The environment is only read when the package is initialized, hence the test runs itself in a child process
+func TestUnsafeDebugFromEnvironment(t *testing.T) {
+	if want := os.Getenv("TGDB_REDACT_TEST_WANT"); want != "" {
+		if fmt.Sprint(IsUnsafeDebug()) != want {
+			fmt.Printf("Expected IsUnsafeDebug() to be %s with %s='%s'\n", want, UnsafeDebugEnv, os.Getenv(UnsafeDebugEnv))
+			os.Exit(1)
+		}
+		return
+	}
+	tests := []struct {
+		value string
+		want  bool
+	}{
+		{"true", true},
+		{"1", true},
+		{"false", false},
+		{"yes", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestUnsafeDebugFromEnvironment$")
+		cmd.Env = append(os.Environ(), UnsafeDebugEnv+"="+test.value, fmt.Sprintf("TGDB_REDACT_TEST_WANT=%v", test.want))
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("Expected IsUnsafeDebug() to be %v with %s='%s': %v\n%s", test.want, UnsafeDebugEnv, test.value, err, out)
+		}
+	}
+}
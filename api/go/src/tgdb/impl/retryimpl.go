@@ -0,0 +1,221 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: retryimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"tgdb"
+	"time"
+)
+
+// DefaultRetryPolicy returns the retry policy built from the default values of the tgdb.connection.retry* properties
+func DefaultRetryPolicy() *tgdb.TGRetryPolicy {
+	return NewRetryPolicy(NewSortedProperties())
+}
+
+// NewRetryPolicy returns the retry policy described by the tgdb.connection.retry* properties in props
+func NewRetryPolicy(props tgdb.TGProperties) *tgdb.TGRetryPolicy {
+	policy := &tgdb.TGRetryPolicy{
+		MaxAttempts:                  retryPropertyAsInt(props, ConnectionRetryMaxAttempts),
+		InitialBackoff:               time.Duration(retryPropertyAsInt(props, ConnectionRetryInitialBackoffMSecs)) * time.Millisecond,
+		MaxBackoff:                   time.Duration(retryPropertyAsInt(props, ConnectionRetryMaxBackoffMSecs)) * time.Millisecond,
+		Multiplier:                   2,
+		Jitter:                       float64(retryPropertyAsInt(props, ConnectionRetryJitterPercent)) / 100,
+		RetryableTransactionStatuses: []tgdb.TGTransactionStatus{tgdb.TGTransactionOptimisticLockFailed},
+	}
+	cn := GetConfigFromKey(ConnectionRetryableErrors)
+	for _, code := range strings.Split(props.GetProperty(cn, cn.GetDefaultValue()), ",") {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			continue
+		}
+		errType, found := errorTypeFromCode(code)
+		if !found {
+			logger.Warning(fmt.Sprintf("WARNING: NewRetryPolicy - ignoring unknown error code '%s' in '%s'", code, cn.GetName()))
+			continue
+		}
+		policy.RetryableErrorTypes = append(policy.RetryableErrorTypes, errType)
+	}
+	return policy
+}
+
+func retryPropertyAsInt(props tgdb.TGProperties, key int) int {
+	cn := GetConfigFromKey(key)
+	if props.GetProperty(cn, "") == "" {
+		v, _ := strconv.Atoi(cn.GetDefaultValue())
+		return v
+	}
+	return props.GetPropertyAsInt(cn)
+}
+
+func errorTypeFromCode(code string) (int, bool) {
+	for errType, tgErr := range PreDefinedErrors {
+		if tgErr.ErrorCode == code {
+			return errType, true
+		}
+	}
+	return 0, false
+}
+
+// isRetryableError reports whether policy retries a query that failed with err
+func isRetryableError(policy *tgdb.TGRetryPolicy, err tgdb.TGError) bool {
+	errType := retryErrorType(err)
+	for _, retryableType := range policy.RetryableErrorTypes {
+		if errType == retryableType {
+			return true
+		}
+	}
+	return false
+}
+
+// retryErrorType returns the type a retry policy matches err by. A disconnected channel keeps the type of the
+// failure that broke it, so it is matched by its kind
+func retryErrorType(err tgdb.TGError) int {
+	if _, ok := err.(*ChannelDisconnected); ok {
+		return TGErrorChannelDisconnected
+	}
+	return err.GetErrorType()
+}
+
+// isRetryableCommitError reports whether policy reruns a closure whose commit failed with err
+func isRetryableCommitError(policy *tgdb.TGRetryPolicy, err tgdb.TGError) bool {
+	if txnErr, ok := err.(tgdb.TGTransactionError); ok {
+		for _, status := range policy.RetryableTransactionStatuses {
+			if txnErr.GetTransactionStatus() == status {
+				return true
+			}
+		}
+	}
+	return isRetryableError(policy, err)
+}
+
+// retryBackoff returns how long to wait before the given retry, counting from 1
+func retryBackoff(policy *tgdb.TGRetryPolicy, retry int) time.Duration {
+	backoff := float64(policy.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		backoff *= policy.Multiplier
+		if policy.MaxBackoff > 0 && backoff >= float64(policy.MaxBackoff) {
+			break
+		}
+	}
+	if policy.MaxBackoff > 0 && backoff > float64(policy.MaxBackoff) {
+		backoff = float64(policy.MaxBackoff)
+	}
+	if policy.Jitter > 0 {
+		backoff += backoff * policy.Jitter * (2*rand.Float64() - 1)
+	}
+	if backoff < 0 {
+		return 0
+	}
+	return time.Duration(backoff)
+}
+
+// waitForRetry sleeps for backoff unless ctx is done first
+func waitForRetry(ctx context.Context, backoff time.Duration) tgdb.TGError {
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return channelContextError(ctx, "Gave up retrying the operation")
+	}
+}
+
+// retryQuery runs query, and runs it again while it fails with an error the retry policy of this connection retries
+func (obj *TGDBConnection) retryQuery(ctx context.Context, opName string, query func() (tgdb.TGResultSet, tgdb.TGError)) (tgdb.TGResultSet, tgdb.TGError) {
+	policy := obj.GetRetryPolicy()
+	for attempt := 1; ; attempt++ {
+		rSet, err := query()
+		if err == nil || !isRetryableError(policy, err) || ctx.Err() != nil {
+			return rSet, err
+		}
+		if attempt >= policy.MaxAttempts {
+			if attempt > 1 {
+				obj.recordRetry(true)
+			}
+			logger.Error(fmt.Sprintf("ERROR: Returning TGDBConnection:%s - giving up after %d attempts w/ error: '%s'", opName, attempt, err.Error()))
+			return rSet, err
+		}
+		backoff := retryBackoff(policy, attempt)
+		obj.recordRetry(false)
+		logger.Warning(fmt.Sprintf("WARNING: Inside TGDBConnection:%s - retrying in %v (attempt %d of %d) after error: '%s'", opName, backoff, attempt+1, policy.MaxAttempts, err.Error()))
+		if wErr := waitForRetry(ctx, backoff); wErr != nil {
+			return nil, wErr
+		}
+	}
+}
+
+// GetRetryCount returns how many times queries and commits on this connection have been retried
+func (obj *TGDBConnection) GetRetryCount() int64 {
+	return atomic.LoadInt64(&obj.retries)
+}
+
+// GetRetryFailureCount returns how many retried queries and commits on this connection still failed on their last attempt
+func (obj *TGDBConnection) GetRetryFailureCount() int64 {
+	return atomic.LoadInt64(&obj.retryFailures)
+}
+
+// retryRecorder is implemented by the connections that count their retries
+type retryRecorder interface {
+	recordRetry(exhausted bool)
+}
+
+func (obj *TGDBConnection) recordRetry(exhausted bool) {
+	if exhausted {
+		atomic.AddInt64(&obj.retryFailures, 1)
+	} else {
+		atomic.AddInt64(&obj.retries, 1)
+	}
+}
+
+// retryCommit runs unit, and runs it again while it fails with an error the retry policy of conn retries, unless
+// ctx is done first
+func retryCommit(ctx context.Context, conn tgdb.TGConnection, opName string, unit func() tgdb.TGError) tgdb.TGError {
+	policy := conn.GetRetryPolicy()
+	recorder, _ := conn.(retryRecorder)
+	for attempt := 1; ; attempt++ {
+		err := unit()
+		if err == nil || !isRetryableCommitError(policy, err) || ctx.Err() != nil {
+			return err
+		}
+		if attempt >= policy.MaxAttempts {
+			if recorder != nil && attempt > 1 {
+				recorder.recordRetry(true)
+			}
+			logger.Error(fmt.Sprintf("ERROR: Returning %s - giving up after %d attempts w/ error: '%s'", opName, attempt, err.Error()))
+			return err
+		}
+		backoff := retryBackoff(policy, attempt)
+		if recorder != nil {
+			recorder.recordRetry(false)
+		}
+		logger.Warning(fmt.Sprintf("WARNING: Inside %s - retrying in %v (attempt %d of %d) after error: '%s'", opName, backoff, attempt+1, policy.MaxAttempts, err.Error()))
+		if wErr := waitForRetry(ctx, backoff); wErr != nil {
+			return wErr
+		}
+	}
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: retryimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"context"
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/mockserver"
+	"time"
+)
+
+func TestWithCommitStopsRetryingWhenContextIsDone(t *testing.T) {
+	conn := impl.DefaultTGDBConnection()
+	conn.SetRetryPolicy(&tgdb.TGRetryPolicy{
+		MaxAttempts:         10,
+		InitialBackoff:      time.Minute,
+		MaxBackoff:          time.Minute,
+		Multiplier:          1,
+		RetryableErrorTypes: []int{impl.TGErrorIOException},
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	attempts := 0
+	started := time.Now()
+	_, err := impl.WithCommitContext(ctx, conn, func(conn tgdb.TGConnection) error {
+		attempts++
+		return impl.GetErrorByType(impl.TGErrorIOException, "", "Connection reset", "")
+	})
+	if err == nil {
+		t.Fatal("Expected the commit to fail")
+	}
+	if attempts != 1 {
+		t.Fatalf("Expected 1 attempt before the context expired, got %d", attempts)
+	}
+	if elapsed := time.Since(started); elapsed > 10*time.Second {
+		t.Fatalf("Expected the backoff to end with the context, waited %v", elapsed)
+	}
+}
+
+func TestQueryIsRetriedWhenResendsAreExhausted(t *testing.T) {
+	server, conn := connectToMockWithProperties(t, map[string]string{
+		"tgdb.channel.ftRetryCount":                "1",
+		"tgdb.channel.ftRetryIntervalSeconds":      "0",
+		"tgdb.connection.retryInitialBackoffMSecs": "1",
+	})
+	defer server.Close()
+	defer conn.Disconnect()
+	ids := addPeople(t, server.GetStore(), "a")
+	server.GetStore().SetQueryResult("people", ids...)
+
+	// The first attempt loses the connection on the request and on its one resend, the retry gets through
+	server.InjectFault(impl.VerbQueryRequest, mockserver.Fault{Kind: mockserver.FaultDropConnection, Count: 2})
+	resultSet, err := conn.ExecuteQuery("tgql://people", impl.NewQueryOption())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if count := len(resultSet.ToCollection()); count != 1 {
+		t.Fatalf("Expected 1 node, got %d", count)
+	}
+	if count := server.GetRequestCount(impl.VerbQueryRequest); count != 3 {
+		t.Fatalf("Expected the query to be sent 3 times, got %d", count)
+	}
+	tgdbConn := conn.(*impl.TGDBConnection)
+	if retries := tgdbConn.GetRetryCount(); retries != 1 {
+		t.Fatalf("Expected 1 retry, got %d", retries)
+	}
+	if failures := tgdbConn.GetRetryFailureCount(); failures != 0 {
+		t.Fatalf("Expected no failed retries, got %d", failures)
+	}
+}
+
+func TestWithCommitRetriesDisconnectedChannel(t *testing.T) {
+	conn := impl.DefaultTGDBConnection()
+	conn.SetRetryPolicy(&tgdb.TGRetryPolicy{
+		MaxAttempts:         2,
+		Multiplier:          1,
+		RetryableErrorTypes: []int{impl.TGErrorChannelDisconnected},
+	})
+
+	attempts := 0
+	_, err := impl.WithCommit(conn, func(conn tgdb.TGConnection) error {
+		attempts++
+		// The channel reports the type of the failure that disconnected it
+		return impl.NewTGChannelDisconnected("", impl.TGErrorIOException, "Connection reset", "")
+	})
+	if err == nil {
+		t.Fatal("Expected the commit to fail")
+	}
+	if attempts != 2 {
+		t.Fatalf("Expected the disconnected channel to be retried, got %d attempts", attempts)
+	}
+}
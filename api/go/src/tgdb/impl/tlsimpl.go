@@ -0,0 +1,800 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: tlsimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io/ioutil"
+	"strings"
+	"tgdb"
+	"unicode/utf16"
+)
+
+const (
+	TGDB_TLS_ERROR string = "TGDB-TLS-ERR"
+)
+
+/////////////////////////////////////////////////////////////////
+// Helper Public functions for the SSL channel configuration
+/////////////////////////////////////////////////////////////////
+
+// GetTLSVersion returns the TLS protocol version for a name such as TLSv1.2 or TLSv1.3
+func GetTLSVersion(name string) (uint16, tgdb.TGError) {
+	version := strings.TrimPrefix(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(name)), "tls"), "v")
+	switch version {
+	case "1.2":
+		return tls.VersionTLS12, nil
+	case "1.3":
+		return tls.VersionTLS13, nil
+	}
+	errMsg := fmt.Sprintf("TLS protocol '%s' is not supported. Use TLSv1.2 or TLSv1.3", name)
+	return 0, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, "")
+}
+
+// DefaultTLSCipherSuites returns the TLS 1.2 cipher suites offered when none are configured - the ones the server
+// supports that Go does not consider insecure. That leaves out RC4, 3DES and the CBC-SHA256 suites
+func DefaultTLSCipherSuites() []uint16 {
+	suites := make([]uint16, 0)
+	for _, cs := range tls.CipherSuites() {
+		if GetCipherSuiteById(cs.ID).suiteId == 0 {
+			continue
+		}
+		for _, version := range cs.SupportedVersions {
+			if version == tls.VersionTLS12 {
+				suites = append(suites, cs.ID)
+				break
+			}
+		}
+	}
+	return suites
+}
+
+// GetTLSCipherSuites returns the ids of a comma separated list of cipher suites, named either the IANA way, e.g.
+// TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256, or the OpenSSL way, e.g. ECDHE-RSA-AES128-GCM-SHA256. An empty list
+// gives the DefaultTLSCipherSuites. Insecure suites are only offered when they are listed explicitly
+func GetTLSCipherSuites(names string) ([]uint16, tgdb.TGError) {
+	if strings.TrimSpace(names) == "" {
+		return DefaultTLSCipherSuites(), nil
+	}
+	suites := make([]uint16, 0)
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if cs := GetCipherSuite(name); cs.suiteId != 0 {
+			suites = append(suites, cs.suiteId)
+			continue
+		}
+		found := false
+		for _, cs := range tls.CipherSuites() {
+			if strings.EqualFold(cs.Name, name) {
+				suites = append(suites, cs.ID)
+				found = true
+				break
+			}
+		}
+		for _, cs := range tls.InsecureCipherSuites() {
+			if !found && strings.EqualFold(cs.Name, name) {
+				logger.Warning(fmt.Sprintf("WARNING: Inside GetTLSCipherSuites - enabling insecure cipher suite '%s'", cs.Name))
+				suites = append(suites, cs.ID)
+				found = true
+			}
+		}
+		if !found {
+			errMsg := fmt.Sprintf("Cipher suite '%s' is not supported", name)
+			return nil, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, "")
+		}
+	}
+	return suites, nil
+}
+
+// LoadClientCertificate loads the certificate the SSL channel presents to the server. certFile is either a PEM
+// file with the certificate chain, or a PKCS#12 (.p12 / .pfx) file holding both the chain and the private key.
+// keyFile is the PEM private key; it can be left empty when certFile holds the key as well. The passphrase
+// decrypts an encrypted key or the PKCS#12 file
+func LoadClientCertificate(certFile, keyFile, passphrase string) (*tls.Certificate, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering LoadClientCertificate w/ certificate file '%s' and key file '%s'", certFile, keyFile))
+	}
+	certData, err := ioutil.ReadFile(certFile)
+	if err != nil {
+		errMsg := fmt.Sprintf("Failed to read client certificate file '%s'", certFile)
+		return nil, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, err.Error())
+	}
+
+	var key crypto.PrivateKey
+	var chain []*x509.Certificate
+	if bytes.Contains(certData, []byte("-----BEGIN")) {
+		chain, err = parsePEMCertificates(certData)
+		if err != nil {
+			errMsg := fmt.Sprintf("Failed to parse client certificate file '%s'", certFile)
+			return nil, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, err.Error())
+		}
+		keyData := certData
+		if keyFile != "" {
+			keyData, err = ioutil.ReadFile(keyFile)
+			if err != nil {
+				errMsg := fmt.Sprintf("Failed to read client key file '%s'", keyFile)
+				return nil, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, err.Error())
+			}
+		}
+		key, err = parsePEMPrivateKey(keyData, passphrase)
+		if err != nil {
+			errMsg := fmt.Sprintf("Failed to load the client key from '%s'", keyFile)
+			if keyFile == "" {
+				errMsg = fmt.Sprintf("Failed to load the client key from '%s'. Set %s if the key is in a file of its own", certFile, GetConfigFromKey(TlsClientKey).GetName())
+			}
+			return nil, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, err.Error())
+		}
+	} else {
+		key, chain, err = decodePKCS12(certData, passphrase)
+		if err != nil {
+			errMsg := fmt.Sprintf("Failed to load the PKCS#12 file '%s'", certFile)
+			return nil, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, err.Error())
+		}
+	}
+
+	certificate, err := newClientCertificate(key, chain)
+	if err != nil {
+		errMsg := fmt.Sprintf("Failed to load client certificate from '%s'", certFile)
+		return nil, GetErrorByType(TGErrorSecurityException, TGDB_TLS_ERROR, errMsg, err.Error())
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning LoadClientCertificate w/ certificate for '%s'", certificate.Leaf.Subject.String()))
+	}
+	return certificate, nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for the client certificate
+/////////////////////////////////////////////////////////////////
+
+// newClientCertificate puts the certificate of the key first, followed by the rest of the chain
+func newClientCertificate(key crypto.PrivateKey, chain []*x509.Certificate) (*tls.Certificate, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return nil, fmt.Errorf("unsupported public key type %T", signer.Public())
+	}
+	certificate := &tls.Certificate{PrivateKey: key}
+	for _, cert := range chain {
+		if certificate.Leaf == nil && publicKey.Equal(cert.PublicKey) {
+			certificate.Leaf = cert
+			certificate.Certificate = append([][]byte{cert.Raw}, certificate.Certificate...)
+		} else {
+			certificate.Certificate = append(certificate.Certificate, cert.Raw)
+		}
+	}
+	if certificate.Leaf == nil {
+		return nil, errors.New("none of the certificates matches the private key")
+	}
+	return certificate, nil
+}
+
+func parsePEMCertificates(data []byte) ([]*x509.Certificate, error) {
+	chain := make([]*x509.Certificate, 0)
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		data = rest
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, cert)
+	}
+	if len(chain) == 0 {
+		return nil, errors.New("no CERTIFICATE block found")
+	}
+	return chain, nil
+}
+
+// parsePEMPrivateKey reads the first private key in data. It can be a plain or an encrypted PKCS#8 key, or an
+// OpenSSL PKCS#1 / EC key, possibly with the legacy Proc-Type encryption
+func parsePEMPrivateKey(data []byte, passphrase string) (crypto.PrivateKey, error) {
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		data = rest
+		switch block.Type {
+		case "ENCRYPTED PRIVATE KEY":
+			if passphrase == "" {
+				return nil, errors.New("the private key is encrypted and no passphrase is set")
+			}
+			der, err := decryptPKCS8PrivateKey(block.Bytes, passphrase)
+			if err != nil {
+				return nil, err
+			}
+			return x509.ParsePKCS8PrivateKey(der)
+		case "PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY":
+			der := block.Bytes
+			if x509.IsEncryptedPEMBlock(block) {
+				if passphrase == "" {
+					return nil, errors.New("the private key is encrypted and no passphrase is set")
+				}
+				var err error
+				der, err = x509.DecryptPEMBlock(block, []byte(passphrase))
+				if err != nil {
+					return nil, err
+				}
+			}
+			return parsePrivateKey(der)
+		}
+	}
+	return nil, errors.New("no PRIVATE KEY block found")
+}
+
+func parsePrivateKey(der []byte) (crypto.PrivateKey, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		switch key.(type) {
+		case *rsa.PrivateKey, *ecdsa.PrivateKey, ed25519.PrivateKey:
+			return key, nil
+		}
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	return nil, errors.New("unrecognized private key format")
+}
+
+/////////////////////////////////////////////////////////////////
+// PKCS#12 (RFC 7292) - only as much as it takes to read a client key and its certificates
+/////////////////////////////////////////////////////////////////
+
+var (
+	oidDataContentType          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEncryptedDataContentType = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 6}
+
+	oidKeyBag              = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 1}
+	oidPKCS8ShroudedKeyBag = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag             = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidX509Certificate     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+
+	oidPBEWithSHAAnd128BitRC2CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 5}
+	oidPBEWithSHAAnd3KeyDESCBC   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidPBEWithSHAAnd40BitRC2CBC  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 6}
+	oidPBES2                     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2                    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+
+	oidSHA1   = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+	oidSHA256 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512 = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidHMACWithSHA384 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 10}
+	oidHMACWithSHA512 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 11}
+
+	oidAES128CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC  = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+	oidDESEDE3CBC = asn1.ObjectIdentifier{1, 2, 840, 113549, 3, 7}
+)
+
+type pkcs12Pfx struct {
+	Version  int
+	AuthSafe pkcs12ContentInfo
+	MacData  pkcs12MacData `asn1:"optional"`
+}
+
+type pkcs12ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"tag:0,explicit,optional"`
+}
+
+type pkcs12MacData struct {
+	Mac        pkcs12DigestInfo
+	MacSalt    []byte
+	Iterations int `asn1:"optional,default:1"`
+}
+
+type pkcs12DigestInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Digest    []byte
+}
+
+type pkcs12EncryptedData struct {
+	Version              int
+	EncryptedContentInfo pkcs12EncryptedContentInfo
+}
+
+type pkcs12EncryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"tag:0,optional"`
+}
+
+type pkcs12SafeBag struct {
+	Id         asn1.ObjectIdentifier
+	Value      asn1.RawValue `asn1:"tag:0,explicit"`
+	Attributes asn1.RawValue `asn1:"optional"`
+}
+
+type pkcs12CertBag struct {
+	Id   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+type pkcs8EncryptedPrivateKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	Data      []byte
+}
+
+type pkcs12PBEParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt       []byte
+	Iterations int
+	KeyLength  int                      `asn1:"optional"`
+	Prf        pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// decodePKCS12 returns the private key and the certificates of a PKCS#12 file
+func decodePKCS12(data []byte, passphrase string) (crypto.PrivateKey, []*x509.Certificate, error) {
+	var pfx pkcs12Pfx
+	if rest, err := asn1.Unmarshal(data, &pfx); err != nil {
+		return nil, nil, fmt.Errorf("not a DER encoded PKCS#12 file: %s", err.Error())
+	} else if len(rest) != 0 {
+		return nil, nil, errors.New("trailing data after the PKCS#12 content")
+	}
+	if pfx.Version != 3 {
+		return nil, nil, fmt.Errorf("PKCS#12 version %d is not supported", pfx.Version)
+	}
+	if !pfx.AuthSafe.ContentType.Equal(oidDataContentType) {
+		return nil, nil, errors.New("public key protected PKCS#12 files are not supported")
+	}
+	var authSafeData []byte
+	if _, err := asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authSafeData); err != nil {
+		return nil, nil, err
+	}
+
+	// An empty passphrase is encoded as two zero bytes by most tools, and as nothing at all by some
+	password := bmpPassword(passphrase)
+	if len(pfx.MacData.Mac.Algorithm.Algorithm) != 0 {
+		err := verifyPKCS12Mac(&pfx.MacData, authSafeData, password)
+		if err != nil && passphrase == "" && verifyPKCS12Mac(&pfx.MacData, authSafeData, nil) == nil {
+			password, err = nil, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var authSafe []pkcs12ContentInfo
+	if _, err := asn1.Unmarshal(authSafeData, &authSafe); err != nil {
+		return nil, nil, err
+	}
+	var key crypto.PrivateKey
+	chain := make([]*x509.Certificate, 0)
+	for _, ci := range authSafe {
+		var safeContents []byte
+		switch {
+		case ci.ContentType.Equal(oidDataContentType):
+			if _, err := asn1.Unmarshal(ci.Content.Bytes, &safeContents); err != nil {
+				return nil, nil, err
+			}
+		case ci.ContentType.Equal(oidEncryptedDataContentType):
+			var encryptedData pkcs12EncryptedData
+			if _, err := asn1.Unmarshal(ci.Content.Bytes, &encryptedData); err != nil {
+				return nil, nil, err
+			}
+			info := encryptedData.EncryptedContentInfo
+			var err error
+			safeContents, err = pbeDecrypt(info.ContentEncryptionAlgorithm, info.EncryptedContent, passphrase, password)
+			if err != nil {
+				return nil, nil, err
+			}
+		default:
+			return nil, nil, fmt.Errorf("PKCS#12 content type %s is not supported", ci.ContentType.String())
+		}
+
+		var bags []pkcs12SafeBag
+		if _, err := asn1.Unmarshal(safeContents, &bags); err != nil {
+			return nil, nil, err
+		}
+		for _, bag := range bags {
+			switch {
+			case bag.Id.Equal(oidCertBag):
+				var certBag pkcs12CertBag
+				if _, err := asn1.Unmarshal(bag.Value.Bytes, &certBag); err != nil {
+					return nil, nil, err
+				}
+				if !certBag.Id.Equal(oidX509Certificate) {
+					continue
+				}
+				cert, err := x509.ParseCertificate(certBag.Data)
+				if err != nil {
+					return nil, nil, err
+				}
+				chain = append(chain, cert)
+			case bag.Id.Equal(oidKeyBag) && key == nil:
+				var err error
+				if key, err = parsePrivateKey(bag.Value.Bytes); err != nil {
+					return nil, nil, err
+				}
+			case bag.Id.Equal(oidPKCS8ShroudedKeyBag) && key == nil:
+				var keyInfo pkcs8EncryptedPrivateKeyInfo
+				if _, err := asn1.Unmarshal(bag.Value.Bytes, &keyInfo); err != nil {
+					return nil, nil, err
+				}
+				der, err := pbeDecrypt(keyInfo.Algorithm, keyInfo.Data, passphrase, password)
+				if err != nil {
+					return nil, nil, err
+				}
+				if key, err = parsePrivateKey(der); err != nil {
+					return nil, nil, err
+				}
+			}
+		}
+	}
+	if key == nil {
+		return nil, nil, errors.New("the PKCS#12 file holds no private key")
+	}
+	return key, chain, nil
+}
+
+func decryptPKCS8PrivateKey(der []byte, passphrase string) ([]byte, error) {
+	var keyInfo pkcs8EncryptedPrivateKeyInfo
+	if _, err := asn1.Unmarshal(der, &keyInfo); err != nil {
+		return nil, err
+	}
+	return pbeDecrypt(keyInfo.Algorithm, keyInfo.Data, passphrase, bmpPassword(passphrase))
+}
+
+func verifyPKCS12Mac(macData *pkcs12MacData, message, password []byte) error {
+	hashFunc, err := hashForOid(macData.Mac.Algorithm.Algorithm, false)
+	if err != nil {
+		return err
+	}
+	key := pkcs12KDF(hashFunc, 3, password, macData.MacSalt, macData.Iterations, hashFunc().Size())
+	mac := hmac.New(hashFunc, key)
+	mac.Write(message)
+	if !hmac.Equal(mac.Sum(nil), macData.Mac.Digest) {
+		return errors.New("integrity check failed - wrong passphrase or corrupted file")
+	}
+	return nil
+}
+
+// pbeDecrypt decrypts with either a PKCS#12 password based scheme, which uses the BMP encoded password, or PBES2
+func pbeDecrypt(algorithm pkix.AlgorithmIdentifier, data []byte, passphrase string, password []byte) ([]byte, error) {
+	var block cipher.Block
+	var iv []byte
+	switch {
+	case algorithm.Algorithm.Equal(oidPBEWithSHAAnd3KeyDESCBC),
+		algorithm.Algorithm.Equal(oidPBEWithSHAAnd128BitRC2CBC),
+		algorithm.Algorithm.Equal(oidPBEWithSHAAnd40BitRC2CBC):
+		var params pkcs12PBEParams
+		if _, err := asn1.Unmarshal(algorithm.Parameters.FullBytes, &params); err != nil {
+			return nil, err
+		}
+		iv = pkcs12KDF(sha1.New, 2, password, params.Salt, params.Iterations, 8)
+		switch {
+		case algorithm.Algorithm.Equal(oidPBEWithSHAAnd3KeyDESCBC):
+			var err error
+			block, err = des.NewTripleDESCipher(pkcs12KDF(sha1.New, 1, password, params.Salt, params.Iterations, 24))
+			if err != nil {
+				return nil, err
+			}
+		case algorithm.Algorithm.Equal(oidPBEWithSHAAnd128BitRC2CBC):
+			block = newRC2Cipher(pkcs12KDF(sha1.New, 1, password, params.Salt, params.Iterations, 16), 128)
+		default:
+			block = newRC2Cipher(pkcs12KDF(sha1.New, 1, password, params.Salt, params.Iterations, 5), 40)
+		}
+	case algorithm.Algorithm.Equal(oidPBES2):
+		var params pbes2Params
+		if _, err := asn1.Unmarshal(algorithm.Parameters.FullBytes, &params); err != nil {
+			return nil, err
+		}
+		if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+			return nil, fmt.Errorf("key derivation function %s is not supported", params.KeyDerivationFunc.Algorithm.String())
+		}
+		var kdfParams pbkdf2Params
+		if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdfParams); err != nil {
+			return nil, err
+		}
+		hashFunc := sha1.New
+		if len(kdfParams.Prf.Algorithm) != 0 {
+			var err error
+			if hashFunc, err = hashForOid(kdfParams.Prf.Algorithm, true); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+			return nil, err
+		}
+		scheme := params.EncryptionScheme.Algorithm
+		keyLength := 0
+		switch {
+		case scheme.Equal(oidAES128CBC):
+			keyLength = 16
+		case scheme.Equal(oidAES192CBC):
+			keyLength = 24
+		case scheme.Equal(oidAES256CBC):
+			keyLength = 32
+		case scheme.Equal(oidDESEDE3CBC):
+			keyLength = 24
+		default:
+			return nil, fmt.Errorf("encryption scheme %s is not supported", scheme.String())
+		}
+		key, err := pbkdf2.Key(hashFunc, passphrase, kdfParams.Salt, kdfParams.Iterations, keyLength)
+		if err != nil {
+			return nil, err
+		}
+		if scheme.Equal(oidDESEDE3CBC) {
+			block, err = des.NewTripleDESCipher(key)
+		} else {
+			block, err = aes.NewCipher(key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("encryption algorithm %s is not supported", algorithm.Algorithm.String())
+	}
+
+	if len(iv) != block.BlockSize() || len(data) == 0 || len(data)%block.BlockSize() != 0 {
+		return nil, errors.New("malformed encrypted content")
+	}
+	plain := make([]byte, len(data))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plain, data)
+	padding := int(plain[len(plain)-1])
+	if padding == 0 || padding > block.BlockSize() || !bytes.Equal(plain[len(plain)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, errors.New("decryption failed - wrong passphrase or corrupted file")
+	}
+	return plain[:len(plain)-padding], nil
+}
+
+func hashForOid(oid asn1.ObjectIdentifier, hmacOid bool) (func() hash.Hash, error) {
+	switch {
+	case !hmacOid && oid.Equal(oidSHA1), hmacOid && oid.Equal(oidHMACWithSHA1):
+		return sha1.New, nil
+	case !hmacOid && oid.Equal(oidSHA256), hmacOid && oid.Equal(oidHMACWithSHA256):
+		return sha256.New, nil
+	case !hmacOid && oid.Equal(oidSHA384), hmacOid && oid.Equal(oidHMACWithSHA384):
+		return sha512.New384, nil
+	case !hmacOid && oid.Equal(oidSHA512), hmacOid && oid.Equal(oidHMACWithSHA512):
+		return sha512.New, nil
+	}
+	return nil, fmt.Errorf("hash algorithm %s is not supported", oid.String())
+}
+
+// bmpPassword encodes the passphrase the way the PKCS#12 key derivation expects it - UTF-16BE with a terminating zero
+func bmpPassword(passphrase string) []byte {
+	units := utf16.Encode([]rune(passphrase))
+	password := make([]byte, 0, 2*len(units)+2)
+	for _, unit := range units {
+		password = append(password, byte(unit>>8), byte(unit))
+	}
+	return append(password, 0, 0)
+}
+
+// pkcs12KDF derives size bytes of key material (id 1), IV (id 2) or MAC key (id 3) as in RFC 7292 appendix B.2
+func pkcs12KDF(hashFunc func() hash.Hash, id byte, password, salt []byte, iterations, size int) []byte {
+	h := hashFunc()
+	v := h.BlockSize()
+	fill := func(in []byte, length int) []byte {
+		out := make([]byte, length)
+		for i := range out {
+			out[i] = in[i%len(in)]
+		}
+		return out
+	}
+	var input []byte
+	if len(salt) > 0 {
+		input = append(input, fill(salt, v*((len(salt)+v-1)/v))...)
+	}
+	if len(password) > 0 {
+		input = append(input, fill(password, v*((len(password)+v-1)/v))...)
+	}
+	diversifier := bytes.Repeat([]byte{id}, v)
+
+	out := make([]byte, 0, size+h.Size())
+	for len(out) < size {
+		h.Reset()
+		h.Write(diversifier)
+		h.Write(input)
+		a := h.Sum(nil)
+		for i := 1; i < iterations; i++ {
+			h.Reset()
+			h.Write(a)
+			a = h.Sum(a[:0])
+		}
+		out = append(out, a...)
+		if len(out) >= size {
+			break
+		}
+		// Every v byte block of the input becomes block + b + 1
+		b := fill(a, v)
+		for j := 0; j < len(input); j += v {
+			carry := 1
+			for k := v - 1; k >= 0; k-- {
+				sum := int(input[j+k]) + int(b[k]) + carry
+				input[j+k] = byte(sum)
+				carry = sum >> 8
+			}
+		}
+	}
+	return out[:size]
+}
+
+/////////////////////////////////////////////////////////////////
+// RC2 (RFC 2268) - older PKCS#12 files still encrypt their certificates with it
+/////////////////////////////////////////////////////////////////
+
+var rc2PiTable = [256]byte{
+	0xd9, 0x78, 0xf9, 0xc4, 0x19, 0xdd, 0xb5, 0xed, 0x28, 0xe9, 0xfd, 0x79, 0x4a, 0xa0, 0xd8, 0x9d,
+	0xc6, 0x7e, 0x37, 0x83, 0x2b, 0x76, 0x53, 0x8e, 0x62, 0x4c, 0x64, 0x88, 0x44, 0x8b, 0xfb, 0xa2,
+	0x17, 0x9a, 0x59, 0xf5, 0x87, 0xb3, 0x4f, 0x13, 0x61, 0x45, 0x6d, 0x8d, 0x09, 0x81, 0x7d, 0x32,
+	0xbd, 0x8f, 0x40, 0xeb, 0x86, 0xb7, 0x7b, 0x0b, 0xf0, 0x95, 0x21, 0x22, 0x5c, 0x6b, 0x4e, 0x82,
+	0x54, 0xd6, 0x65, 0x93, 0xce, 0x60, 0xb2, 0x1c, 0x73, 0x56, 0xc0, 0x14, 0xa7, 0x8c, 0xf1, 0xdc,
+	0x12, 0x75, 0xca, 0x1f, 0x3b, 0xbe, 0xe4, 0xd1, 0x42, 0x3d, 0xd4, 0x30, 0xa3, 0x3c, 0xb6, 0x26,
+	0x6f, 0xbf, 0x0e, 0xda, 0x46, 0x69, 0x07, 0x57, 0x27, 0xf2, 0x1d, 0x9b, 0xbc, 0x94, 0x43, 0x03,
+	0xf8, 0x11, 0xc7, 0xf6, 0x90, 0xef, 0x3e, 0xe7, 0x06, 0xc3, 0xd5, 0x2f, 0xc8, 0x66, 0x1e, 0xd7,
+	0x08, 0xe8, 0xea, 0xde, 0x80, 0x52, 0xee, 0xf7, 0x84, 0xaa, 0x72, 0xac, 0x35, 0x4d, 0x6a, 0x2a,
+	0x96, 0x1a, 0xd2, 0x71, 0x5a, 0x15, 0x49, 0x74, 0x4b, 0x9f, 0xd0, 0x5e, 0x04, 0x18, 0xa4, 0xec,
+	0xc2, 0xe0, 0x41, 0x6e, 0x0f, 0x51, 0xcb, 0xcc, 0x24, 0x91, 0xaf, 0x50, 0xa1, 0xf4, 0x70, 0x39,
+	0x99, 0x7c, 0x3a, 0x85, 0x23, 0xb8, 0xb4, 0x7a, 0xfc, 0x02, 0x36, 0x5b, 0x25, 0x55, 0x97, 0x31,
+	0x2d, 0x5d, 0xfa, 0x98, 0xe3, 0x8a, 0x92, 0xae, 0x05, 0xdf, 0x29, 0x10, 0x67, 0x6c, 0xba, 0xc9,
+	0xd3, 0x00, 0xe6, 0xcf, 0xe1, 0x9e, 0xa8, 0x2c, 0x63, 0x16, 0x01, 0x3f, 0x58, 0xe2, 0x89, 0xa9,
+	0x0d, 0x38, 0x34, 0x1b, 0xab, 0x33, 0xff, 0xb0, 0xbb, 0x48, 0x0c, 0x5f, 0xb9, 0xb1, 0xcd, 0x2e,
+	0xc5, 0xf3, 0xdb, 0x47, 0xe5, 0xa5, 0x9c, 0x77, 0x0a, 0xa6, 0x20, 0x68, 0xfe, 0x7f, 0xc1, 0xad,
+}
+
+type rc2Cipher struct {
+	k [64]uint16
+}
+
+func newRC2Cipher(key []byte, effectiveBits int) *rc2Cipher {
+	var l [128]byte
+	copy(l[:], key)
+	for i := len(key); i < 128; i++ {
+		l[i] = rc2PiTable[l[i-1]+l[i-len(key)]]
+	}
+	t8 := (effectiveBits + 7) / 8
+	tm := byte(0xff >> uint(8*t8-effectiveBits))
+	l[128-t8] = rc2PiTable[l[128-t8]&tm]
+	for i := 127 - t8; i >= 0; i-- {
+		l[i] = rc2PiTable[l[i+1]^l[i+t8]]
+	}
+	c := &rc2Cipher{}
+	for i := range c.k {
+		c.k[i] = uint16(l[2*i]) | uint16(l[2*i+1])<<8
+	}
+	return c
+}
+
+func (c *rc2Cipher) BlockSize() int {
+	return 8
+}
+
+func (c *rc2Cipher) Encrypt(dst, src []byte) {
+	r0 := uint16(src[0]) | uint16(src[1])<<8
+	r1 := uint16(src[2]) | uint16(src[3])<<8
+	r2 := uint16(src[4]) | uint16(src[5])<<8
+	r3 := uint16(src[6]) | uint16(src[7])<<8
+	j := 0
+	mix := func() {
+		r0 += c.k[j] + (r3 & r2) + (^r3 & r1)
+		r0 = r0<<1 | r0>>15
+		r1 += c.k[j+1] + (r0 & r3) + (^r0 & r2)
+		r1 = r1<<2 | r1>>14
+		r2 += c.k[j+2] + (r1 & r0) + (^r1 & r3)
+		r2 = r2<<3 | r2>>13
+		r3 += c.k[j+3] + (r2 & r1) + (^r2 & r0)
+		r3 = r3<<5 | r3>>11
+		j += 4
+	}
+	mash := func() {
+		r0 += c.k[r3&63]
+		r1 += c.k[r0&63]
+		r2 += c.k[r1&63]
+		r3 += c.k[r2&63]
+	}
+	for _, rounds := range []int{5, 6, 5} {
+		if j > 0 {
+			mash()
+		}
+		for i := 0; i < rounds; i++ {
+			mix()
+		}
+	}
+	dst[0], dst[1], dst[2], dst[3] = byte(r0), byte(r0>>8), byte(r1), byte(r1>>8)
+	dst[4], dst[5], dst[6], dst[7] = byte(r2), byte(r2>>8), byte(r3), byte(r3>>8)
+}
+
+func (c *rc2Cipher) Decrypt(dst, src []byte) {
+	r0 := uint16(src[0]) | uint16(src[1])<<8
+	r1 := uint16(src[2]) | uint16(src[3])<<8
+	r2 := uint16(src[4]) | uint16(src[5])<<8
+	r3 := uint16(src[6]) | uint16(src[7])<<8
+	j := 63
+	mix := func() {
+		r3 = r3>>5 | r3<<11
+		r3 -= c.k[j] + (r2 & r1) + (^r2 & r0)
+		r2 = r2>>3 | r2<<13
+		r2 -= c.k[j-1] + (r1 & r0) + (^r1 & r3)
+		r1 = r1>>2 | r1<<14
+		r1 -= c.k[j-2] + (r0 & r3) + (^r0 & r2)
+		r0 = r0>>1 | r0<<15
+		r0 -= c.k[j-3] + (r3 & r2) + (^r3 & r1)
+		j -= 4
+	}
+	mash := func() {
+		r3 -= c.k[r2&63]
+		r2 -= c.k[r1&63]
+		r1 -= c.k[r0&63]
+		r0 -= c.k[r3&63]
+	}
+	for _, rounds := range []int{5, 6, 5} {
+		if j < 63 {
+			mash()
+		}
+		for i := 0; i < rounds; i++ {
+			mix()
+		}
+	}
+	dst[0], dst[1], dst[2], dst[3] = byte(r0), byte(r0>>8), byte(r1), byte(r1>>8)
+	dst[4], dst[5], dst[6], dst[7] = byte(r2), byte(r2>>8), byte(r3), byte(r3>>8)
+}
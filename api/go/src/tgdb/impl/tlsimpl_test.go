@@ -0,0 +1,114 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: tlsimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"path/filepath"
+	"testing"
+	"tgdb/impl"
+)
+
+// The fixtures in testdata/tls were made with OpenSSL 3 and the passphrase "secret":
+//
+//	openssl req -x509 -newkey rsa:2048 -nodes -keyout key.pem -out client-cert.pem -subj "/CN=tgdb-test-client"
+//	openssl pkcs12 -export -in client-cert.pem -inkey key.pem -out client.p12
+//	openssl pkcs12 -export -legacy -in client-cert.pem -inkey key.pem -out client-legacy.p12
+//	openssl pkcs8 -topk8 -in key.pem -out client-key-pkcs8-aes.pem
+//	openssl pkcs8 -topk8 -v1 PBE-SHA1-3DES -in key.pem -out client-key-pkcs8-3des.pem
+//
+// client-ec.p12 holds a prime256v1 key and a certificate for "tgdb-test-ec", exported like client.p12
+const tlsFixturePassphrase = "secret"
+
+func tlsFixture(name string) string {
+	return filepath.Join("testdata", "tls", name)
+}
+
+func TestLoadClientCertificateFromPKCS12(t *testing.T) {
+	tests := []struct {
+		name       string
+		file       string
+		commonName string
+	}{
+		// PBES2 with PBKDF2 and AES-256-CBC, HMAC-SHA256 mac
+		{"OpenSSL 3 default", "client.p12", "tgdb-test-client"},
+		// RC2-40 certificates, 3DES key, SHA-1 mac
+		{"OpenSSL 3 legacy", "client-legacy.p12", "tgdb-test-client"},
+		{"EC key", "client-ec.p12", "tgdb-test-ec"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			certificate, err := impl.LoadClientCertificate(tlsFixture(test.file), "", tlsFixturePassphrase)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if cn := certificate.Leaf.Subject.CommonName; cn != test.commonName {
+				t.Fatalf("Expected the certificate of '%s', got '%s'", test.commonName, cn)
+			}
+			if len(certificate.Certificate) != 1 {
+				t.Fatalf("Expected 1 certificate in the chain, got %d", len(certificate.Certificate))
+			}
+		})
+	}
+}
+
+func TestLoadClientCertificateFromEncryptedPKCS8(t *testing.T) {
+	for _, keyFile := range []string{"client-key-pkcs8-aes.pem", "client-key-pkcs8-3des.pem"} {
+		t.Run(keyFile, func(t *testing.T) {
+			certificate, err := impl.LoadClientCertificate(tlsFixture("client-cert.pem"), tlsFixture(keyFile), tlsFixturePassphrase)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if _, ok := certificate.PrivateKey.(*rsa.PrivateKey); !ok {
+				t.Fatalf("Expected an RSA key, got '%T'", certificate.PrivateKey)
+			}
+			if _, err := impl.LoadClientCertificate(tlsFixture("client-cert.pem"), tlsFixture(keyFile), ""); err == nil {
+				t.Fatal("Expected the encrypted key to need a passphrase")
+			}
+		})
+	}
+}
+
+func TestLoadClientCertificateRejectsWrongPassphrase(t *testing.T) {
+	for _, file := range []string{"client.p12", "client-legacy.p12"} {
+		if _, err := impl.LoadClientCertificate(tlsFixture(file), "", "wrong"); err == nil {
+			t.Fatalf("Expected '%s' to fail its mac check with the wrong passphrase", file)
+		}
+	}
+	if _, err := impl.LoadClientCertificate(tlsFixture("client-cert.pem"), tlsFixture("client-key-pkcs8-aes.pem"), "wrong"); err == nil {
+		t.Fatal("Expected the key to fail to decrypt with the wrong passphrase")
+	}
+}
+
+func TestLoadClientCertificateKeyMatchesCertificate(t *testing.T) {
+	certificate, err := impl.LoadClientCertificate(tlsFixture("client-ec.p12"), "", tlsFixturePassphrase)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	key, ok := certificate.PrivateKey.(*ecdsa.PrivateKey)
+	if !ok {
+		t.Fatalf("Expected an EC key, got '%T'", certificate.PrivateKey)
+	}
+	if !key.PublicKey.Equal(certificate.Leaf.PublicKey) {
+		t.Fatal("Expected the key to belong to the leaf certificate")
+	}
+}
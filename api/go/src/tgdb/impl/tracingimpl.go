@@ -0,0 +1,200 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: tracingimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"context"
+	"sync"
+	"tgdb"
+	"time"
+)
+
+var requestTracer struct {
+	sync.RWMutex
+	tracer tgdb.TGRequestTracer
+}
+
+// SetRequestTracer sets the tracer that opens a span around every request sent on any channel. A nil tracer
+// restores the no-op default
+func SetRequestTracer(tracer tgdb.TGRequestTracer) {
+	requestTracer.Lock()
+	defer requestTracer.Unlock()
+	requestTracer.tracer = tracer
+}
+
+// GetRequestTracer returns the tracer set with SetRequestTracer, or the no-op default
+func GetRequestTracer() tgdb.TGRequestTracer {
+	requestTracer.RLock()
+	defer requestTracer.RUnlock()
+	if requestTracer.tracer == nil {
+		return NewNoopRequestTracer()
+	}
+	return requestTracer.tracer
+}
+
+// startRequestSpan opens the span of a request that has been given its request id
+func startRequestSpan(ctx context.Context, msg tgdb.TGMessage) (context.Context, tgdb.TGRequestSpan) {
+	verb := GetVerb(msg.GetVerbId()).GetName()
+	ctx, span := GetRequestTracer().StartSpan(ctx, verb)
+	span.SetAttribute(tgdb.SpanAttributeVerb, verb)
+	span.SetAttribute(tgdb.SpanAttributeRequestId, msg.GetRequestId())
+	return ctx, span
+}
+
+// endRequestSpan closes the span of a request once it has been answered, abandoned or has failed
+func endRequestSpan(ctx context.Context, span tgdb.TGRequestSpan, msg tgdb.TGMessage, err tgdb.TGError) {
+	span.SetAttribute(tgdb.SpanAttributeSessionId, msg.GetSessionId())
+	span.SetAttribute(tgdb.SpanAttributeMessageSize, msg.GetMessageByteBufLength())
+	switch {
+	case err == nil:
+		span.SetAttribute(tgdb.SpanAttributeOutcome, "success")
+	case ctx.Err() != nil:
+		span.SetAttribute(tgdb.SpanAttributeOutcome, "cancelled")
+	default:
+		span.SetAttribute(tgdb.SpanAttributeOutcome, "error")
+	}
+	span.End(err)
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for NoopRequestTracer
+/////////////////////////////////////////////////////////////////
+
+// NoopRequestTracer is the default tracer. Its spans record nothing
+type NoopRequestTracer struct {
+}
+
+type noopRequestSpan struct {
+}
+
+func NewNoopRequestTracer() *NoopRequestTracer {
+	return &NoopRequestTracer{}
+}
+
+// StartSpan returns ctx unchanged and a span that records nothing
+func (obj *NoopRequestTracer) StartSpan(ctx context.Context, name string) (context.Context, tgdb.TGRequestSpan) {
+	return ctx, noopRequestSpan{}
+}
+
+func (obj noopRequestSpan) SetAttribute(key string, value interface{}) {
+}
+
+func (obj noopRequestSpan) End(err tgdb.TGError) {
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for RecordingRequestTracer
+/////////////////////////////////////////////////////////////////
+
+// RecordedSpan is a span kept in memory by a RecordingRequestTracer
+type RecordedSpan struct {
+	TraceId    int64
+	SpanId     int64
+	ParentId   int64 // 0 for a root span
+	Name       string
+	Attributes map[string]interface{}
+	Start      time.Time
+	End        time.Time // zero while the span is open
+	Err        tgdb.TGError
+}
+
+// Duration returns how long the span was open, or zero while it is still open
+func (obj RecordedSpan) Duration() time.Duration {
+	if obj.End.IsZero() {
+		return 0
+	}
+	return obj.End.Sub(obj.Start)
+}
+
+// RecordingRequestTracer keeps every span in memory. It is meant for tests
+type RecordingRequestTracer struct {
+	lock   sync.Mutex
+	nextId int64
+	spans  []*RecordedSpan
+}
+
+type recordingRequestSpan struct {
+	tracer *RecordingRequestTracer
+	span   *RecordedSpan
+}
+
+func NewRecordingRequestTracer() *RecordingRequestTracer {
+	return &RecordingRequestTracer{}
+}
+
+// StartSpan records a new span. A span of this tracer carried by ctx becomes its parent
+func (obj *RecordingRequestTracer) StartSpan(ctx context.Context, name string) (context.Context, tgdb.TGRequestSpan) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.nextId++
+	span := &RecordedSpan{
+		TraceId:    obj.nextId,
+		SpanId:     obj.nextId,
+		Name:       name,
+		Attributes: make(map[string]interface{}),
+		Start:      time.Now(),
+	}
+	if parent, ok := tgdb.SpanFromContext(ctx).(*recordingRequestSpan); ok && parent.tracer == obj {
+		span.TraceId = parent.span.TraceId
+		span.ParentId = parent.span.SpanId
+	}
+	obj.spans = append(obj.spans, span)
+	recording := &recordingRequestSpan{tracer: obj, span: span}
+	return tgdb.ContextWithSpan(ctx, recording), recording
+}
+
+// GetSpans returns a copy of the spans recorded so far, in the order they were started
+func (obj *RecordingRequestTracer) GetSpans() []RecordedSpan {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	spans := make([]RecordedSpan, 0, len(obj.spans))
+	for _, span := range obj.spans {
+		copied := *span
+		copied.Attributes = make(map[string]interface{}, len(span.Attributes))
+		for k, v := range span.Attributes {
+			copied.Attributes[k] = v
+		}
+		spans = append(spans, copied)
+	}
+	return spans
+}
+
+// Reset discards the spans recorded so far
+func (obj *RecordingRequestTracer) Reset() {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.spans = nil
+}
+
+func (obj *recordingRequestSpan) SetAttribute(key string, value interface{}) {
+	obj.tracer.lock.Lock()
+	defer obj.tracer.lock.Unlock()
+	obj.span.Attributes[key] = value
+}
+
+func (obj *recordingRequestSpan) End(err tgdb.TGError) {
+	obj.tracer.lock.Lock()
+	defer obj.tracer.lock.Unlock()
+	if obj.span.End.IsZero() {
+		obj.span.End = time.Now()
+		obj.span.Err = err
+	}
+}
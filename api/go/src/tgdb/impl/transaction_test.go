@@ -0,0 +1,129 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: transaction_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"context"
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/mockserver"
+	"time"
+)
+
+func TestBeginWaitsForOpenTransaction(t *testing.T) {
+	server, conn := connectToMock(t)
+	defer server.Close()
+	defer conn.Disconnect()
+
+	first, err := conn.Begin(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// The connection is busy until the first transaction ends
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := conn.Begin(&tgdb.TGTransactionOptions{Context: ctx}); err == nil {
+		t.Fatal("Expected Begin to give up while another transaction is open")
+	}
+
+	began := make(chan tgdb.TGTransaction)
+	go func() {
+		second, err := conn.Begin(nil)
+		if err != nil {
+			t.Error(err.Error())
+		}
+		began <- second
+	}()
+	select {
+	case <-began:
+		t.Fatal("Expected Begin to wait for the open transaction")
+	case <-time.After(100 * time.Millisecond):
+	}
+	if _, err := first.Commit(); err != nil {
+		t.Fatal(err.Error())
+	}
+	select {
+	case second := <-began:
+		if second == nil {
+			t.Fatal("Expected the second transaction to begin")
+		}
+		if err := second.Rollback(); err != nil {
+			t.Fatal(err.Error())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected Begin to proceed once the open transaction committed")
+	}
+
+	// A rolled back transaction frees the connection too
+	third, err := conn.Begin(nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	_ = third.Rollback()
+}
+
+func TestCommitKeepsChangesWhenChannelFails(t *testing.T) {
+	server, conn := connectToMockWithProperties(t, map[string]string{
+		"tgdb.connection.rollbackOnCommitFailure": "true",
+		"tgdb.channel.ftRetryCount":               "1",
+		"tgdb.channel.ftRetryIntervalSeconds":     "0",
+	})
+	defer server.Close()
+	defer conn.Disconnect()
+	addPeople(t, server.GetStore())
+
+	gmd, err := conn.GetGraphMetadata(true)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	nodeType, err := gmd.GetNodeType("person")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	gof, err := conn.GetGraphObjectFactory()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	node, err := gof.CreateNodeInGraph(nodeType)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	_ = node.SetOrCreateAttribute("name", "a")
+	if err := conn.InsertEntity(node); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// The commit loses the connection, and the reconnect cannot authenticate any more
+	server.SetCredentials("scott", "changed")
+	server.InjectFault(impl.VerbCommitTransactionRequest, mockserver.Fault{Kind: mockserver.FaultDropConnection})
+	_, err = conn.Commit()
+	if err == nil {
+		t.Fatal("Expected the commit to fail")
+	}
+	if _, ok := err.(tgdb.TGTransactionError); ok {
+		t.Fatalf("Expected a channel failure rather than a transaction error, got '%s'", err.Error())
+	}
+	if added := conn.(*impl.TGDBConnection).GetAddedList(); len(added) != 1 {
+		t.Fatalf("Expected the pending node to be kept, got %d added entities", len(added))
+	}
+}
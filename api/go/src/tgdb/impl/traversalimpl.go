@@ -0,0 +1,366 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: traversalimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"tgdb"
+)
+
+// A traversal is sent to the server as a VerbTraverseRequest. The server walks the graph from the starting
+// points, following the edge type, direction, depth, predicate and uniqueness rules, and answers with the
+// entities it reached and the results - paths, nodes or edges - as lists of their ids.
+
+type TraversalUniqueness int
+
+const (
+	// UniqueNodesPerPath never visits a node twice within one path. This is the default
+	UniqueNodesPerPath TraversalUniqueness = iota
+	// UniqueEdgesPerPath never follows an edge twice within one path
+	UniqueEdgesPerPath
+	// UniqueNodesGlobal reaches every node at most once, through the shortest path found first
+	UniqueNodesGlobal
+	// UniqueNone places no restriction other than the maximum depth
+	UniqueNone
+)
+
+type TraversalResultShape int
+
+const (
+	// TraversalResultPaths returns each path as a slice of alternating nodes and edges
+	TraversalResultPaths TraversalResultShape = iota
+	// TraversalResultNodes returns the distinct end nodes of the paths
+	TraversalResultNodes
+	// TraversalResultEdges returns the distinct last edges of the paths
+	TraversalResultEdges
+)
+
+const (
+	DefaultTraversalMaxDepth = 3
+)
+
+type TraversalDescriptor struct {
+	conn        tgdb.TGConnection
+	edgeTypes   []string
+	direction   tgdb.TGDirection
+	minDepth    int
+	maxDepth    int
+	nodeFilter  tgdb.TGFilter
+	edgeFilter  tgdb.TGFilter
+	uniqueness  TraversalUniqueness
+	resultShape TraversalResultShape
+	edgeLimit   int
+}
+
+// Make sure that the TraversalDescriptor implements the TGTraversalDescriptor interface
+var _ tgdb.TGTraversalDescriptor = (*TraversalDescriptor)(nil)
+
+func DefaultTraversalDescriptor() *TraversalDescriptor {
+	newDescriptor := TraversalDescriptor{
+		direction:   tgdb.DirectionAny,
+		minDepth:    1,
+		maxDepth:    DefaultTraversalMaxDepth,
+		uniqueness:  UniqueNodesPerPath,
+		resultShape: TraversalResultPaths,
+		edgeLimit:   DefaultEdgeLimit,
+	}
+	return &newDescriptor
+}
+
+// NewTraversalDescriptor creates a traversal that is sent to the server through the connection
+func NewTraversalDescriptor(conn tgdb.TGConnection) *TraversalDescriptor {
+	newDescriptor := DefaultTraversalDescriptor()
+	newDescriptor.conn = conn
+	return newDescriptor
+}
+
+/////////////////////////////////////////////////////////////////
+// Builder functions for TraversalDescriptor
+/////////////////////////////////////////////////////////////////
+
+// WithEdgeTypes only follows edges of the named types. By default every edge type is followed
+func (obj *TraversalDescriptor) WithEdgeTypes(edgeTypes ...string) *TraversalDescriptor {
+	obj.edgeTypes = edgeTypes
+	return obj
+}
+
+// WithDirection follows edges in the given direction relative to the current node
+func (obj *TraversalDescriptor) WithDirection(direction tgdb.TGDirection) *TraversalDescriptor {
+	obj.direction = direction
+	return obj
+}
+
+// WithDepth sets the number of hops of the returned paths. minDepth 0 includes the starting points
+func (obj *TraversalDescriptor) WithDepth(minDepth, maxDepth int) *TraversalDescriptor {
+	obj.minDepth = minDepth
+	obj.maxDepth = maxDepth
+	return obj
+}
+
+// WithNodeFilter only steps onto nodes that match the filter. The filter is sent to the server as TGQL
+func (obj *TraversalDescriptor) WithNodeFilter(filter tgdb.TGFilter) *TraversalDescriptor {
+	obj.nodeFilter = filter
+	return obj
+}
+
+// WithEdgeFilter only follows edges that match the filter. The filter is sent to the server as TGQL
+func (obj *TraversalDescriptor) WithEdgeFilter(filter tgdb.TGFilter) *TraversalDescriptor {
+	obj.edgeFilter = filter
+	return obj
+}
+
+// WithUniqueness sets how often a node or an edge may be visited
+func (obj *TraversalDescriptor) WithUniqueness(uniqueness TraversalUniqueness) *TraversalDescriptor {
+	obj.uniqueness = uniqueness
+	return obj
+}
+
+// WithResultShape sets whether paths, nodes or edges are returned
+func (obj *TraversalDescriptor) WithResultShape(resultShape TraversalResultShape) *TraversalDescriptor {
+	obj.resultShape = resultShape
+	return obj
+}
+
+// WithEdgeLimit limits the number of edges the server follows per node. 0 means unlimited
+func (obj *TraversalDescriptor) WithEdgeLimit(edgeLimit int) *TraversalDescriptor {
+	obj.edgeLimit = edgeLimit
+	return obj
+}
+
+/////////////////////////////////////////////////////////////////
+// Implement functions from Interface ==> TGTraversalDescriptor
+/////////////////////////////////////////////////////////////////
+
+// Traverse the graph using starting points provided. Errors are reported through the exceptions of the
+// returned result set; use Execute to get them as a return value instead
+func (obj *TraversalDescriptor) Traverse(startingPoints []tgdb.TGNode) tgdb.TGResultSet {
+	resultSet, err := obj.Execute(startingPoints)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:Traverse w/ error: '%s'", err.Error()))
+		resultSet = NewResultSet(obj.conn, 0)
+		resultSet.AddException(err)
+	}
+	return resultSet
+}
+
+// Execute runs the traversal and returns the result set, or the first error encountered
+func (obj *TraversalDescriptor) Execute(startingPoints []tgdb.TGNode) (*ResultSet, tgdb.TGError) {
+	return obj.ExecuteContext(context.Background(), startingPoints)
+}
+
+// ExecuteContext is Execute that gives up waiting on the server once ctx is cancelled or expires
+func (obj *TraversalDescriptor) ExecuteContext(ctx context.Context, startingPoints []tgdb.TGNode) (*ResultSet, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering TraversalDescriptor:ExecuteContext w/ %d starting point(s) for '%+v'", len(startingPoints), obj))
+	}
+	if obj.conn == nil {
+		errMsg := "Traversal descriptor is not bound to a connection"
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	if obj.minDepth < 0 || obj.maxDepth < 1 || obj.minDepth > obj.maxDepth {
+		errMsg := fmt.Sprintf("Invalid traversal depth range [%d, %d]", obj.minDepth, obj.maxDepth)
+		return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	gof, err := obj.conn.GetGraphObjectFactory()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:ExecuteContext - unable to get the graph object factory w/ error: '%s'", err.Error()))
+		return nil, err
+	}
+
+	msgRequest, channelResponse, err := createChannelRequest(obj.conn, VerbTraverseRequest)
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:ExecuteContext - unable to createChannelRequest(VerbTraverseRequest) w/ error: '%s'", err.Error()))
+		return nil, err
+	}
+	request := msgRequest.(*TraverseRequestMessage)
+	if err := obj.configureRequest(request, startingPoints); err != nil {
+		return nil, err
+	}
+
+	msgResponse, err := obj.conn.GetChannel().SendRequestContext(ctx, request, channelResponse.(*BlockingChannelResponse))
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:ExecuteContext - unable to channel.SendRequest() w/ error: '%s'", err.Error()))
+		return nil, err
+	}
+	response, ok := msgResponse.(*TraverseResponseMessage)
+	if !ok {
+		errMsg := fmt.Sprintf("Unexpected response '%T' to a traverse request", msgResponse)
+		return nil, GetErrorByType(TGErrorProtocolNotSupported, INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+
+	resultSet, err := obj.populateResultSet(gof, response)
+	if err != nil {
+		return nil, err
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning TraversalDescriptor:ExecuteContext w/ %d result(s)", resultSet.Count()))
+	}
+	return resultSet, nil
+}
+
+func (obj *TraversalDescriptor) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("TraversalDescriptor:{")
+	buffer.WriteString(fmt.Sprintf("EdgeTypes: %+v", obj.edgeTypes))
+	buffer.WriteString(fmt.Sprintf(", Direction: %s", obj.direction))
+	buffer.WriteString(fmt.Sprintf(", MinDepth: %d", obj.minDepth))
+	buffer.WriteString(fmt.Sprintf(", MaxDepth: %d", obj.maxDepth))
+	buffer.WriteString(fmt.Sprintf(", NodeFilter: %+v", obj.nodeFilter))
+	buffer.WriteString(fmt.Sprintf(", EdgeFilter: %+v", obj.edgeFilter))
+	buffer.WriteString(fmt.Sprintf(", Uniqueness: %d", obj.uniqueness))
+	buffer.WriteString(fmt.Sprintf(", ResultShape: %d", obj.resultShape))
+	buffer.WriteString(fmt.Sprintf(", EdgeLimit: %d", obj.edgeLimit))
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for TraversalDescriptor
+/////////////////////////////////////////////////////////////////
+
+// configureRequest fills the traverse request in. Starting points have to be stored on the server
+func (obj *TraversalDescriptor) configureRequest(request *TraverseRequestMessage, startingPoints []tgdb.TGNode) tgdb.TGError {
+	startIds := make([]int64, 0, len(startingPoints))
+	for _, startNode := range startingPoints {
+		if startNode == nil {
+			continue
+		}
+		if startNode.GetIsNew() {
+			errMsg := fmt.Sprintf("Starting point '%d' is not stored on the server yet", startNode.GetVirtualId())
+			return GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+		}
+		startIds = append(startIds, startNode.GetVirtualId())
+	}
+	if obj.nodeFilter != nil {
+		expr, err := obj.nodeFilter.ToTGQL()
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:configureRequest - unable to render the node filter w/ error: '%s'", err.Error()))
+			return err
+		}
+		request.SetNodeFilter(expr)
+	}
+	if obj.edgeFilter != nil {
+		expr, err := obj.edgeFilter.ToTGQL()
+		if err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:configureRequest - unable to render the edge filter w/ error: '%s'", err.Error()))
+			return err
+		}
+		request.SetEdgeFilter(expr)
+	}
+	request.SetDirection(int(obj.direction))
+	request.SetDepth(obj.minDepth, obj.maxDepth)
+	request.SetUniqueness(int(obj.uniqueness))
+	request.SetResultShape(int(obj.resultShape))
+	request.SetEdgeLimit(obj.edgeLimit)
+	request.SetEdgeTypes(obj.edgeTypes)
+	request.SetStartIds(startIds)
+	return nil
+}
+
+// populateResultSet reads the entities reached by the traversal, then builds the results out of their ids
+func (obj *TraversalDescriptor) populateResultSet(gof tgdb.TGGraphObjectFactory, response *TraverseResponseMessage) (*ResultSet, tgdb.TGError) {
+	respStream := response.GetEntityStream().(*ProtocolDataInputStream)
+	fetchedEntities := make(map[int64]tgdb.TGEntity, 0)
+	respStream.SetReferenceMap(fetchedEntities)
+
+	entityCount, err := respStream.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:populateResultSet - unable to read the entity count w/ error: '%s'", err.Error()))
+		return nil, err
+	}
+	for i := 0; i < entityCount; i++ {
+		kind, err := respStream.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		entityId, err := respStream.ReadLong()
+		if err != nil {
+			return nil, err
+		}
+		entity := fetchedEntities[entityId]
+		if entity == nil {
+			if tgdb.TGEntityKind(kind) != tgdb.EntityKindNode && tgdb.TGEntityKind(kind) != tgdb.EntityKindEdge {
+				errMsg := fmt.Sprintf("Unexpected entity kind '%d' in the traverse response", kind)
+				return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+			}
+			entity, err = gof.CreateEntity(tgdb.TGEntityKind(kind))
+			if err != nil {
+				return nil, err
+			}
+			fetchedEntities[entityId] = entity
+		}
+		if err := entity.ReadExternal(respStream); err != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:populateResultSet - unable to read entity '%d' w/ error: '%s'", entityId, err.Error()))
+			return nil, err
+		}
+	}
+
+	resultSet := NewResultSet(obj.conn, 0)
+	resultSet.MetaData = obj.resultMetadata()
+	resultCount, err := respStream.ReadInt()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TraversalDescriptor:populateResultSet - unable to read the result count w/ error: '%s'", err.Error()))
+		return nil, err
+	}
+	for i := 0; i < resultCount; i++ {
+		length, err := respStream.ReadInt()
+		if err != nil {
+			return nil, err
+		}
+		result := make([]interface{}, 0, length)
+		for j := 0; j < length; j++ {
+			entityId, err := respStream.ReadLong()
+			if err != nil {
+				return nil, err
+			}
+			entity, ok := fetchedEntities[entityId]
+			if !ok {
+				errMsg := fmt.Sprintf("Traverse response refers to entity '%d' that it does not hold", entityId)
+				return nil, GetErrorByType(TGErrorGeneralException, INTERNAL_SERVER_ERROR, errMsg, "")
+			}
+			result = append(result, entity)
+		}
+		if obj.resultShape != TraversalResultPaths && len(result) == 1 {
+			resultSet.AddEntityToResultSet(result[0].(tgdb.TGEntity))
+		} else {
+			resultSet.ResultList = append(resultSet.ResultList, result)
+		}
+	}
+	return resultSet, nil
+}
+
+func (obj *TraversalDescriptor) resultMetadata() *ResultSetMetadata {
+	metadata := DefaultResultSetMetadata()
+	var desc tgdb.TGResultDataDescriptor
+	switch obj.resultShape {
+	case TraversalResultNodes:
+		desc = NewResultDataDescriptor(tgdb.TYPE_NODE)
+	case TraversalResultEdges:
+		desc = NewResultDataDescriptor(tgdb.TYPE_EDGE)
+	default:
+		desc = NewResultDataDescriptor(tgdb.TYPE_PATH)
+	}
+	metadata.ResultDataDescriptor = &desc
+	metadata.ResultType = desc.GetDataType()
+	return metadata
+}
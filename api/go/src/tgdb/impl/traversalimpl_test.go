@@ -0,0 +1,129 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: traversalimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/mockserver"
+)
+
+// startTraversalServer serves the chain a -knows-> b -knows-> c, and returns the connected client and node a.
+// The graph is set up before the client first asks for the metadata
+func startTraversalServer(t *testing.T) (*mockserver.Server, tgdb.TGConnection, tgdb.TGNode) {
+	server, conn := connectToMock(t)
+	store := server.GetStore()
+	store.AddAttributeDescriptor("name", impl.AttributeTypeString, false)
+	if _, err := store.AddNodeType("person", "name"); err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := store.AddEdgeType("knows", tgdb.DirectionTypeDirected, "person", "person"); err != nil {
+		t.Fatal(err.Error())
+	}
+	ids := make([]int64, 0)
+	for _, name := range []string{"a", "b", "c"} {
+		node, err := store.AddNode("person", map[string]interface{}{"name": name})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		ids = append(ids, node.GetVirtualId())
+	}
+	for i := 1; i < len(ids); i++ {
+		if _, err := store.AddEdge("knows", ids[i-1], ids[i], nil); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	store.SetQueryResult("start", ids[0])
+
+	resultSet, err := conn.ExecuteQuery("start", impl.NewQueryOption())
+	if err != nil || resultSet == nil || !resultSet.HasNext() {
+		t.Fatal("Unable to fetch the starting node")
+	}
+	return server, conn, resultSet.Next().(tgdb.TGNode)
+}
+
+func TestTraversalSendsTraverseRequest(t *testing.T) {
+	server, conn, start := startTraversalServer(t)
+	defer server.Close()
+	defer conn.Disconnect()
+
+	resultSet, err := impl.NewTraversalDescriptor(conn).
+		WithEdgeTypes("knows").
+		WithDirection(tgdb.DirectionOutbound).
+		WithDepth(1, 2).
+		Execute([]tgdb.TGNode{start})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if count := server.GetRequestCount(impl.VerbTraverseRequest); count != 1 {
+		t.Fatalf("Expected 1 traverse request, got %d", count)
+	}
+	// Paths alternate nodes and edges: a-b and a-b-c
+	lengths := make([]int, 0)
+	for _, result := range resultSet.ToCollection() {
+		path, ok := result.([]interface{})
+		if !ok {
+			t.Fatalf("Expected a path, got '%T'", result)
+		}
+		if _, ok := path[len(path)-1].(tgdb.TGNode); !ok {
+			t.Fatalf("Expected a path to end with a node, got '%T'", path[len(path)-1])
+		}
+		lengths = append(lengths, len(path))
+	}
+	if len(lengths) != 2 || lengths[0] != 3 || lengths[1] != 5 {
+		t.Fatalf("Expected paths of 3 and 5 entities, got %v", lengths)
+	}
+}
+
+func TestTraversalResultShapes(t *testing.T) {
+	server, conn, start := startTraversalServer(t)
+	defer server.Close()
+	defer conn.Disconnect()
+
+	nodes, err := impl.NewTraversalDescriptor(conn).
+		WithDepth(1, 2).
+		WithResultShape(impl.TraversalResultNodes).
+		Execute([]tgdb.TGNode{start})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if nodes.Count() != 2 {
+		t.Fatalf("Expected nodes b and c, got %d result(s)", nodes.Count())
+	}
+	for _, result := range nodes.ToCollection() {
+		if _, ok := result.(tgdb.TGNode); !ok {
+			t.Fatalf("Expected a node, got '%T'", result)
+		}
+	}
+
+	// Nothing is inbound to the head of the chain
+	inbound, err := impl.NewTraversalDescriptor(conn).
+		WithDirection(tgdb.DirectionInbound).
+		WithResultShape(impl.TraversalResultEdges).
+		Execute([]tgdb.TGNode{start})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if inbound.Count() != 0 {
+		t.Fatalf("Expected no inbound edge, got %d result(s)", inbound.Count())
+	}
+}
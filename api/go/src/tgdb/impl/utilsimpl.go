@@ -1406,6 +1406,39 @@ func min(x, y int32) int32 {
 	return x
 }
 
+// tgCheckSum64 computes the TGDB standard checksum (MurmurHash64A with a zero seed) of the data
+// that accompanies bulk import and export requests
+func tgCheckSum64(data []byte) int64 {
+	const m uint64 = 0xc6a4a7935bd1e995
+	const r = 47
+
+	length := len(data)
+	h := uint64(length) * m
+
+	end := length - (length % 8)
+	for i := 0; i < end; i += 8 {
+		k := binary.LittleEndian.Uint64(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h ^= k
+		h *= m
+	}
+
+	rem := data[end:]
+	if len(rem) > 0 {
+		for i := len(rem) - 1; i >= 0; i-- {
+			h ^= uint64(rem[i]) << (8 * uint(i))
+		}
+		h *= m
+	}
+
+	h ^= h >> r
+	h *= m
+	h ^= h >> r
+	return int64(h)
+}
+
 func unquoteIfQuoted(value interface{}) (string, error) {
 	var bytes []byte
 
@@ -1442,8 +1475,9 @@ type sortFunc func(p1, p2 *KvPair) bool
 
 type SortedProperties struct {
 	properties   []*KvPair
-	sortHandlers []sortFunc // Intentionally kept Private
-	mutex        sync.Mutex // rw-lock for synchronizing read-n-update of env configuration
+	sortHandlers []sortFunc                // Intentionally kept Private
+	mutex        sync.Mutex                // rw-lock for synchronizing read-n-update of env configuration
+	credentials  tgdb.TGCredentialProvider // Set by the deprecated SetPassword in place of a password property
 }
 
 // Define Sort Handler functions
@@ -1557,6 +1591,16 @@ func DoesPropertyExist(obj *SortedProperties, name string) bool {
 	return false
 }
 
+func (obj *KvPair) String() string {
+	return fmt.Sprintf("KvPair:{KeyName: %s, KeyValue: %s}", obj.KeyName, redactProperty(obj.KeyName, obj.KeyValue))
+}
+
+func (obj *SortedProperties) String() string {
+	return fmt.Sprintf("SortedProperties:{Properties: %+v}", obj.properties)
+}
+
+// Deprecated: SetUserAndPassword hands the password to a credential provider instead of keeping it in the
+// properties. Pass the password to the connection factory, or use a TGCredentialProvider
 func SetUserAndPassword(obj *SortedProperties, user, pwd string) tgdb.TGError {
 	err := obj.SetUser(user)
 	if err != nil {
@@ -1587,17 +1631,34 @@ func (obj *SortedProperties) SetUser(user string) tgdb.TGError {
 	return nil
 }
 
+// Deprecated: SetPassword sets a static credential provider that the channel authenticates with, rather than a
+// password property. An empty password keeps the password settings already present
 func (obj *SortedProperties) SetPassword(pwd string) tgdb.TGError {
-	pwdConfig := GetConfigFromKey(ChannelPassword)
 	if len(pwd) < 1 {
-		p := obj.GetProperty(pwdConfig, "")
-		pwd = p
+		return nil
 	}
-	// AddProperty either sets the property or adds it
-	obj.AddProperty(pwdConfig.GetName(), pwd)
+	obj.credentials = NewStaticCredentialProvider("", []byte(pwd))
 	return nil
 }
 
+// RemoveProperty removes the property whether it was set by its name or its alias, and returns its value
+func (obj *SortedProperties) RemoveProperty(conf tgdb.TGConfigName) string {
+	cn := conf.(*ConfigName)
+	value := ""
+	kept := obj.properties[:0]
+	for _, kvp := range obj.properties {
+		if strings.ToLower(kvp.KeyName) == strings.ToLower(cn.GetName()) || strings.ToLower(kvp.KeyName) == strings.ToLower(cn.aliasName) {
+			if value == "" {
+				value = kvp.KeyValue
+			}
+			continue
+		}
+		kept = append(kept, kvp)
+	}
+	obj.properties = kept
+	return value
+}
+
 /////////////////////////////////////////////////////////////////
 // Implement functions from Interface ==> sort.Interface
 /////////////////////////////////////////////////////////////////
@@ -1783,16 +1844,12 @@ func (q *SimpleQueue) Enqueue(x interface{}) {
 
 // Dequeue removes and returns an entry from the queue in first to last order.
 func (q *SimpleQueue) Dequeue() interface{} {
-	for {
-		if len(q.values) > 0 {
-			q.qLock.Lock()
-			x := q.values[0]
-			q.values = q.values[1:]
-			q.qLock.Unlock()
-			return x
-		}
-		break
-		//return nil
+	q.qLock.Lock()
+	defer q.qLock.Unlock()
+	if len(q.values) > 0 {
+		x := q.values[0]
+		q.values = q.values[1:]
+		return x
 	}
 	return nil
 }
@@ -2138,6 +2195,20 @@ const (
 	KeyStorePassword
 	EnableConnectionTrace
 	ConnectionTraceDir
+	BulkIOEntityBatchSize
+	ConnectionRollbackOnCommitFailure
+	ConnectionRetryMaxAttempts
+	ConnectionRetryInitialBackoffMSecs
+	ConnectionRetryMaxBackoffMSecs
+	ConnectionRetryJitterPercent
+	ConnectionRetryableErrors
+	ConnectionPoolMinIdle
+	ConnectionPoolMaxLifetimeSeconds
+	ConnectionPoolHealthCheckIntervalSeconds
+	TlsClientCertificate
+	TlsClientKey
+	ChannelPasswordEnv
+	ChannelPasswordFile
 	InvalidName
 )
 
@@ -2146,6 +2217,7 @@ type ConfigName struct {
 	aliasName      string
 	defaultValue   string
 	description    string
+	sensitive      bool // The value is a secret, and is masked in logs and String() output
 }
 
 var PreDefinedConfigurations = map[int]ConfigName{
@@ -2161,7 +2233,7 @@ var PreDefinedConfigurations = map[int]ConfigName{
 	ChannelFTRetryCount:                            {configPropName: "tgdb.channel.ftRetryCount", aliasName: "ftRetryCount", defaultValue: "3", description: "The number of times ro retry"},
 	ChannelDefaultUserID:                           {configPropName: "tgdb.channel.defaultUserID", aliasName: "defaultUserID", defaultValue: "", description: "The default user id for the connection"},
 	ChannelUserID:                                  {configPropName: "tgdb.channel.userID", aliasName: "userID", defaultValue: "", description: "The user id for the connection if it is not specified in the API. See the rules for picking the user Name"},
-	ChannelPassword:                                {configPropName: "tgdb.channel.password", aliasName: "password", defaultValue: "", description: "The password for the username"},
+	ChannelPassword:                                {configPropName: "tgdb.channel.password", aliasName: "password", defaultValue: "", description: "The password for the username. It is handed to a credential provider and not kept in the properties", sensitive: true},
 	ChannelClientId:                                {configPropName: "tgdb.channel.clientId", aliasName: "clientId", defaultValue: "tgdb.go-api.client", description: "The client id to be used for the connection"},
 	ConnectionDatabaseName:                         {configPropName: "tgdb.connection.dbName", aliasName: "dbName", defaultValue: "", description: "The database Name the client is connecting to. It is used as part of verification for ssl channels"},
 	ConnectionPoolUseDedicatedChannelPerConnection: {configPropName: "tgdb.connectionpool.useDedicatedChannelPerConnection", aliasName: "useDedicatedChannelPerConnection", defaultValue: "false", description: ""},
@@ -2169,27 +2241,41 @@ var PreDefinedConfigurations = map[int]ConfigName{
 	//0 = mean immediate, Integer Max for indefinite
 	ConnectionReserveTimeoutSeconds: {configPropName: "tgdb.connectionpool.connectionReserveTimeoutSeconds", aliasName: "connectionReserveTimeoutSeconds", defaultValue: "10", description: "A timeout parameter indicating how long to wait before getting a connection from the pool"},
 	//Represented in ms. Default Value is 10sec
-	ConnectionOperationTimeoutSeconds: {configPropName: "tgdb.connection.operationTimeoutSeconds", aliasName: "connectionOperationTimeoutSeconds", defaultValue: "10", description: "A timeout parameter indicating how long to wait for a operation before giving up. Some queries are long running, and may override this behavior"},
-	ConnectionDateFormat:              {configPropName: "tgdb.connection.dateFormat", aliasName: "dateFormat", defaultValue: "YYYY-MM-DD", description: "Date format for this connection"},
-	ConnectionTimeFormat:              {configPropName: "tgdb.connection.timeFormat", aliasName: "timeFormat", defaultValue: "HH:mm:ss", description: "Date format for this connection"},
-	ConnectionTimeStampFormat:         {configPropName: "tgdb.connection.timeStampFormat", aliasName: "timeStampFormat", defaultValue: "YYYY-MM-DD HH:mm:ss.zzz", description: "Timestamp format for this connection"},
-	ConnectionLocale:                  {configPropName: "tgdb.connection.locale", aliasName: "locale", defaultValue: "en_US", description: "Locale for this connection"},
-	ConnectionDefaultQueryLanguage:    {configPropName: "tgdb.connection.defaultQueryLanguage", aliasName: "queryLanguage", defaultValue: "tgql", description: "Default query lanaguge format for this connection"},
+	ConnectionOperationTimeoutSeconds:  {configPropName: "tgdb.connection.operationTimeoutSeconds", aliasName: "connectionOperationTimeoutSeconds", defaultValue: "10", description: "A timeout parameter indicating how long to wait for a operation before giving up. Some queries are long running, and may override this behavior"},
+	ConnectionDateFormat:               {configPropName: "tgdb.connection.dateFormat", aliasName: "dateFormat", defaultValue: "YYYY-MM-DD", description: "Date format for this connection"},
+	ConnectionTimeFormat:               {configPropName: "tgdb.connection.timeFormat", aliasName: "timeFormat", defaultValue: "HH:mm:ss", description: "Date format for this connection"},
+	ConnectionTimeStampFormat:          {configPropName: "tgdb.connection.timeStampFormat", aliasName: "timeStampFormat", defaultValue: "YYYY-MM-DD HH:mm:ss.zzz", description: "Timestamp format for this connection"},
+	ConnectionLocale:                   {configPropName: "tgdb.connection.locale", aliasName: "locale", defaultValue: "en_US", description: "Locale for this connection"},
+	ConnectionDefaultQueryLanguage:     {configPropName: "tgdb.connection.defaultQueryLanguage", aliasName: "queryLanguage", defaultValue: "tgql", description: "Default query lanaguge format for this connection"},
 	// TODO: Ask TGDB Engineering Team
 	TlsProviderName: {configPropName: "tgdb.tls.provider.Name", aliasName: "tlsProviderName", defaultValue: "SunJSSE", description: "Transport level Security provider. Work with your InfoSec team to change this value"},
 	// TODO: Ask TGDB Engineering Team - The default is the Sun JSSE. One can specify the tibco wrapper class for FIPS
 	TlsProviderClassName:  {configPropName: "tgdb.tls.provider.className", aliasName: "tlsProviderClassName", defaultValue: "com.sun.net.ssl.internal.ssl.Provider", description: "The underlying Provider implementation. Work with your InfoSec team to change this value"},
 	TlsProviderConfigFile: {configPropName: "tgdb.tls.provider.configFile", aliasName: "tlsProviderConfigFile", defaultValue: "", description: "Some providers require extra configuration paramters, and it can be passed as a file"},
-	TlsProtocol:           {configPropName: "tgdb.tls.protocol", aliasName: "tlsProtocol", defaultValue: "TLSv1.2", description: "TLSProtocol version. The system only supports 1.2+"},
+	TlsProtocol:           {configPropName: "tgdb.tls.protocol", aliasName: "tlsProtocol", defaultValue: "TLSv1.2", description: "The minimum TLS protocol version, TLSv1.2 or TLSv1.3. The system only supports 1.2+"},
 	//Use the Default Cipher Suites
-	TlsCipherSuites:        {configPropName: "tgdb.tls.cipherSuites", aliasName: "cipherSuites", defaultValue: "", description: "A list cipher suites that the InfoSec team has cleared. The default list is a common list of JSSE's cipher list and Openssl list that supports 1.2 protocol"},
+	TlsCipherSuites:        {configPropName: "tgdb.tls.cipherSuites", aliasName: "cipherSuites", defaultValue: "", description: "A comma separated list of cipher suites, by IANA or OpenSSL name, that the InfoSec team has cleared. The default is the AES-GCM suites the server supports. Insecure suites such as RC4 and 3DES are only offered when listed here"},
 	TlsVerifyDatabaseName:  {configPropName: "tgdb.tls.verifyDBName", aliasName: "verifyDBName", defaultValue: "false", description: "Verify the Database Name in the certificate. TGDB provides self signed certificate for easy-to-use SSL"},
-	TlsExpectedHostName:    {configPropName: "tgdb.tls.expectedHostName", aliasName: "expectedHostName", defaultValue: "", description: "The expected hostName for the certificate. This is for future use"},
-	TlsTrustedCertificates: {configPropName: "tgdb.tls.trustedCertificates", aliasName: "trustedCertificates", defaultValue: "", description: "The list of trusted Certificates"},
-	KeyStorePassword:       {configPropName: "tgdb.security.keyStorePassword", aliasName: "keyStorePassword", defaultValue: "", description: "The Keystore for the password"},
-	EnableConnectionTrace:  {configPropName: "tgdb.connection.enableTrace", aliasName: "enableTrace", defaultValue: "false", description: "The flag for debugging purpose, to enable the commit trace"},
-	ConnectionTraceDir:     {configPropName: "tgdb.connection.enableTraceDir", aliasName: "enableTraceDir", defaultValue: ".", description: "The base directory to hold commit trace log"},
-	InvalidName:            {configPropName: "", aliasName: "", defaultValue: "", description: ""},
+	TlsExpectedHostName:    {configPropName: "tgdb.tls.expectedHostName", aliasName: "expectedHostName", defaultValue: "", description: "The host name the server certificate is verified against. The default is the host of the channel URL"},
+	TlsTrustedCertificates: {configPropName: "tgdb.tls.trustedCertificates", aliasName: "trustedCertificates", defaultValue: "", description: "A comma separated list of PEM files with the certificates the server certificate is verified against. The default is the system certificate pool"},
+	KeyStorePassword:       {configPropName: "tgdb.security.keyStorePassword", aliasName: "keyStorePassword", defaultValue: "", description: "The passphrase of an encrypted client key or PKCS#12 file", sensitive: true},
+	EnableConnectionTrace:                    {configPropName: "tgdb.connection.enableTrace", aliasName: "enableTrace", defaultValue: "false", description: "The flag for debugging purpose, to capture the PDUs sent and received on the wire"},
+	ConnectionTraceDir:                       {configPropName: "tgdb.connection.enableTraceDir", aliasName: "enableTraceDir", defaultValue: ".", description: "The base directory to hold the wire capture files"},
+	BulkIOEntityBatchSize:                    {configPropName: "tgdb.bulkIO.entityBatchSize", aliasName: "bulkIOEntityBatchSize", defaultValue: "1000", description: "The maximum number of entities the server sends per batch during a bulk export"},
+	ConnectionRollbackOnCommitFailure:        {configPropName: "tgdb.connection.rollbackOnCommitFailure", aliasName: "rollbackOnCommitFailure", defaultValue: "false", description: "Discard the pending changes when the server rejects a commit. By default they are kept so the caller can fix and retry"},
+	ConnectionRetryMaxAttempts:               {configPropName: "tgdb.connection.retryMaxAttempts", aliasName: "retryMaxAttempts", defaultValue: "4", description: "The number of times a query or a closure-based commit is tried before its error is returned. 1 disables retries"},
+	ConnectionRetryInitialBackoffMSecs:       {configPropName: "tgdb.connection.retryInitialBackoffMSecs", aliasName: "retryInitialBackoffMSecs", defaultValue: "100", description: "The wait in ms before the first retry. It doubles on every further retry"},
+	ConnectionRetryMaxBackoffMSecs:           {configPropName: "tgdb.connection.retryMaxBackoffMSecs", aliasName: "retryMaxBackoffMSecs", defaultValue: "5000", description: "The longest wait in ms between two retries"},
+	ConnectionRetryJitterPercent:             {configPropName: "tgdb.connection.retryJitterPercent", aliasName: "retryJitterPercent", defaultValue: "20", description: "The percentage of each retry wait that is randomized, so that clients do not retry in lock step"},
+	ConnectionRetryableErrors:                {configPropName: "tgdb.connection.retryableErrors", aliasName: "retryableErrors", defaultValue: "TGErrorRetryIOException", description: "Comma separated error codes, e.g. TGErrorRetryIOException,TGErrorConnectionTimeout, that are retried"},
+	ConnectionPoolMinIdle:                    {configPropName: "tgdb.connectionpool.minIdle", aliasName: "minIdle", defaultValue: "1", description: "The number of idle connections the pool keeps connected. The others are connected when they are first handed out"},
+	ConnectionPoolMaxLifetimeSeconds:         {configPropName: "tgdb.connectionpool.maxLifetimeSeconds", aliasName: "maxLifetimeSeconds", defaultValue: "0", description: "How long a connection is used before the pool replaces it. 0 means forever"},
+	ConnectionPoolHealthCheckIntervalSeconds: {configPropName: "tgdb.connectionpool.healthCheckIntervalSeconds", aliasName: "healthCheckIntervalSeconds", defaultValue: "30", description: "How often the pool checks with the server that its idle connections still get answers, and replaces the broken ones. 0 disables the check"},
+	TlsClientCertificate:                     {configPropName: "tgdb.tls.clientCertificate", aliasName: "clientCertificate", defaultValue: "", description: "The certificate presented to the server - a PEM file with the certificate chain, or a PKCS#12 (.p12 / .pfx) file holding the chain and the private key"},
+	TlsClientKey:                             {configPropName: "tgdb.tls.clientKey", aliasName: "clientKey", defaultValue: "", description: "The PEM file with the private key of the client certificate. Not needed for PKCS#12 files, or when the certificate file holds the key"},
+	ChannelPasswordEnv:                       {configPropName: "tgdb.channel.passwordEnv", aliasName: "passwordEnv", defaultValue: "", description: "The environment variable to read the password from each time the channel authenticates"},
+	ChannelPasswordFile:                      {configPropName: "tgdb.channel.passwordFile", aliasName: "passwordFile", defaultValue: "", description: "The file to read the password from. It is read again whenever it changes, so the password can be rotated"},
+	InvalidName:                              {configPropName: "", aliasName: "", defaultValue: "", description: ""},
 }
 
 // Make sure that the ConfigName implements the TGConfigName interface
@@ -2258,3 +2344,8 @@ func (c *ConfigName) GetDesc() string {
 func (c *ConfigName) SetDesc(desc string) {
 	c.description = desc
 }
+
+// IsSensitive checks whether the configuration value is a secret
+func (c *ConfigName) IsSensitive() bool {
+	return c.sensitive
+}
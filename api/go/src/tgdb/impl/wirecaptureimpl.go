@@ -0,0 +1,255 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: wirecaptureimpl.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"tgdb"
+	"time"
+)
+
+// A wire capture file starts with a header
+//
+//	magic            [8]byte  "TGDBWCAP"
+//	format version   uint16   WireCaptureFormatVersion
+//	protocol version uint16   GetProtocolVersion() of the client that wrote the capture
+//	created at       int64    Unix time in nanoseconds
+//	client id length uint16
+//	client id        []byte
+//
+// followed by one record per PDU
+//
+//	timestamp        int64    Unix time in nanoseconds
+//	direction        uint8    WireSent or WireReceived
+//	length           uint32
+//	pdu              []byte   the PDU exactly as it was on the wire, starting with its own length
+//
+// All the integers are big-endian, like the PDUs themselves.
+const (
+	WireCaptureMagic         = "TGDBWCAP"
+	WireCaptureFormatVersion = 1
+
+	TGDB_WIRECAPTURE_ERROR string = "TGDB-WIRECAPTURE-ERR"
+
+	maxWireCaptureRecordLength = 1 << 30
+)
+
+// WireDirection tells which way a captured PDU went
+type WireDirection uint8
+
+const (
+	WireSent     WireDirection = 1 // from the client to the server
+	WireReceived WireDirection = 2 // from the server to the client
+)
+
+func (dir WireDirection) String() string {
+	switch dir {
+	case WireSent:
+		return "sent"
+	case WireReceived:
+		return "received"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(dir))
+	}
+}
+
+// WireCaptureHeader describes a capture file
+type WireCaptureHeader struct {
+	FormatVersion   uint16
+	ProtocolVersion uint16
+	CreatedAt       time.Time
+	ClientId        string
+}
+
+// WireCaptureRecord is one PDU of a capture file
+type WireCaptureRecord struct {
+	Timestamp time.Time
+	Direction WireDirection
+	Pdu       []byte
+}
+
+// WireCaptureWriter writes a capture file
+type WireCaptureWriter struct {
+	out *bufio.Writer
+}
+
+// NewWireCaptureWriter writes the capture header for this client's protocol version to out
+func NewWireCaptureWriter(out io.Writer, clientId string) (*WireCaptureWriter, tgdb.TGError) {
+	obj := &WireCaptureWriter{out: bufio.NewWriter(out)}
+	header := make([]byte, 0, len(WireCaptureMagic)+14+len(clientId))
+	header = append(header, WireCaptureMagic...)
+	header = appendUint16(header, WireCaptureFormatVersion)
+	header = appendUint16(header, GetProtocolVersion())
+	header = appendUint64(header, uint64(time.Now().UnixNano()))
+	header = appendUint16(header, uint16(len(clientId)))
+	header = append(header, clientId...)
+	if _, err := obj.out.Write(header); err != nil {
+		return nil, wireCaptureError("unable to write the capture header", err)
+	}
+	return obj, nil
+}
+
+// WriteRecord appends a PDU to the capture
+func (obj *WireCaptureWriter) WriteRecord(record *WireCaptureRecord) tgdb.TGError {
+	prefix := make([]byte, 0, 13)
+	prefix = appendUint64(prefix, uint64(record.Timestamp.UnixNano()))
+	prefix = append(prefix, byte(record.Direction))
+	prefix = appendUint32(prefix, uint32(len(record.Pdu)))
+	if _, err := obj.out.Write(prefix); err != nil {
+		return wireCaptureError("unable to write a capture record", err)
+	}
+	if _, err := obj.out.Write(record.Pdu); err != nil {
+		return wireCaptureError("unable to write a capture record", err)
+	}
+	return nil
+}
+
+// Flush writes any buffered records to the underlying writer
+func (obj *WireCaptureWriter) Flush() tgdb.TGError {
+	if err := obj.out.Flush(); err != nil {
+		return wireCaptureError("unable to flush the capture", err)
+	}
+	return nil
+}
+
+// WireCaptureReader reads a capture file
+type WireCaptureReader struct {
+	in     *bufio.Reader
+	header WireCaptureHeader
+}
+
+// NewWireCaptureReader reads and checks the capture header from in
+func NewWireCaptureReader(in io.Reader) (*WireCaptureReader, tgdb.TGError) {
+	obj := &WireCaptureReader{in: bufio.NewReader(in)}
+	fixed := make([]byte, len(WireCaptureMagic)+14)
+	if _, err := io.ReadFull(obj.in, fixed); err != nil {
+		return nil, wireCaptureError("unable to read the capture header", err)
+	}
+	if string(fixed[:len(WireCaptureMagic)]) != WireCaptureMagic {
+		return nil, GetErrorByType(TGErrorIOException, TGDB_WIRECAPTURE_ERROR, "Not a wire capture file", "")
+	}
+	fixed = fixed[len(WireCaptureMagic):]
+	obj.header.FormatVersion = binary.BigEndian.Uint16(fixed[0:])
+	if obj.header.FormatVersion != WireCaptureFormatVersion {
+		errMsg := fmt.Sprintf("Unsupported wire capture format version %d", obj.header.FormatVersion)
+		return nil, GetErrorByType(TGErrorIOException, TGDB_WIRECAPTURE_ERROR, errMsg, "")
+	}
+	obj.header.ProtocolVersion = binary.BigEndian.Uint16(fixed[2:])
+	obj.header.CreatedAt = time.Unix(0, int64(binary.BigEndian.Uint64(fixed[4:])))
+	clientId := make([]byte, binary.BigEndian.Uint16(fixed[12:]))
+	if _, err := io.ReadFull(obj.in, clientId); err != nil {
+		return nil, wireCaptureError("unable to read the capture header", err)
+	}
+	obj.header.ClientId = string(clientId)
+	return obj, nil
+}
+
+// GetHeader returns the capture header
+func (obj *WireCaptureReader) GetHeader() WireCaptureHeader {
+	return obj.header
+}
+
+// ReadRecord returns the next PDU of the capture, or nil and no error at the end of the capture
+func (obj *WireCaptureReader) ReadRecord() (*WireCaptureRecord, tgdb.TGError) {
+	prefix := make([]byte, 13)
+	if _, err := io.ReadFull(obj.in, prefix); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, wireCaptureError("unable to read a capture record", err)
+	}
+	length := binary.BigEndian.Uint32(prefix[9:])
+	if length > maxWireCaptureRecordLength {
+		errMsg := fmt.Sprintf("Capture record of %d bytes is too large", length)
+		return nil, GetErrorByType(TGErrorIOException, TGDB_WIRECAPTURE_ERROR, errMsg, "")
+	}
+	record := &WireCaptureRecord{
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(prefix[0:]))),
+		Direction: WireDirection(prefix[8]),
+		Pdu:       make([]byte, length),
+	}
+	if _, err := io.ReadFull(obj.in, record.Pdu); err != nil {
+		return nil, wireCaptureError("unable to read a capture record", err)
+	}
+	return record, nil
+}
+
+func wireCaptureError(errMsg string, err error) tgdb.TGError {
+	return GetErrorByType(TGErrorIOException, TGDB_WIRECAPTURE_ERROR, errMsg, err.Error())
+}
+
+func appendUint16(buf []byte, v uint16) []byte {
+	return append(buf, byte(v>>8), byte(v))
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func appendUint64(buf []byte, v uint64) []byte {
+	return appendUint32(appendUint32(buf, uint32(v>>32)), uint32(v))
+}
+
+// WireHeader is the header of a PDU, decoded without creating the message so that it can be shown even
+// when the message itself cannot be decoded, e.g. because of a protocol version mismatch
+type WireHeader struct {
+	BufLength       int32  `json:"bufLength"`
+	Magic           int32  `json:"magic"`
+	ProtocolVersion uint16 `json:"protocolVersion"`
+	VerbId          int    `json:"verbId"`
+	VerbName        string `json:"verbName"`
+	SequenceNo      int64  `json:"sequenceNo"`
+	Timestamp       int64  `json:"timestamp"`
+	RequestId       int64  `json:"requestId"`
+	AuthToken       int64  `json:"authToken"`
+	SessionId       int64  `json:"sessionId"`
+	TenantId        int16  `json:"tenantId"`
+	DataOffset      int16  `json:"dataOffset"`
+}
+
+// wireHeaderLength is the size of the header written by APMWriteHeader
+const wireHeaderLength = 56
+
+// DecodeWireHeader decodes the header at the start of pdu
+func DecodeWireHeader(pdu []byte) (*WireHeader, tgdb.TGError) {
+	if len(pdu) < wireHeaderLength {
+		errMsg := fmt.Sprintf("PDU of %d bytes is shorter than a message header", len(pdu))
+		return nil, GetErrorByType(TGErrorInvalidMessageLength, TGDB_WIRECAPTURE_ERROR, errMsg, "")
+	}
+	header := &WireHeader{
+		BufLength:       int32(binary.BigEndian.Uint32(pdu[0:])),
+		Magic:           int32(binary.BigEndian.Uint32(pdu[4:])),
+		ProtocolVersion: binary.BigEndian.Uint16(pdu[8:]),
+		VerbId:          int(int16(binary.BigEndian.Uint16(pdu[10:]))),
+		SequenceNo:      int64(binary.BigEndian.Uint64(pdu[12:])),
+		Timestamp:       int64(binary.BigEndian.Uint64(pdu[20:])),
+		RequestId:       int64(binary.BigEndian.Uint64(pdu[28:])),
+		AuthToken:       int64(binary.BigEndian.Uint64(pdu[36:])),
+		SessionId:       int64(binary.BigEndian.Uint64(pdu[44:])),
+		TenantId:        int16(binary.BigEndian.Uint16(pdu[52:])),
+		DataOffset:      int16(binary.BigEndian.Uint16(pdu[54:])),
+	}
+	header.VerbName = GetVerb(header.VerbId).GetName()
+	return header, nil
+}
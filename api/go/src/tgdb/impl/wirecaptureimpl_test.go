@@ -0,0 +1,97 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: wirecaptureimpl_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package impl_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"tgdb/impl"
+	"tgdb/mockserver"
+)
+
+func TestWireCaptureHoldsNoPassword(t *testing.T) {
+	const password = "Sup3r-Secret!"
+	traceDir, err := ioutil.TempDir("", "tgdb-wirecapture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(traceDir)
+
+	server := mockserver.DefaultServer()
+	server.SetCredentials("scott", password)
+	if err := server.Start(); err != nil {
+		t.Fatalf("Unable to start the mock server: %s", err.Error())
+	}
+	defer server.Close()
+	env := map[string]string{
+		"tgdb.connection.enableTrace":    "true",
+		"tgdb.connection.enableTraceDir": traceDir,
+		"tgdb.channel.clientId":          "capture-test",
+	}
+	conn, tgErr := impl.NewTGConnectionFactory().CreateConnection(server.GetUrl(), "scott", password, env)
+	if tgErr != nil {
+		t.Fatal(tgErr.Error())
+	}
+	if tgErr := conn.Connect(); tgErr != nil {
+		t.Fatal(tgErr.Error())
+	}
+	conn.Disconnect()
+	// Stopping the tracer writes out the queued records
+	conn.GetChannel().GetTracer().Stop()
+
+	captures, err := filepath.Glob(filepath.Join(traceDir, "capture-test.trace.*"))
+	if err != nil || len(captures) == 0 {
+		t.Fatalf("Expected a capture file in '%s'", traceDir)
+	}
+	authenticateRequests := 0
+	for _, capture := range captures {
+		data, err := ioutil.ReadFile(capture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(data, []byte(password)) {
+			t.Fatalf("Capture '%s' holds the password", capture)
+		}
+		reader, tgErr := impl.NewWireCaptureReader(bytes.NewReader(data))
+		if tgErr != nil {
+			t.Fatal(tgErr.Error())
+		}
+		for {
+			record, tgErr := reader.ReadRecord()
+			if tgErr != nil {
+				t.Fatal(tgErr.Error())
+			}
+			if record == nil {
+				break
+			}
+			verb, tgErr := impl.VerbIdFromBytes(record.Pdu)
+			if tgErr == nil && verb.GetID() == impl.VerbAuthenticateRequest {
+				authenticateRequests++
+			}
+		}
+	}
+	if authenticateRequests == 0 {
+		t.Fatal("Expected the capture to hold the authenticate request")
+	}
+}
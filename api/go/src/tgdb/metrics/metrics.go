@@ -0,0 +1,287 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: metrics.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+// Package metrics exports client and server metrics in the Prometheus text exposition format.
+// An Exporter records the count and latency of every request sent on any channel, the statistics
+// of the connection pools added to it and the server information polled through an admin
+// connection, and serves them as an http.Handler.
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"tgdb"
+	"tgdb/impl"
+	"time"
+)
+
+const (
+	TGDB_METRICS_ERROR string = "TGDB-METRICS-ERR"
+
+	// ContentType is the content type of the Prometheus text exposition format served by an Exporter
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// DefaultLatencyBuckets are the upper bounds, in seconds, of the request latency histogram buckets
+var DefaultLatencyBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+var logger = impl.DefaultTGLogManager().GetLogger()
+
+type requestStats struct {
+	succeeded int64
+	failed    int64
+	buckets   []int64
+	sum       float64
+}
+
+type namedPool struct {
+	name string
+	pool tgdb.TGConnectionPool
+}
+
+// Exporter collects the metrics and serves them in the Prometheus text exposition format
+type Exporter struct {
+	lock       sync.Mutex
+	buckets    []float64
+	requests   map[string]*requestStats
+	pools      []namedPool
+	serverInfo serverInfoSnapshot
+	stopPoll   chan bool
+	closed     bool
+}
+
+// NewExporter returns an Exporter that records every request completed on any channel
+// until it is closed, using the DefaultLatencyBuckets
+func NewExporter() *Exporter {
+	return NewExporterWithBuckets(DefaultLatencyBuckets)
+}
+
+// NewExporterWithBuckets returns an Exporter that records every request completed on any channel
+// until it is closed, using the given latency histogram bucket upper bounds in seconds
+func NewExporterWithBuckets(buckets []float64) *Exporter {
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+	obj := &Exporter{
+		buckets:  sorted,
+		requests: make(map[string]*requestStats),
+	}
+	impl.AddRequestObserver(obj)
+	return obj
+}
+
+// OnRequestCompleted records a request completed on a channel. It implements tgdb.TGRequestObserver
+func (obj *Exporter) OnRequestCompleted(verb string, latency time.Duration, err tgdb.TGError) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	stats, ok := obj.requests[verb]
+	if !ok {
+		stats = &requestStats{buckets: make([]int64, len(obj.buckets))}
+		obj.requests[verb] = stats
+	}
+	if err != nil {
+		stats.failed++
+	} else {
+		stats.succeeded++
+	}
+	seconds := latency.Seconds()
+	stats.sum += seconds
+	for i, bound := range obj.buckets {
+		if seconds <= bound {
+			stats.buckets[i]++
+			break
+		}
+	}
+}
+
+// AddConnectionPool exports the statistics of pool, labelled with name
+func (obj *Exporter) AddConnectionPool(name string, pool tgdb.TGConnectionPool) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.pools = append(obj.pools, namedPool{name: name, pool: pool})
+}
+
+// Close stops recording requests and polling the server information
+func (obj *Exporter) Close() {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if obj.closed {
+		return
+	}
+	obj.closed = true
+	impl.RemoveRequestObserver(obj)
+	if obj.stopPoll != nil {
+		close(obj.stopPoll)
+		obj.stopPoll = nil
+	}
+}
+
+// ServeHTTP writes the current metrics in the Prometheus text exposition format
+func (obj *Exporter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var buf bytes.Buffer
+	if err := obj.Export(&buf); err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning Exporter:ServeHTTP - failed to export metrics w/ error: '%s'", err.Error()))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.Write(buf.Bytes())
+}
+
+// Export writes the current metrics to out in the Prometheus text exposition format
+func (obj *Exporter) Export(out io.Writer) error {
+	mw := &metricWriter{}
+	obj.exportRequests(mw)
+	obj.exportPools(mw)
+	obj.exportServerInfo(mw)
+	_, err := out.Write(mw.buf.Bytes())
+	return err
+}
+
+func (obj *Exporter) exportRequests(mw *metricWriter) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	verbs := make([]string, 0, len(obj.requests))
+	for verb := range obj.requests {
+		verbs = append(verbs, verb)
+	}
+	sort.Strings(verbs)
+
+	mw.header("tgdb_client_requests_total", "Requests sent to the server, by verb and result.", "counter")
+	for _, verb := range verbs {
+		stats := obj.requests[verb]
+		mw.sample("tgdb_client_requests_total", float64(stats.succeeded), "verb", verb, "result", "success")
+		mw.sample("tgdb_client_requests_total", float64(stats.failed), "verb", verb, "result", "error")
+	}
+
+	mw.header("tgdb_client_request_duration_seconds", "Time from sending a request to receiving its response, by verb.", "histogram")
+	for _, verb := range verbs {
+		stats := obj.requests[verb]
+		var cumulative int64
+		for i, bound := range obj.buckets {
+			cumulative += stats.buckets[i]
+			mw.sample("tgdb_client_request_duration_seconds_bucket", float64(cumulative), "verb", verb, "le", formatValue(bound))
+		}
+		count := stats.succeeded + stats.failed
+		mw.sample("tgdb_client_request_duration_seconds_bucket", float64(count), "verb", verb, "le", "+Inf")
+		mw.sample("tgdb_client_request_duration_seconds_sum", stats.sum, "verb", verb)
+		mw.sample("tgdb_client_request_duration_seconds_count", float64(count), "verb", verb)
+	}
+}
+
+func (obj *Exporter) exportPools(mw *metricWriter) {
+	obj.lock.Lock()
+	pools := append([]namedPool(nil), obj.pools...)
+	obj.lock.Unlock()
+	if len(pools) == 0 {
+		return
+	}
+	stats := make([]tgdb.TGConnectionPoolStats, len(pools))
+	for i, p := range pools {
+		stats[i] = p.pool.Stats()
+	}
+	poolMetrics := []struct {
+		name  string
+		help  string
+		kind  string
+		value func(s tgdb.TGConnectionPoolStats) float64
+	}{
+		{"tgdb_pool_size", "Connections in the pool.", "gauge", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.PoolSize) }},
+		{"tgdb_pool_in_use", "Connections handed out and not yet released.", "gauge", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.InUse) }},
+		{"tgdb_pool_idle", "Connections waiting in the pool.", "gauge", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Idle) }},
+		{"tgdb_pool_waiting", "Callers waiting for a connection.", "gauge", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Waiting) }},
+		{"tgdb_pool_acquisitions_total", "Connections handed out by the pool.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Acquisitions) }},
+		{"tgdb_pool_waits_total", "Acquisitions that had to wait for a connection.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Waits) }},
+		{"tgdb_pool_wait_seconds_total", "Time spent waiting for a connection.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return s.WaitTime.Seconds() }},
+		{"tgdb_pool_timeouts_total", "Callers that gave up waiting for a connection.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Timeouts) }},
+		{"tgdb_pool_evictions_total", "Connections evicted from the pool.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Evictions) }},
+		{"tgdb_pool_reconnects_total", "Channel reconnects of the pool connections.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Reconnects) }},
+		{"tgdb_pool_retries_total", "Queries and commits retried on the pool connections.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.Retries) }},
+		{"tgdb_pool_retry_failures_total", "Retried queries and commits that still failed.", "counter", func(s tgdb.TGConnectionPoolStats) float64 { return float64(s.RetryFailures) }},
+	}
+	for _, m := range poolMetrics {
+		mw.header(m.name, m.help, m.kind)
+		for i, p := range pools {
+			mw.sample(m.name, m.value(stats[i]), "pool", p.name)
+		}
+	}
+}
+
+// metricWriter formats metric families in the Prometheus text exposition format
+type metricWriter struct {
+	buf bytes.Buffer
+}
+
+func (obj *metricWriter) header(name, help, kind string) {
+	fmt.Fprintf(&obj.buf, "# HELP %s %s\n", name, escapeHelp(help))
+	fmt.Fprintf(&obj.buf, "# TYPE %s %s\n", name, kind)
+}
+
+// sample writes one sample of the metric; labels holds label name and value pairs
+func (obj *metricWriter) sample(name string, value float64, labels ...string) {
+	obj.buf.WriteString(name)
+	if len(labels) > 0 {
+		obj.buf.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				obj.buf.WriteByte(',')
+			}
+			fmt.Fprintf(&obj.buf, "%s=\"%s\"", labels[i], escapeLabelValue(labels[i+1]))
+		}
+		obj.buf.WriteByte('}')
+	}
+	obj.buf.WriteByte(' ')
+	obj.buf.WriteString(formatValue(value))
+	obj.buf.WriteByte('\n')
+}
+
+var helpEscaper = strings.NewReplacer("\\", "\\\\", "\n", "\\n")
+var labelValueEscaper = strings.NewReplacer("\\", "\\\\", "\n", "\\n", "\"", "\\\"")
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func escapeLabelValue(s string) string {
+	return labelValueEscaper.Replace(s)
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
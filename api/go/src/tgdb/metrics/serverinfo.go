@@ -0,0 +1,215 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: serverinfo.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package metrics
+
+import (
+	"fmt"
+	"tgdb"
+	"tgdb/impl"
+	"time"
+)
+
+// ServerInfoSource retrieves the server information for PollServerInfo
+type ServerInfoSource func() (tgdb.TGServerInfo, tgdb.TGError)
+
+// AdminConnectionSource returns a ServerInfoSource that calls GetInfo on conn
+func AdminConnectionSource(conn tgdb.TGAdminConnection) ServerInfoSource {
+	return conn.GetInfo
+}
+
+// PoolSource returns a ServerInfoSource that takes an admin connection from pool for each call
+// to GetInfo. The pool has to be created with tgdb.TypeAdmin
+func PoolSource(pool tgdb.TGConnectionPool) ServerInfoSource {
+	return func() (tgdb.TGServerInfo, tgdb.TGError) {
+		conn, err := pool.Get()
+		if err != nil {
+			return nil, err
+		}
+		defer pool.ReleaseConnection(conn)
+		admin, ok := conn.(tgdb.TGAdminConnection)
+		if !ok {
+			errMsg := "PoolSource - the connection pool does not hand out admin connections"
+			return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_METRICS_ERROR, errMsg, "")
+		}
+		return admin.GetInfo()
+	}
+}
+
+type serverInfoSnapshot struct {
+	polling  bool
+	info     tgdb.TGServerInfo
+	polledAt time.Time
+	failures int64
+}
+
+// PollServerInfo calls source right away and then every interval until the exporter is closed, and
+// exports the server information from the last successful call. A non-positive interval polls only once.
+// It replaces any earlier source
+func (obj *Exporter) PollServerInfo(source ServerInfoSource, interval time.Duration) {
+	obj.lock.Lock()
+	if obj.closed {
+		obj.lock.Unlock()
+		return
+	}
+	if obj.stopPoll != nil {
+		close(obj.stopPoll)
+	}
+	stop := make(chan bool)
+	obj.stopPoll = stop
+	obj.serverInfo = serverInfoSnapshot{polling: true}
+	obj.lock.Unlock()
+
+	go func() {
+		if interval <= 0 {
+			obj.pollServerInfo(source, stop)
+			return
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			obj.pollServerInfo(source, stop)
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (obj *Exporter) pollServerInfo(source ServerInfoSource, stop chan bool) {
+	info, err := source()
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if obj.stopPoll != stop {
+		return
+	}
+	if err != nil {
+		obj.serverInfo.failures++
+		logger.Warning(fmt.Sprintf("WARNING: Inside Exporter:pollServerInfo - failed to retrieve the server information w/ error: '%s'", err.Error()))
+		return
+	}
+	obj.serverInfo.info = info
+	obj.serverInfo.polledAt = time.Now()
+}
+
+func (obj *Exporter) exportServerInfo(mw *metricWriter) {
+	obj.lock.Lock()
+	snapshot := obj.serverInfo
+	obj.lock.Unlock()
+	if !snapshot.polling {
+		return
+	}
+
+	mw.header("tgdb_server_info_poll_failures_total", "Failed attempts to retrieve the server information.", "counter")
+	mw.sample("tgdb_server_info_poll_failures_total", float64(snapshot.failures))
+	if snapshot.info == nil {
+		return
+	}
+	mw.header("tgdb_server_info_last_poll_timestamp_seconds", "When the server information was last retrieved.", "gauge")
+	mw.sample("tgdb_server_info_last_poll_timestamp_seconds", float64(snapshot.polledAt.UnixNano())/1e9)
+
+	info := snapshot.info
+	if status := info.GetServerStatus(); status != nil {
+		mw.header("tgdb_server_uptime_seconds", "Time since the server started.", "gauge")
+		mw.sample("tgdb_server_uptime_seconds", status.GetUptime().Seconds(), "server", status.GetName())
+	}
+
+	if cache := info.GetCacheInfo(); cache != nil {
+		gauge(mw, "tgdb_server_data_cache_entries", "Entries in the data cache.", float64(cache.GetDataCacheEntries()))
+		gauge(mw, "tgdb_server_data_cache_max_entries", "Maximum entries in the data cache.", float64(cache.GetDataCacheMaxEntries()))
+		gauge(mw, "tgdb_server_data_cache_max_memory_bytes", "Maximum memory of the data cache.", float64(cache.GetDataCacheMaxMemory()))
+		counter(mw, "tgdb_server_data_cache_hits_total", "Data cache hits.", float64(cache.GetDataCacheHits()))
+		counter(mw, "tgdb_server_data_cache_misses_total", "Data cache misses.", float64(cache.GetDataCacheMisses()))
+		gauge(mw, "tgdb_server_index_cache_entries", "Entries in the index cache.", float64(cache.GetIndexCacheEntries()))
+		gauge(mw, "tgdb_server_index_cache_max_entries", "Maximum entries in the index cache.", float64(cache.GetIndexCacheMaxEntries()))
+		gauge(mw, "tgdb_server_index_cache_max_memory_bytes", "Maximum memory of the index cache.", float64(cache.GetIndexCacheMaxMemory()))
+		counter(mw, "tgdb_server_index_cache_hits_total", "Index cache hits.", float64(cache.GetIndexCacheHits()))
+		counter(mw, "tgdb_server_index_cache_misses_total", "Index cache misses.", float64(cache.GetIndexCacheMisses()))
+	}
+
+	if db := info.GetDatabaseInfo(); db != nil {
+		gauge(mw, "tgdb_server_database_size_bytes", "Size of the database.", float64(db.GetDbSize()))
+		gauge(mw, "tgdb_server_database_data_size_bytes", "Size of the data segments.", float64(db.GetDataSize()))
+		gauge(mw, "tgdb_server_database_data_used_bytes", "Used space in the data segments.", float64(db.GetDataUsed()))
+		gauge(mw, "tgdb_server_database_data_free_bytes", "Free space in the data segments.", float64(db.GetDataFree()))
+		gauge(mw, "tgdb_server_database_data_segments", "Number of data segments.", float64(db.GetNumDataSegments()))
+		gauge(mw, "tgdb_server_database_index_size_bytes", "Size of the index segments.", float64(db.GetIndexSize()))
+		gauge(mw, "tgdb_server_database_index_used_bytes", "Used space in the index segments.", float64(db.GetIndexUsed()))
+		gauge(mw, "tgdb_server_database_index_free_bytes", "Free space in the index segments.", float64(db.GetIndexFree()))
+		gauge(mw, "tgdb_server_database_index_segments", "Number of index segments.", float64(db.GetNumIndexSegments()))
+	}
+
+	memTypes := []struct {
+		memType tgdb.MemType
+		label   string
+	}{
+		{tgdb.MemoryProcess, "process"},
+		{tgdb.MemoryShared, "shared"},
+	}
+	memMetrics := []struct {
+		name  string
+		help  string
+		value func(m tgdb.TGMemoryInfo) int64
+	}{
+		{"tgdb_server_memory_used_bytes", "Memory used by the server.", tgdb.TGMemoryInfo.GetUsedMemory},
+		{"tgdb_server_memory_free_bytes", "Memory free in the server.", tgdb.TGMemoryInfo.GetFreeMemory},
+		{"tgdb_server_memory_max_bytes", "Maximum memory of the server.", tgdb.TGMemoryInfo.GetMaxMemory},
+	}
+	for _, m := range memMetrics {
+		mw.header(m.name, m.help, "gauge")
+		for _, t := range memTypes {
+			if mem := info.GetMemoryInfo(t.memType); mem != nil {
+				mw.sample(m.name, float64(m.value(mem)), "type", t.label)
+			}
+		}
+	}
+
+	if listeners := info.GetNetListenersInfo(); len(listeners) > 0 {
+		mw.header("tgdb_server_listener_connections", "Current connections of a server listener.", "gauge")
+		for _, l := range listeners {
+			mw.sample("tgdb_server_listener_connections", float64(l.GetCurrentConnections()), "listener", l.GetListenerName(), "port", l.GetPortNumber())
+		}
+		mw.header("tgdb_server_listener_max_connections", "Maximum connections of a server listener.", "gauge")
+		for _, l := range listeners {
+			mw.sample("tgdb_server_listener_max_connections", float64(l.GetMaxConnections()), "listener", l.GetListenerName(), "port", l.GetPortNumber())
+		}
+	}
+
+	if txn := info.GetTransactionsInfo(); txn != nil {
+		counter(mw, "tgdb_server_transactions_processed_total", "Transactions processed by the server.", float64(txn.GetTransactionProcessedCount()))
+		counter(mw, "tgdb_server_transactions_successful_total", "Transactions the server committed successfully.", float64(txn.GetTransactionSuccessfulCount()))
+		gauge(mw, "tgdb_server_transactions_pending", "Transactions waiting to be processed.", float64(txn.GetPendingTransactionsCount()))
+		gauge(mw, "tgdb_server_transaction_processors", "Transaction processors of the server.", float64(txn.GetTransactionProcessorsCount()))
+		gauge(mw, "tgdb_server_transaction_logger_queue_depth", "Queue depth of the transaction logger.", float64(txn.GetTransactionLoggerQueueDepth()))
+		gauge(mw, "tgdb_server_transaction_average_processing_time", "Average processing time of a transaction, as reported by the server.", txn.GetAverageProcessingTime())
+	}
+}
+
+func gauge(mw *metricWriter, name, help string, value float64) {
+	mw.header(name, help, "gauge")
+	mw.sample(name, value)
+}
+
+func counter(mw *metricWriter, name, help string, value float64) {
+	mw.header(name, help, "counter")
+	mw.sample(name, value)
+}
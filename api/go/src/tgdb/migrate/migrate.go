@@ -0,0 +1,159 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: migrate.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+// Package migrate applies a declarative schema of attribute descriptors, node types, edge types and
+// indices to a TGDB server. Only additive changes are made; anything else is reported as a conflict.
+// Applied schema versions are recorded as nodes of the VersionNodeType marker type.
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"tgdb"
+	"tgdb/impl"
+	"time"
+)
+
+const (
+	VersionNodeType    = "tgdb_schema_version"
+	VersionAttribute   = "tgdb_schema_version_id"
+	AppliedAtAttribute = "tgdb_schema_applied_at"
+)
+
+var logger = impl.DefaultTGLogManager().GetLogger()
+
+// Options control how Migrate runs
+type Options struct {
+	// DryRun builds and prints the plan without changing the server
+	DryRun bool
+	// IgnoreConflicts applies the additive steps even when the plan has conflicts
+	IgnoreConflicts bool
+	// Out receives the plan and progress messages. Nothing is printed when it is nil
+	Out io.Writer
+}
+
+// Migrate brings the server in line with the schema and returns the plan that was (or, for a dry run,
+// would be) applied
+func Migrate(conn tgdb.TGAdminConnection, schema *Schema, options Options) (*Plan, tgdb.TGError) {
+	plan, err := BuildPlan(conn, schema)
+	if err != nil {
+		return nil, err
+	}
+	if options.Out != nil {
+		plan.Print(options.Out)
+	}
+	if options.DryRun {
+		return plan, nil
+	}
+	if len(plan.Conflicts) > 0 && !options.IgnoreConflicts {
+		errMsg := fmt.Sprintf("Schema version '%s' has %d conflict(s) with the server - nothing applied", schema.Version, len(plan.Conflicts))
+		logger.Error(fmt.Sprintf("ERROR: Returning migrate:Migrate - %s", errMsg))
+		return plan, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MIGRATE_ERROR, errMsg, plan.Conflicts[0])
+	}
+	if err := plan.Apply(conn); err != nil {
+		return plan, err
+	}
+	if options.Out != nil && !plan.IsEmpty() {
+		fmt.Fprintf(options.Out, "Applied %d step(s)\n", len(plan.Steps))
+	}
+	return plan, nil
+}
+
+// MigrateFile loads a schema file and migrates the server to it
+func MigrateFile(conn tgdb.TGAdminConnection, path string, options Options) (*Plan, tgdb.TGError) {
+	schema, err := LoadSchema(path)
+	if err != nil {
+		return nil, err
+	}
+	return Migrate(conn, schema, options)
+}
+
+// addVersionSteps creates the marker type on first use and records the version as the last step
+func (p *Plan) addVersionSteps(server *serverSchema, version string) {
+	if _, ok := server.nodeTypes[VersionNodeType]; !ok {
+		markerAttrs := []AttributeSpec{
+			{Name: VersionAttribute, Type: "string"},
+			{Name: AppliedAtAttribute, Type: "long"},
+		}
+		for _, attr := range markerAttrs {
+			if _, ok := server.attributes[attr.Name]; !ok {
+				p.diffAttribute(server, attr)
+			}
+		}
+		attrs := []string{VersionAttribute, AppliedAtAttribute}
+		pkeys := []string{VersionAttribute}
+		p.addStep(StepCreateNodeType, VersionNodeType, describeEntityType(attrs, pkeys), func(conn tgdb.TGAdminConnection) tgdb.TGError {
+			return conn.CreateNodeType(VersionNodeType, attrs, pkeys)
+		})
+	}
+	p.addStep(StepRecordVersion, version, "", func(conn tgdb.TGAdminConnection) tgdb.TGError {
+		return recordVersion(conn, version)
+	})
+}
+
+func isVersionRecorded(conn tgdb.TGAdminConnection, version string) (bool, tgdb.TGError) {
+	gof, err := conn.GetGraphObjectFactory()
+	if err != nil {
+		return false, err
+	}
+	key, err := gof.CreateCompositeKey(VersionNodeType)
+	if err != nil {
+		return false, err
+	}
+	if err := key.SetOrCreateAttribute(VersionAttribute, version); err != nil {
+		return false, err
+	}
+	entity, err := conn.GetEntity(key, nil)
+	if err != nil {
+		return false, err
+	}
+	return entity != nil, nil
+}
+
+func recordVersion(conn tgdb.TGAdminConnection, version string) tgdb.TGError {
+	gmd, err := conn.GetGraphMetadata(true)
+	if err != nil {
+		return err
+	}
+	nodeType, err := gmd.GetNodeType(VersionNodeType)
+	if err != nil {
+		return err
+	}
+	gof, err := conn.GetGraphObjectFactory()
+	if err != nil {
+		return err
+	}
+	node, err := gof.CreateNodeInGraph(nodeType)
+	if err != nil {
+		return err
+	}
+	if err := node.SetOrCreateAttribute(VersionAttribute, version); err != nil {
+		return err
+	}
+	appliedAt := time.Now().UnixNano() / int64(time.Millisecond)
+	if err := node.SetOrCreateAttribute(AppliedAtAttribute, appliedAt); err != nil {
+		return err
+	}
+	if err := conn.InsertEntity(node); err != nil {
+		return err
+	}
+	_, err = conn.Commit()
+	return err
+}
@@ -0,0 +1,454 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: plan.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"tgdb"
+	"tgdb/impl"
+)
+
+type StepKind int
+
+const (
+	StepCreateAttribute StepKind = iota
+	StepCreateNodeType
+	StepCreateEdgeType
+	StepCreateIndex
+	StepRecordVersion
+)
+
+func (k StepKind) String() string {
+	switch k {
+	case StepCreateAttribute:
+		return "create attribute descriptor"
+	case StepCreateNodeType:
+		return "create node type"
+	case StepCreateEdgeType:
+		return "create edge type"
+	case StepCreateIndex:
+		return "create index"
+	case StepRecordVersion:
+		return "record schema version"
+	}
+	return "unknown step"
+}
+
+// Step is a single additive change to the server's schema
+type Step struct {
+	Kind   StepKind
+	Name   string
+	Detail string
+	apply  func(conn tgdb.TGAdminConnection) tgdb.TGError
+}
+
+func (s *Step) String() string {
+	if s.Detail == "" {
+		return fmt.Sprintf("%s '%s'", s.Kind, s.Name)
+	}
+	return fmt.Sprintf("%s '%s' %s", s.Kind, s.Name, s.Detail)
+}
+
+// Plan is the ordered list of steps needed to bring the server in line with a schema. Differences that
+// cannot be resolved by creating new objects are reported as conflicts and are never applied.
+type Plan struct {
+	Version        string
+	VersionApplied bool
+	Steps          []*Step
+	Conflicts      []string
+}
+
+// IsEmpty reports whether the server already matches the schema
+func (p *Plan) IsEmpty() bool {
+	return len(p.Steps) == 0
+}
+
+// Print writes a human readable form of the plan
+func (p *Plan) Print(w io.Writer) {
+	version := p.Version
+	if version == "" {
+		version = "<unversioned>"
+	}
+	switch {
+	case p.IsEmpty() && p.VersionApplied:
+		fmt.Fprintf(w, "Schema version %s is already applied - nothing to do\n", version)
+	case p.IsEmpty():
+		fmt.Fprintf(w, "Schema version %s - nothing to do\n", version)
+	default:
+		fmt.Fprintf(w, "Schema version %s - %d step(s):\n", version, len(p.Steps))
+		for i, step := range p.Steps {
+			fmt.Fprintf(w, "  %d. %s\n", i+1, step)
+		}
+	}
+	if len(p.Conflicts) > 0 {
+		fmt.Fprintf(w, "%d conflict(s) that cannot be applied automatically:\n", len(p.Conflicts))
+		for _, conflict := range p.Conflicts {
+			fmt.Fprintf(w, "  - %s\n", conflict)
+		}
+	}
+}
+
+// Apply runs the steps of the plan in order and stops at the first failure
+func (p *Plan) Apply(conn tgdb.TGAdminConnection) tgdb.TGError {
+	for i, step := range p.Steps {
+		if logger.IsDebug() {
+			logger.Debug(fmt.Sprintf("Inside migrate:Apply executing step %d: %s", i+1, step))
+		}
+		if err := step.apply(conn); err != nil {
+			errMsg := fmt.Sprintf("Schema migration failed at step %d (%s)", i+1, step)
+			logger.Error(fmt.Sprintf("ERROR: %s w/ error: '%s'", errMsg, err.Error()))
+			return impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MIGRATE_ERROR, errMsg, err.Error())
+		}
+	}
+	return nil
+}
+
+// serverSchema is a name based snapshot of the server's metadata and indices
+type serverSchema struct {
+	attributes map[string]tgdb.TGAttributeDescriptor
+	nodeTypes  map[string]tgdb.TGNodeType
+	edgeTypes  map[string]tgdb.TGEdgeType
+	nodeNames  map[int]string
+	indices    map[string]tgdb.TGIndexInfo
+}
+
+// The metadata lookups by name return typed nil pointers for unknown names, so the snapshot is built
+// from the list getters instead
+func readServerSchema(conn tgdb.TGAdminConnection) (*serverSchema, tgdb.TGError) {
+	gmd, err := conn.GetGraphMetadata(true)
+	if err != nil {
+		return nil, err
+	}
+	server := &serverSchema{
+		attributes: make(map[string]tgdb.TGAttributeDescriptor),
+		nodeTypes:  make(map[string]tgdb.TGNodeType),
+		edgeTypes:  make(map[string]tgdb.TGEdgeType),
+		nodeNames:  make(map[int]string),
+		indices:    make(map[string]tgdb.TGIndexInfo),
+	}
+	attrs, err := gmd.GetAttributeDescriptors()
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range attrs {
+		server.attributes[attr.GetName()] = attr
+	}
+	nodeTypes, err := gmd.GetNodeTypes()
+	if err != nil {
+		return nil, err
+	}
+	for _, nodeType := range nodeTypes {
+		server.nodeTypes[nodeType.GetName()] = nodeType
+		server.nodeNames[nodeType.GetEntityTypeId()] = nodeType.GetName()
+	}
+	edgeTypes, err := gmd.GetEdgeTypes()
+	if err != nil {
+		return nil, err
+	}
+	for _, edgeType := range edgeTypes {
+		server.edgeTypes[edgeType.GetName()] = edgeType
+	}
+	indices, err := conn.GetIndices()
+	if err != nil {
+		return nil, err
+	}
+	for _, index := range indices {
+		server.indices[index.GetName()] = index
+	}
+	return server, nil
+}
+
+// BuildPlan compares the schema with the server and returns the additive steps needed to apply it
+func BuildPlan(conn tgdb.TGAdminConnection, schema *Schema) (*Plan, tgdb.TGError) {
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Entering migrate:BuildPlan for schema version '%s'", schema.Version))
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	server, err := readServerSchema(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Version: schema.Version}
+	if schema.Version != "" {
+		if _, ok := server.nodeTypes[VersionNodeType]; ok {
+			applied, err := isVersionRecorded(conn, schema.Version)
+			if err != nil {
+				return nil, err
+			}
+			plan.VersionApplied = applied
+		}
+	}
+
+	attributes := make(map[string]bool)
+	for name := range server.attributes {
+		attributes[name] = true
+	}
+	for _, attr := range schema.Attributes {
+		attributes[attr.Name] = true
+		plan.diffAttribute(server, attr)
+	}
+
+	nodeTypes := make(map[string]bool)
+	for name := range server.nodeTypes {
+		nodeTypes[name] = true
+	}
+	for _, nodeType := range schema.NodeTypes {
+		nodeTypes[nodeType.Name] = true
+	}
+	for _, nodeType := range schema.NodeTypes {
+		plan.diffNodeType(server, attributes, nodeType)
+	}
+	for _, edgeType := range schema.EdgeTypes {
+		plan.diffEdgeType(server, attributes, nodeTypes, edgeType)
+	}
+	for _, index := range schema.Indices {
+		plan.diffIndex(server, attributes, nodeTypes, index)
+	}
+
+	if schema.Version != "" && !plan.VersionApplied {
+		plan.addVersionSteps(server, schema.Version)
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning migrate:BuildPlan w/ %d step(s) and %d conflict(s)", len(plan.Steps), len(plan.Conflicts)))
+	}
+	return plan, nil
+}
+
+func (p *Plan) addStep(kind StepKind, name, detail string, apply func(conn tgdb.TGAdminConnection) tgdb.TGError) {
+	p.Steps = append(p.Steps, &Step{Kind: kind, Name: name, Detail: detail, apply: apply})
+}
+
+func (p *Plan) addConflict(format string, args ...interface{}) {
+	p.Conflicts = append(p.Conflicts, fmt.Sprintf(format, args...))
+}
+
+func (p *Plan) diffAttribute(server *serverSchema, spec AttributeSpec) {
+	attrType := attributeTypesByName[strings.ToLower(spec.Type)]
+	existing, ok := server.attributes[spec.Name]
+	if !ok {
+		p.addStep(StepCreateAttribute, spec.Name, describeAttribute(spec), func(conn tgdb.TGAdminConnection) tgdb.TGError {
+			return conn.CreateAttributeDescriptor(newAttributeDescriptor(spec))
+		})
+		return
+	}
+	if existing.GetAttrType() != attrType {
+		p.addConflict("attribute '%s' is of type %s on the server but %s in the schema", spec.Name, attributeTypeName(existing.GetAttrType()), strings.ToLower(spec.Type))
+	}
+	if existing.IsAttributeArray() != spec.IsArray {
+		p.addConflict("attribute '%s' has isArray=%t on the server but isArray=%t in the schema", spec.Name, existing.IsAttributeArray(), spec.IsArray)
+	}
+	if existing.Is_Encrypted() != spec.IsEncrypted {
+		p.addConflict("attribute '%s' has isEncrypted=%t on the server but isEncrypted=%t in the schema", spec.Name, existing.Is_Encrypted(), spec.IsEncrypted)
+	}
+	if attrType == impl.AttributeTypeNumber && spec.Precision != 0 &&
+		(existing.GetPrecision() != spec.Precision || existing.GetScale() != spec.Scale) {
+		p.addConflict("attribute '%s' is number(%d,%d) on the server but number(%d,%d) in the schema", spec.Name,
+			existing.GetPrecision(), existing.GetScale(), spec.Precision, spec.Scale)
+	}
+}
+
+func (p *Plan) diffNodeType(server *serverSchema, attributes map[string]bool, spec NodeTypeSpec) {
+	existing, ok := server.nodeTypes[spec.Name]
+	if !ok {
+		if !p.checkKnownAttributes("node type", spec.Name, spec.Attributes, attributes) {
+			return
+		}
+		p.addStep(StepCreateNodeType, spec.Name, describeEntityType(spec.Attributes, spec.PrimaryKeys), func(conn tgdb.TGAdminConnection) tgdb.TGError {
+			return conn.CreateNodeType(spec.Name, spec.Attributes, spec.PrimaryKeys)
+		})
+		return
+	}
+	p.diffEntityTypeAttributes("node type", existing, spec.Attributes)
+	serverKeys := attributeNames(existing.GetPKeyAttributeDescriptors())
+	if len(spec.PrimaryKeys) > 0 && !sameStrings(serverKeys, spec.PrimaryKeys) {
+		p.addConflict("node type '%s' has primary key %v on the server but %v in the schema", spec.Name, serverKeys, spec.PrimaryKeys)
+	}
+}
+
+func (p *Plan) diffEdgeType(server *serverSchema, attributes, nodeTypes map[string]bool, spec EdgeTypeSpec) {
+	direction := directionTypesByName[strings.ToLower(spec.Direction)]
+	existing, ok := server.edgeTypes[spec.Name]
+	if !ok {
+		if !p.checkKnownAttributes("edge type", spec.Name, spec.Attributes, attributes) {
+			return
+		}
+		for _, end := range []string{spec.From, spec.To} {
+			if end != "" && !nodeTypes[end] {
+				p.addConflict("edge type '%s' refers to node type '%s' that is neither on the server nor in the schema", spec.Name, end)
+				return
+			}
+		}
+		detail := fmt.Sprintf("%s from=%s to=%s %s", directionName(direction), anyNodeType(spec.From), anyNodeType(spec.To),
+			describeEntityType(spec.Attributes, spec.PrimaryKeys))
+		p.addStep(StepCreateEdgeType, spec.Name, detail, func(conn tgdb.TGAdminConnection) tgdb.TGError {
+			return conn.CreateEdgeType(spec.Name, spec.From, spec.To, direction, spec.Attributes, spec.PrimaryKeys)
+		})
+		return
+	}
+	p.diffEntityTypeAttributes("edge type", existing, spec.Attributes)
+	if existing.GetDirectionType() != direction {
+		p.addConflict("edge type '%s' is %s on the server but %s in the schema", spec.Name,
+			directionName(existing.GetDirectionType()), directionName(direction))
+	}
+	serverFrom := server.nodeNames[existing.GetFromTypeId()]
+	serverTo := server.nodeNames[existing.GetToTypeId()]
+	if serverFrom != spec.From || serverTo != spec.To {
+		p.addConflict("edge type '%s' connects %s to %s on the server but %s to %s in the schema", spec.Name,
+			anyNodeType(serverFrom), anyNodeType(serverTo), anyNodeType(spec.From), anyNodeType(spec.To))
+	}
+}
+
+func (p *Plan) diffIndex(server *serverSchema, attributes, nodeTypes map[string]bool, spec IndexSpec) {
+	existing, ok := server.indices[spec.Name]
+	if !ok {
+		if !p.checkKnownAttributes("index", spec.Name, spec.Attributes, attributes) {
+			return
+		}
+		for _, nodeType := range spec.NodeTypes {
+			if !nodeTypes[nodeType] {
+				p.addConflict("index '%s' refers to node type '%s' that is neither on the server nor in the schema", spec.Name, nodeType)
+				return
+			}
+		}
+		detail := fmt.Sprintf("on %v attributes=%v unique=%t", spec.NodeTypes, spec.Attributes, spec.Unique)
+		p.addStep(StepCreateIndex, spec.Name, detail, func(conn tgdb.TGAdminConnection) tgdb.TGError {
+			return conn.CreateIndex(spec.Name, spec.NodeTypes, spec.Attributes, spec.Unique)
+		})
+		return
+	}
+	if existing.IsUnique() != spec.Unique {
+		p.addConflict("index '%s' has unique=%t on the server but unique=%t in the schema", spec.Name, existing.IsUnique(), spec.Unique)
+	}
+	if !sameStrings(existing.GetAttributeNames(), spec.Attributes) {
+		p.addConflict("index '%s' covers attributes %v on the server but %v in the schema", spec.Name, existing.GetAttributeNames(), spec.Attributes)
+	}
+	if len(spec.NodeTypes) > 0 && !sameStrings(existing.GetNodeTypes(), spec.NodeTypes) {
+		p.addConflict("index '%s' is on node types %v on the server but %v in the schema", spec.Name, existing.GetNodeTypes(), spec.NodeTypes)
+	}
+}
+
+// diffEntityTypeAttributes reports attributes missing from an existing type. The admin commands can only
+// create types, so these cannot be added by the migration.
+func (p *Plan) diffEntityTypeAttributes(kind string, existing tgdb.TGEntityType, attrs []string) {
+	serverAttrs := attributeNames(existing.GetAttributeDescriptors())
+	for _, attr := range attrs {
+		if !containsString(serverAttrs, attr) {
+			p.addConflict("%s '%s' exists on the server without attribute '%s'", kind, existing.GetName(), attr)
+		}
+	}
+}
+
+func (p *Plan) checkKnownAttributes(kind, name string, attrs []string, attributes map[string]bool) bool {
+	for _, attr := range attrs {
+		if !attributes[attr] {
+			p.addConflict("%s '%s' refers to attribute '%s' that is neither on the server nor in the schema", kind, name, attr)
+			return false
+		}
+	}
+	return true
+}
+
+func newAttributeDescriptor(spec AttributeSpec) *impl.AttributeDescriptor {
+	attrDesc := impl.NewAttributeDescriptorAsArray(spec.Name, attributeTypesByName[strings.ToLower(spec.Type)], spec.IsArray)
+	attrDesc.SetIsEncrypted(spec.IsEncrypted)
+	if spec.Precision != 0 {
+		attrDesc.SetPrecision(spec.Precision)
+		attrDesc.SetScale(spec.Scale)
+	}
+	return attrDesc
+}
+
+func describeAttribute(spec AttributeSpec) string {
+	detail := fmt.Sprintf("type=%s", strings.ToLower(spec.Type))
+	if spec.Precision != 0 {
+		detail += fmt.Sprintf("(%d,%d)", spec.Precision, spec.Scale)
+	}
+	if spec.IsArray {
+		detail += " array"
+	}
+	if spec.IsEncrypted {
+		detail += " encrypted"
+	}
+	return detail
+}
+
+func describeEntityType(attrs, pkeys []string) string {
+	if len(pkeys) == 0 {
+		return fmt.Sprintf("attributes=%v", attrs)
+	}
+	return fmt.Sprintf("attributes=%v primaryKey=%v", attrs, pkeys)
+}
+
+func attributeTypeName(attrType int) string {
+	for name, t := range attributeTypesByName {
+		if t == attrType && name != "bool" && name != "int" {
+			return name
+		}
+	}
+	return fmt.Sprintf("<type %d>", attrType)
+}
+
+func directionName(direction tgdb.TGDirectionType) string {
+	switch direction {
+	case tgdb.DirectionTypeUnDirected:
+		return "undirected"
+	case tgdb.DirectionTypeBiDirectional:
+		return "bidirected"
+	}
+	return "directed"
+}
+
+func anyNodeType(name string) string {
+	if name == "" {
+		return "<any>"
+	}
+	return name
+}
+
+func attributeNames(attrs []tgdb.TGAttributeDescriptor) []string {
+	names := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		names = append(names, attr.GetName())
+	}
+	return names
+}
+
+func sameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	x := append([]string(nil), a...)
+	y := append([]string(nil), b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,255 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: plan_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package migrate_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/migrate"
+	"tgdb/mockserver"
+)
+
+var createCommands = []impl.AdminCommand{
+	impl.AdminCommandCreateAttrDesc,
+	impl.AdminCommandCreateNodeType,
+	impl.AdminCommandCreateEdgeType,
+	impl.AdminCommandCreateIndex,
+}
+
+// connectAdminToMock starts a mock server holding a person node type with a unique index on its name, and
+// returns an admin connection to it
+func connectAdminToMock(t *testing.T) (*mockserver.Server, tgdb.TGAdminConnection) {
+	server := mockserver.DefaultServer()
+	store := server.GetStore()
+	store.AddAttributeDescriptor("name", impl.AttributeTypeString, false)
+	if _, err := store.AddNodeType("person", "name"); err != nil {
+		t.Fatal(err.Error())
+	}
+	store.AddIndex("name_idx", []string{"person"}, []string{"name"}, true)
+	if err := server.Start(); err != nil {
+		t.Fatal(err.Error())
+	}
+	conn, err := impl.NewTGConnectionFactory().CreateAdminConnection(server.GetUrl(), "scott", "scott", nil)
+	if err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	if err := conn.Connect(); err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	return server, conn.(tgdb.TGAdminConnection)
+}
+
+func stepNames(plan *migrate.Plan) []string {
+	names := make([]string, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		names = append(names, fmt.Sprintf("%s %s", step.Kind, step.Name))
+	}
+	return names
+}
+
+func TestBuildPlan(t *testing.T) {
+	server, conn := connectAdminToMock(t)
+	defer server.Close()
+	defer conn.Disconnect()
+
+	tests := []struct {
+		name      string
+		schema    string
+		steps     []string
+		conflicts []string
+	}{
+		{
+			name:   "matching schema",
+			schema: `{"attributes": [{"name": "name", "type": "string"}], "nodeTypes": [{"name": "person", "attributes": ["name"], "primaryKeys": ["name"]}], "indices": [{"name": "name_idx", "nodeTypes": ["person"], "attributes": ["name"], "unique": true}]}`,
+		},
+		{
+			name: "additions",
+			schema: `{"attributes": [{"name": "age", "type": "int"}],
+				"nodeTypes": [{"name": "company", "attributes": ["name"], "primaryKeys": ["name"]}],
+				"edgeTypes": [{"name": "works_for", "from": "person", "to": "company", "attributes": ["age"]}],
+				"indices": [{"name": "age_idx", "nodeTypes": ["person"], "attributes": ["age"]}]}`,
+			steps: []string{
+				"create attribute descriptor age",
+				"create node type company",
+				"create edge type works_for",
+				"create index age_idx",
+			},
+		},
+		{
+			name:   "new version",
+			schema: `{"version": "1"}`,
+			steps: []string{
+				"create attribute descriptor tgdb_schema_version_id",
+				"create attribute descriptor tgdb_schema_applied_at",
+				"create node type tgdb_schema_version",
+				"record schema version 1",
+			},
+		},
+		{
+			name: "conflicts",
+			schema: `{"attributes": [{"name": "name", "type": "int", "isArray": true}],
+				"nodeTypes": [{"name": "person", "attributes": ["name", "email"], "primaryKeys": ["email"]}],
+				"indices": [{"name": "name_idx", "nodeTypes": ["person"], "attributes": ["name"], "unique": false}]}`,
+			conflicts: []string{
+				"attribute 'name' is of type string on the server but int in the schema",
+				"attribute 'name' has isArray=false on the server but isArray=true in the schema",
+				"node type 'person' exists on the server without attribute 'email'",
+				"node type 'person' has primary key [name] on the server but [email] in the schema",
+				"index 'name_idx' has unique=true on the server but unique=false in the schema",
+			},
+		},
+		{
+			name:   "unknown references",
+			schema: `{"edgeTypes": [{"name": "owns", "from": "person", "to": "car"}], "indices": [{"name": "email_idx", "attributes": ["email"]}]}`,
+			conflicts: []string{
+				"edge type 'owns' refers to node type 'car' that is neither on the server nor in the schema",
+				"index 'email_idx' refers to attribute 'email' that is neither on the server nor in the schema",
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, err := migrate.ParseJSON([]byte(test.schema))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			plan, err := migrate.BuildPlan(conn, schema)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			if fmt.Sprint(stepNames(plan)) != fmt.Sprint(test.steps) {
+				t.Errorf("Expected the steps %q, got %q", test.steps, stepNames(plan))
+			}
+			if fmt.Sprint(plan.Conflicts) != fmt.Sprint(test.conflicts) {
+				t.Errorf("Expected the conflicts %q, got %q", test.conflicts, plan.Conflicts)
+			}
+			if plan.IsEmpty() != (len(test.steps) == 0) {
+				t.Errorf("Expected IsEmpty to be %t", len(test.steps) == 0)
+			}
+		})
+	}
+	for _, command := range createCommands {
+		if count := server.GetAdminCommandCount(command); count != 0 {
+			t.Fatalf("Expected building plans to create nothing, got %d requests of command %d", count, command)
+		}
+	}
+}
+
+func TestMigrateDryRunSendsNothing(t *testing.T) {
+	server, conn := connectAdminToMock(t)
+	defer server.Close()
+	defer conn.Disconnect()
+	schema, err := migrate.ParseJSON([]byte(`{"version": "2", "attributes": [{"name": "age", "type": "int"}],
+		"nodeTypes": [{"name": "company", "attributes": ["name"]}]}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var out bytes.Buffer
+	plan, err := migrate.Migrate(conn, schema, migrate.Options{DryRun: true, Out: &out})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(plan.Steps) != 6 {
+		t.Fatalf("Expected 6 steps, got %q", stepNames(plan))
+	}
+	for _, command := range createCommands {
+		if count := server.GetAdminCommandCount(command); count != 0 {
+			t.Fatalf("Expected a dry run to create nothing, got %d requests of command %d", count, command)
+		}
+	}
+	if count := server.GetRequestCount(impl.VerbCommitTransactionRequest); count != 0 {
+		t.Fatalf("Expected a dry run to commit nothing, got %d commits", count)
+	}
+	printed := out.String()
+	for _, expected := range []string{"Schema version 2 - 6 step(s):", "2. create node type 'company' attributes=[name]", "6. record schema version '2'"} {
+		if !strings.Contains(printed, expected) {
+			t.Fatalf("Expected the printed plan to contain '%s', got:\n%s", expected, printed)
+		}
+	}
+}
+
+func TestMigrateAppliesPlanAndRecordsVersion(t *testing.T) {
+	server, conn := connectAdminToMock(t)
+	defer server.Close()
+	defer conn.Disconnect()
+	schema, err := migrate.ParseJSON([]byte(`{"version": "3", "attributes": [{"name": "age", "type": "int"}],
+		"nodeTypes": [{"name": "company", "attributes": ["name"], "primaryKeys": ["name"]}],
+		"edgeTypes": [{"name": "works_for", "from": "person", "to": "company"}],
+		"indices": [{"name": "age_idx", "nodeTypes": ["person"], "attributes": ["age"]}]}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := migrate.Migrate(conn, schema, migrate.Options{}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if count := server.GetAdminCommandCount(impl.AdminCommandCreateNodeType); count != 2 {
+		t.Fatalf("Expected the company and marker node types to be created, got %d", count)
+	}
+	if count := server.GetRequestCount(impl.VerbCommitTransactionRequest); count != 1 {
+		t.Fatalf("Expected the version to be recorded in 1 commit, got %d", count)
+	}
+
+	// Run again, the server matches the schema and the version is found
+	var out bytes.Buffer
+	plan, err := migrate.Migrate(conn, schema, migrate.Options{Out: &out})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !plan.IsEmpty() || !plan.VersionApplied || len(plan.Conflicts) != 0 {
+		t.Fatalf("Expected nothing to do, got steps %q and conflicts %q", stepNames(plan), plan.Conflicts)
+	}
+	if !strings.Contains(out.String(), "Schema version 3 is already applied - nothing to do") {
+		t.Fatalf("Unexpected output: %s", out.String())
+	}
+}
+
+func TestMigrateRefusesConflicts(t *testing.T) {
+	server, conn := connectAdminToMock(t)
+	defer server.Close()
+	defer conn.Disconnect()
+	schema, err := migrate.ParseJSON([]byte(`{"attributes": [{"name": "name", "type": "int"}, {"name": "age", "type": "int"}]}`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	plan, err := migrate.Migrate(conn, schema, migrate.Options{})
+	if err == nil {
+		t.Fatal("Expected the conflict to stop the migration")
+	}
+	if len(plan.Conflicts) != 1 || server.GetAdminCommandCount(impl.AdminCommandCreateAttrDesc) != 0 {
+		t.Fatalf("Expected 1 conflict and nothing created, got %q", plan.Conflicts)
+	}
+
+	if _, err := migrate.Migrate(conn, schema, migrate.Options{IgnoreConflicts: true}); err != nil {
+		t.Fatal(err.Error())
+	}
+	if count := server.GetAdminCommandCount(impl.AdminCommandCreateAttrDesc); count != 1 {
+		t.Fatalf("Expected the new attribute to be created despite the conflict, got %d", count)
+	}
+}
@@ -0,0 +1,248 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: schema.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"tgdb"
+	"tgdb/impl"
+)
+
+const (
+	TGDB_MIGRATE_ERROR string = "TGDB-MIGRATE-ERR"
+)
+
+// Schema is the declarative description of the graph model that a database is migrated to
+type Schema struct {
+	Version    string          `json:"version"`
+	Attributes []AttributeSpec `json:"attributes"`
+	NodeTypes  []NodeTypeSpec  `json:"nodeTypes"`
+	EdgeTypes  []EdgeTypeSpec  `json:"edgeTypes"`
+	Indices    []IndexSpec     `json:"indices"`
+}
+
+// AttributeSpec describes an attribute descriptor
+type AttributeSpec struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	IsArray     bool   `json:"isArray"`
+	IsEncrypted bool   `json:"isEncrypted"`
+	Precision   int16  `json:"precision"`
+	Scale       int16  `json:"scale"`
+}
+
+// NodeTypeSpec describes a node type and its primary key
+type NodeTypeSpec struct {
+	Name        string   `json:"name"`
+	Attributes  []string `json:"attributes"`
+	PrimaryKeys []string `json:"primaryKeys"`
+}
+
+// EdgeTypeSpec describes an edge type. From and To may be left empty for edges between any node types
+type EdgeTypeSpec struct {
+	Name        string   `json:"name"`
+	From        string   `json:"from"`
+	To          string   `json:"to"`
+	Direction   string   `json:"direction"`
+	Attributes  []string `json:"attributes"`
+	PrimaryKeys []string `json:"primaryKeys"`
+}
+
+// IndexSpec describes an index on one or more node types
+type IndexSpec struct {
+	Name       string   `json:"name"`
+	NodeTypes  []string `json:"nodeTypes"`
+	Attributes []string `json:"attributes"`
+	Unique     bool     `json:"unique"`
+}
+
+var attributeTypesByName = map[string]int{
+	"boolean":   impl.AttributeTypeBoolean,
+	"bool":      impl.AttributeTypeBoolean,
+	"byte":      impl.AttributeTypeByte,
+	"char":      impl.AttributeTypeChar,
+	"short":     impl.AttributeTypeShort,
+	"integer":   impl.AttributeTypeInteger,
+	"int":       impl.AttributeTypeInteger,
+	"long":      impl.AttributeTypeLong,
+	"float":     impl.AttributeTypeFloat,
+	"double":    impl.AttributeTypeDouble,
+	"number":    impl.AttributeTypeNumber,
+	"string":    impl.AttributeTypeString,
+	"date":      impl.AttributeTypeDate,
+	"time":      impl.AttributeTypeTime,
+	"timestamp": impl.AttributeTypeTimeStamp,
+	"blob":      impl.AttributeTypeBlob,
+	"clob":      impl.AttributeTypeClob,
+}
+
+var directionTypesByName = map[string]tgdb.TGDirectionType{
+	"":              tgdb.DirectionTypeDirected,
+	"directed":      tgdb.DirectionTypeDirected,
+	"undirected":    tgdb.DirectionTypeUnDirected,
+	"bidirected":    tgdb.DirectionTypeBiDirectional,
+	"bidirectional": tgdb.DirectionTypeBiDirectional,
+}
+
+// LoadSchema reads a schema file, choosing the YAML or JSON parser from the file extension
+func LoadSchema(path string) (*Schema, tgdb.TGError) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		errMsg := fmt.Sprintf("Unable to read schema file '%s'", path)
+		return nil, impl.GetErrorByType(impl.TGErrorIOException, TGDB_MIGRATE_ERROR, errMsg, err.Error())
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ParseJSON(data)
+	case ".yaml", ".yml":
+		return ParseYAML(data)
+	}
+	errMsg := fmt.Sprintf("Unable to determine the format of schema file '%s' - expected a .json, .yaml or .yml extension", path)
+	return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MIGRATE_ERROR, errMsg, "")
+}
+
+// ParseJSON parses and validates a JSON schema document
+func ParseJSON(data []byte) (*Schema, tgdb.TGError) {
+	schema := &Schema{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(schema); err != nil {
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MIGRATE_ERROR, "Invalid schema document", err.Error())
+	}
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// ParseYAML parses and validates a YAML schema document
+func ParseYAML(data []byte) (*Schema, tgdb.TGError) {
+	doc, err := parseYAML(data)
+	if err != nil {
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MIGRATE_ERROR, "Invalid schema document", err.Error())
+	}
+	if doc == nil {
+		doc = map[string]interface{}{}
+	}
+	// An unquoted version such as 1.2 is read as a number, but it is a string in the schema
+	if m, ok := doc.(map[string]interface{}); ok {
+		if version, ok := m["version"].(json.Number); ok {
+			m["version"] = version.String()
+		}
+	}
+	jsonData, err := json.Marshal(doc)
+	if err != nil {
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MIGRATE_ERROR, "Invalid schema document", err.Error())
+	}
+	return ParseJSON(jsonData)
+}
+
+// Validate checks that the schema is self consistent. Attributes and node types referenced by the
+// schema must either be declared in it or already exist on the server, so only the shape of each
+// entry and duplicate names are checked here.
+func (s *Schema) Validate() tgdb.TGError {
+	seen := make(map[string]bool)
+	for _, attr := range s.Attributes {
+		if attr.Name == "" {
+			return invalidSchema("an attribute has no name")
+		}
+		if seen[attr.Name] {
+			return invalidSchema(fmt.Sprintf("attribute '%s' is declared more than once", attr.Name))
+		}
+		seen[attr.Name] = true
+		if _, ok := attributeTypesByName[strings.ToLower(attr.Type)]; !ok {
+			return invalidSchema(fmt.Sprintf("attribute '%s' has unknown type '%s'", attr.Name, attr.Type))
+		}
+	}
+
+	types := make(map[string]bool)
+	for _, nodeType := range s.NodeTypes {
+		if err := validateEntityTypeSpec("node type", nodeType.Name, nodeType.Attributes, nodeType.PrimaryKeys, types); err != nil {
+			return err
+		}
+	}
+	for _, edgeType := range s.EdgeTypes {
+		if err := validateEntityTypeSpec("edge type", edgeType.Name, edgeType.Attributes, edgeType.PrimaryKeys, types); err != nil {
+			return err
+		}
+		if _, ok := directionTypesByName[strings.ToLower(edgeType.Direction)]; !ok {
+			return invalidSchema(fmt.Sprintf("edge type '%s' has unknown direction '%s'", edgeType.Name, edgeType.Direction))
+		}
+	}
+
+	indices := make(map[string]bool)
+	for _, index := range s.Indices {
+		if index.Name == "" {
+			return invalidSchema("an index has no name")
+		}
+		if indices[index.Name] {
+			return invalidSchema(fmt.Sprintf("index '%s' is declared more than once", index.Name))
+		}
+		indices[index.Name] = true
+		if len(index.Attributes) == 0 {
+			return invalidSchema(fmt.Sprintf("index '%s' has no attributes", index.Name))
+		}
+	}
+	return nil
+}
+
+func (s *Schema) attributeSpec(name string) *AttributeSpec {
+	for i := range s.Attributes {
+		if s.Attributes[i].Name == name {
+			return &s.Attributes[i]
+		}
+	}
+	return nil
+}
+
+func validateEntityTypeSpec(kind, name string, attrs, pkeys []string, seen map[string]bool) tgdb.TGError {
+	if name == "" {
+		return invalidSchema(fmt.Sprintf("a %s has no name", kind))
+	}
+	if seen[name] {
+		return invalidSchema(fmt.Sprintf("type '%s' is declared more than once", name))
+	}
+	seen[name] = true
+	for _, pkey := range pkeys {
+		if !containsString(attrs, pkey) {
+			return invalidSchema(fmt.Sprintf("primary key '%s' of %s '%s' is not one of its attributes", pkey, kind, name))
+		}
+	}
+	return nil
+}
+
+func invalidSchema(reason string) tgdb.TGError {
+	return impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MIGRATE_ERROR, fmt.Sprintf("Invalid schema: %s", reason), "")
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: yaml_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package migrate
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type yamlMap = map[string]interface{}
+type yamlList = []interface{}
+
+func TestParseYAML(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		expected interface{}
+	}{
+		{
+			name:     "nested mappings",
+			doc:      "a:\n  b:\n    c: 1\n  d: x\ne: y\n",
+			expected: yamlMap{"a": yamlMap{"b": yamlMap{"c": json.Number("1")}, "d": "x"}, "e": "y"},
+		},
+		{
+			name:     "sequence at the indentation of its key",
+			doc:      "items:\n- a\n- b\nnext: c\n",
+			expected: yamlMap{"items": yamlList{"a", "b"}, "next": "c"},
+		},
+		{
+			name:     "indented sequence",
+			doc:      "items:\n    - a\n    - b\n",
+			expected: yamlMap{"items": yamlList{"a", "b"}},
+		},
+		{
+			name: "sequence of mappings",
+			doc:  "attributes:\n  - name: age\n    type: int\n  - name: tags\n    isArray: true\n",
+			expected: yamlMap{"attributes": yamlList{
+				yamlMap{"name": "age", "type": "int"},
+				yamlMap{"name": "tags", "isArray": true},
+			}},
+		},
+		{
+			name:     "nested sequence",
+			doc:      "-\n  - a\n  - b\n- c\n",
+			expected: yamlList{yamlList{"a", "b"}, "c"},
+		},
+		{
+			name:     "flow sequences",
+			doc:      "a: [x, 'y, z', \"w\"]\nb: []\nc: [1, true]\n",
+			expected: yamlMap{"a": yamlList{"x", "y, z", "w"}, "b": yamlList{}, "c": yamlList{json.Number("1"), true}},
+		},
+		{
+			name:     "quoted scalars",
+			doc:      "a: \"x: y\"\nb: 'it''s'\nc: \"tab\\there\"\nd: '#not a comment'\n'e f': \"1.10\"\n",
+			expected: yamlMap{"a": "x: y", "b": "it's", "c": "tab\there", "d": "#not a comment", "e f": "1.10"},
+		},
+		{
+			name:     "plain scalars",
+			doc:      "a: 1.10\nb: TRUE\nc: false\nd: ~\ne: null\nf: plain text\ng:\n",
+			expected: yamlMap{"a": json.Number("1.10"), "b": true, "c": false, "d": nil, "e": nil, "f": "plain text", "g": nil},
+		},
+		{
+			name:     "comments, blank lines and document start",
+			doc:      "---\n# header\na: x # trailing\n\nb: y#z\n",
+			expected: yamlMap{"a": "x", "b": "y#z"},
+		},
+		{
+			name:     "empty document",
+			doc:      "# nothing\n",
+			expected: nil,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc, err := parseYAML([]byte(test.doc))
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+			if !reflect.DeepEqual(doc, test.expected) {
+				t.Fatalf("Expected %#v, got %#v", test.expected, doc)
+			}
+		})
+	}
+}
+
+func TestParseYAMLReportsLineOfError(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		expected string
+	}{
+		{"deeper indentation", "a: x\n  b: y\n", "line 2: unexpected indentation"},
+		{"shallower indentation", "a:\n    b: x\n  c: y\n", "line 3: unexpected indentation"},
+		{"tab indentation", "a:\n\tb: x\n", "line 2: tabs are not allowed"},
+		{"sequence item in mapping", "a:\n  b: x\n  - c\n", "line 3: expected a 'key: value' entry"},
+		{"missing colon", "a: x\nb\n", "line 2: expected a 'key: value' entry"},
+		{"duplicate key", "a: x\n# comment\na: y\n", "line 3: duplicate key 'a'"},
+		{"unterminated double quote", "a: x\nb: \"y\n", "line 2: unterminated double quoted string"},
+		{"unterminated single quote", "a:\n  - 'y\n", "line 2: unterminated single quoted string"},
+		{"unterminated flow sequence", "a: [x, y\n", "line 1: unterminated flow sequence"},
+		{"nested flow sequence", "a: [x, [y]]\n", "line 1: nested flow collections"},
+		{"empty flow entry", "a: [x,,y]\n", "line 1: empty entry in flow sequence"},
+		{"flow mapping", "a: x\nb: {c: d}\n", "line 2: flow mappings are not supported"},
+		{"block scalar", "a: |\n", "line 1: block scalars are not supported"},
+		{"anchor", "a: x\nb: &anchor y\n", "line 2: unsupported YAML construct"},
+		{"alias line", "a:\n  *alias\n", "line 2: unsupported YAML construct"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := parseYAML([]byte(test.doc))
+			if err == nil {
+				t.Fatalf("Expected an error containing '%s'", test.expected)
+			}
+			if !strings.Contains(err.Error(), test.expected) {
+				t.Fatalf("Expected an error containing '%s', got '%s'", test.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestParseYAMLSchema(t *testing.T) {
+	schema, err := ParseYAML([]byte(`
+version: 1.10
+attributes:
+  - name: name
+    type: string
+  - name: amount
+    type: number
+    precision: 10
+    scale: 2
+nodeTypes:
+  - name: person
+    attributes: [name, amount]
+    primaryKeys: [name]
+edgeTypes:
+  - name: knows
+    from: person
+    to: person
+    direction: undirected
+indices:
+  - name: amount_idx
+    nodeTypes: [person]
+    attributes: [amount]
+    unique: false
+`))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	expected := &Schema{
+		Version: "1.10",
+		Attributes: []AttributeSpec{
+			{Name: "name", Type: "string"},
+			{Name: "amount", Type: "number", Precision: 10, Scale: 2},
+		},
+		NodeTypes: []NodeTypeSpec{{Name: "person", Attributes: []string{"name", "amount"}, PrimaryKeys: []string{"name"}}},
+		EdgeTypes: []EdgeTypeSpec{{Name: "knows", From: "person", To: "person", Direction: "undirected"}},
+		Indices:   []IndexSpec{{Name: "amount_idx", NodeTypes: []string{"person"}, Attributes: []string{"amount"}}},
+	}
+	if !reflect.DeepEqual(schema, expected) {
+		t.Fatalf("Expected %+v, got %+v", expected, schema)
+	}
+
+	if _, err := ParseYAML([]byte("attributes:\n  - name: a\n    typ: string\n")); err == nil {
+		t.Fatal("Expected an unknown field to be rejected")
+	}
+	if _, err := ParseYAML([]byte("attributes:\n  - name: a\n    type: text\n")); err == nil {
+		t.Fatal("Expected an unknown attribute type to be rejected")
+	}
+}
@@ -0,0 +1,720 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: mockserver.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+// Package mockserver is an in-process stand-in for a TGDB server, for testing client code without one.
+// A Server listens on a local TCP port and speaks the handshake, authenticate, ping, metadata, query,
+// get entity, traverse, begin, commit and rollback verbs of the wire protocol, backed by an in-memory Store.
+// Of the admin commands, it answers those that show and create indices and create attribute descriptors
+// and types. Batched bulk exports and imports read and write the records that the Store holds per type.
+// Traversals apply edge types, direction, depth, uniqueness and result shape, but not node or edge predicates.
+// Responses can be scripted per verb with a Handler, and faults can be injected per verb.
+package mockserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"tgdb"
+	"tgdb/impl"
+	"time"
+)
+
+const (
+	TGDB_MOCKSERVER_ERROR string = "TGDB-MOCKSERVER-ERR"
+	// maxFrameLength bounds the length prefix of a request so that a corrupt one cannot size a huge buffer
+	maxFrameLength uint32 = 64 * 1024 * 1024
+)
+
+var logger = impl.DefaultTGLogManager().GetLogger()
+
+// Handler scripts the response to a request. Returning a nil response and a nil error falls back to
+// the built-in handling of the verb, and returning an error sends it to the client as an exception message.
+// The request id, auth token and session id of the response are filled in by the server.
+type Handler func(request tgdb.TGMessage) (tgdb.TGMessage, tgdb.TGError)
+
+type FaultKind int
+
+const (
+	// FaultDropConnection closes the connection instead of answering the request
+	FaultDropConnection FaultKind = iota
+	// FaultDelay waits for the fault's Delay before answering the request
+	FaultDelay
+	// FaultException answers the request with an exception message carrying the fault's Message
+	FaultException
+)
+
+// Fault is injected into the handling of requests of one verb
+type Fault struct {
+	Kind    FaultKind
+	Delay   time.Duration
+	Message string
+	// Count is the number of requests the fault applies to. Zero applies it until the faults are cleared
+	Count int
+}
+
+// Server is a mock TGDB server listening on a local TCP port
+type Server struct {
+	lock          sync.Mutex
+	store         *Store
+	listener      net.Listener
+	conns         map[net.Conn]bool
+	handlers      map[int]Handler
+	faults        map[int]*Fault
+	requestCounts map[int]int
+	adminCounts   map[impl.AdminCommand]int
+	userName      string
+	password      string
+	nextSessionId int64
+	wg            sync.WaitGroup
+}
+
+// session is the state of one client connection
+type session struct {
+	authToken int64
+	sessionId int64
+	nextTxnId int64
+	export    *bulkExport
+	imports   map[string]*BulkType
+}
+
+// bulkExport is how far a session has got through the export it began
+type bulkExport struct {
+	types     []BulkType
+	typeIdx   int
+	recordIdx int
+	batchSize int
+}
+
+func DefaultServer() *Server {
+	return NewServer(NewStore())
+}
+
+func NewServer(store *Store) *Server {
+	return &Server{
+		store:         store,
+		conns:         make(map[net.Conn]bool, 0),
+		handlers:      make(map[int]Handler, 0),
+		faults:        make(map[int]*Fault, 0),
+		requestCounts: make(map[int]int, 0),
+		adminCounts:   make(map[impl.AdminCommand]int, 0),
+		nextSessionId: 1,
+	}
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for Server
+/////////////////////////////////////////////////////////////////
+
+// Start listens on a free port of the loopback interface and serves connections in the background
+func (obj *Server) Start() tgdb.TGError {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning Server:Start - unable to listen w/ error: '%s'", err.Error()))
+		return impl.GetErrorByType(impl.TGErrorIOException, TGDB_MOCKSERVER_ERROR, "Unable to start the mock server", err.Error())
+	}
+	obj.lock.Lock()
+	obj.listener = listener
+	obj.lock.Unlock()
+	obj.wg.Add(1)
+	go obj.accept(listener)
+	return nil
+}
+
+// Close stops listening, closes all connections and waits for them to finish
+func (obj *Server) Close() {
+	obj.lock.Lock()
+	if obj.listener != nil {
+		_ = obj.listener.Close()
+		obj.listener = nil
+	}
+	for conn := range obj.conns {
+		_ = conn.Close()
+	}
+	obj.lock.Unlock()
+	obj.wg.Wait()
+}
+
+// GetAddress returns the host:port the server listens on
+func (obj *Server) GetAddress() string {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if obj.listener == nil {
+		return ""
+	}
+	return obj.listener.Addr().String()
+}
+
+// GetUrl returns a connection URL for the server
+func (obj *Server) GetUrl() string {
+	return fmt.Sprintf("tcp://%s", obj.GetAddress())
+}
+
+// GetStore returns the in-memory graph behind the server
+func (obj *Server) GetStore() *Store {
+	return obj.store
+}
+
+// GetRequestCount returns the number of requests received for a verb
+func (obj *Server) GetRequestCount(verbId int) int {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.requestCounts[verbId]
+}
+
+// GetAdminCommandCount returns the number of admin requests received for a command
+func (obj *Server) GetAdminCommandCount(command impl.AdminCommand) int {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.adminCounts[command]
+}
+
+// SetCredentials makes the server reject other user names and passwords. Any are accepted by default
+func (obj *Server) SetCredentials(userName, password string) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.userName = userName
+	obj.password = password
+}
+
+// SetHandler scripts the responses to requests of a verb, a nil handler restores the built-in handling
+func (obj *Server) SetHandler(verbId int, handler Handler) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if handler == nil {
+		delete(obj.handlers, verbId)
+		return
+	}
+	obj.handlers[verbId] = handler
+}
+
+// InjectFault injects a fault into the handling of requests of a verb, replacing any earlier one
+func (obj *Server) InjectFault(verbId int, fault Fault) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.faults[verbId] = &fault
+}
+
+// ClearFaults removes all injected faults
+func (obj *Server) ClearFaults() {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.faults = make(map[int]*Fault, 0)
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for Server
+/////////////////////////////////////////////////////////////////
+
+func (obj *Server) accept(listener net.Listener) {
+	defer obj.wg.Done()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		obj.lock.Lock()
+		if obj.listener == nil {
+			obj.lock.Unlock()
+			_ = conn.Close()
+			return
+		}
+		obj.conns[conn] = true
+		sess := &session{authToken: obj.nextSessionId<<32 | 0x7467, sessionId: obj.nextSessionId, nextTxnId: 1}
+		obj.nextSessionId++
+		obj.wg.Add(1)
+		obj.lock.Unlock()
+		go obj.serve(conn, sess)
+	}
+}
+
+func (obj *Server) serve(conn net.Conn, sess *session) {
+	defer obj.wg.Done()
+	defer func() {
+		obj.lock.Lock()
+		delete(obj.conns, conn)
+		obj.lock.Unlock()
+		_ = conn.Close()
+	}()
+	for {
+		frame, err := readFrame(conn)
+		if err != nil {
+			if err != io.EOF && logger.IsDebug() {
+				logger.Debug(fmt.Sprintf("Returning Server:serve after read failure: '%s'", err.Error()))
+			}
+			return
+		}
+		request, tgErr := impl.CreateMessageFromBuffer(frame, 0, len(frame))
+		if tgErr != nil {
+			logger.Error(fmt.Sprintf("ERROR: Returning Server:serve - unable to read a message w/ error: '%s'", tgErr.Error()))
+			return
+		}
+		if !obj.process(conn, sess, request) {
+			return
+		}
+	}
+}
+
+// process answers a request, and returns false when the connection is to be closed
+func (obj *Server) process(conn net.Conn, sess *session, request tgdb.TGMessage) bool {
+	verbId := request.GetVerbId()
+	obj.lock.Lock()
+	obj.requestCounts[verbId]++
+	handler := obj.handlers[verbId]
+	var fault *Fault
+	if f, ok := obj.faults[verbId]; ok {
+		fault = f
+		if f.Count > 0 {
+			f.Count--
+			if f.Count == 0 {
+				delete(obj.faults, verbId)
+			}
+		}
+	}
+	obj.lock.Unlock()
+
+	if verbId == impl.VerbDisconnectChannelRequest {
+		return false
+	}
+	if fault != nil {
+		switch fault.Kind {
+		case FaultDropConnection:
+			return false
+		case FaultDelay:
+			time.Sleep(fault.Delay)
+		case FaultException:
+			return obj.sendException(conn, sess, request, fault.Message)
+		}
+	}
+
+	var response tgdb.TGMessage
+	var err tgdb.TGError
+	if handler != nil {
+		response, err = handler(request)
+	}
+	if response == nil && err == nil {
+		response, err = obj.respond(sess, request)
+	}
+	if err != nil {
+		return obj.sendException(conn, sess, request, err.GetErrorMsg())
+	}
+	if response == nil {
+		return true
+	}
+	response.SetRequestId(request.GetRequestId())
+	if verbId != impl.VerbHandShakeRequest {
+		response.SetAuthToken(sess.authToken)
+		response.SetSessionId(sess.sessionId)
+	}
+	return obj.send(conn, response)
+}
+
+// respond is the built-in handling of a request. A nil response sends nothing back
+func (obj *Server) respond(sess *session, request tgdb.TGMessage) (tgdb.TGMessage, tgdb.TGError) {
+	switch request.GetVerbId() {
+	case impl.VerbPingMessage:
+		return nil, nil
+	case impl.VerbHandShakeRequest:
+		return obj.handshake(request.(*impl.HandShakeRequestMessage)), nil
+	case impl.VerbAuthenticateRequest:
+		return obj.authenticate(sess, request.(*impl.AuthenticateRequestMessage)), nil
+	case impl.VerbMetadataRequest:
+		return obj.metadata()
+	case impl.VerbQueryRequest:
+		return obj.query(request.(*impl.QueryRequestMessage)), nil
+	case impl.VerbGetEntityRequest:
+		return obj.getEntity(request.(*impl.GetEntityRequestMessage))
+	case impl.VerbTraverseRequest:
+		return obj.traverse(request.(*impl.TraverseRequestMessage))
+	case impl.VerbBeginTransactionRequest:
+		response := impl.DefaultBeginTransactionResponseMessage()
+		response.SetTransactionId(sess.nextTxnId)
+		sess.nextTxnId++
+		return response, nil
+	case impl.VerbCommitTransactionRequest:
+		return obj.commit(request.(*impl.CommitTransactionRequest)), nil
+	case impl.VerbRollbackTransactionRequest:
+		return impl.DefaultRollbackTransactionResponseMessage(), nil
+	case impl.VerbAdminRequest:
+		return obj.admin(request.(*impl.AdminRequestMessage))
+	case impl.VerbBeginExportRequest:
+		return obj.beginExport(sess, request.(*impl.BeginExportRequestMessage))
+	case impl.VerbPartialExportRequest:
+		return obj.partialExport(sess)
+	case impl.VerbCancelExportRequest:
+		sess.export = nil
+		return nil, nil
+	case impl.VerbBeginImportRequest:
+		return obj.beginImport(sess, request.(*impl.BeginImportRequestMessage))
+	case impl.VerbPartialImportRequest:
+		return obj.partialImport(sess, request.(*impl.PartialImportRequestMessage))
+	}
+	errMsg := fmt.Sprintf("Verb '%d' is not supported by the mock server", request.GetVerbId())
+	return nil, impl.GetErrorByType(impl.TGErrorProtocolNotSupported, TGDB_MOCKSERVER_ERROR, errMsg, "")
+}
+
+func (obj *Server) handshake(request *impl.HandShakeRequestMessage) tgdb.TGMessage {
+	response := impl.DefaultHandShakeResponseMessage()
+	switch request.GetRequestType() {
+	case impl.InitiateRequest:
+		response.SetResponseStatus(impl.ResponseAcceptChallenge)
+		response.SetChallenge(impl.GetClientVersion().GetVersionAsLong())
+	case impl.ChallengeAccepted:
+		response.SetResponseStatus(impl.ResponseProceedWithAuthentication)
+	default:
+		response.SetResponseStatus(impl.ResponseChallengeFailed)
+		response.SetErrorMessage(fmt.Sprintf("Unexpected handshake request type '%d'", request.GetRequestType()))
+	}
+	return response
+}
+
+func (obj *Server) authenticate(sess *session, request *impl.AuthenticateRequestMessage) tgdb.TGMessage {
+	obj.lock.Lock()
+	userName, password := obj.userName, obj.password
+	obj.lock.Unlock()
+	response := impl.DefaultAuthenticateResponseMessage()
+	if userName != "" && (request.GetUserName() != userName || string(request.GetPassword()) != password) {
+		response.SetSuccess(false)
+		response.SetErrorStatus(1)
+		return response
+	}
+	response.SetSuccess(true)
+	response.SetServerCertBuffer(make([]byte, 0))
+	return response
+}
+
+func (obj *Server) metadata() (tgdb.TGMessage, tgdb.TGError) {
+	gmd := obj.store.GetGraphMetadata()
+	attrDescs, err := gmd.GetAttributeDescriptors()
+	if err != nil {
+		return nil, err
+	}
+	nodeTypes, err := gmd.GetNodeTypes()
+	if err != nil {
+		return nil, err
+	}
+	edgeTypes, err := gmd.GetEdgeTypes()
+	if err != nil {
+		return nil, err
+	}
+	response := impl.DefaultMetadataResponseMessage()
+	response.SetAttrDescList(attrDescs)
+	response.SetNodeTypeList(nodeTypes)
+	response.SetEdgeTypeList(edgeTypes)
+	return response, nil
+}
+
+func (obj *Server) query(request *impl.QueryRequestMessage) tgdb.TGMessage {
+	response := impl.DefaultQueryResponseMessage()
+	switch request.GetCommand() {
+	case impl.EXECUTE:
+		obj.store.lock.RLock()
+		entities := obj.store.queryResult(request.GetQuery())
+		obj.store.lock.RUnlock()
+		response.SetResultCount(len(entities))
+		response.SetEntities(entities)
+	case impl.EXECUTEGREMLIN, impl.EXECUTEGREMLINSTR:
+		obj.store.lock.RLock()
+		entities := obj.store.queryResult(request.GetQuery())
+		obj.store.lock.RUnlock()
+		response.SetResultCount(len(entities))
+		response.SetGremlinEntities(entities)
+	default:
+		response.SetQueryHashId(request.GetQueryHashId())
+	}
+	return response
+}
+
+func (obj *Server) getEntity(request *impl.GetEntityRequestMessage) (tgdb.TGMessage, tgdb.TGError) {
+	response := impl.DefaultGetEntityResponseMessage()
+	response.SetResultId(request.GetResultId())
+	if request.GetKeyStream() == nil {
+		return response, nil
+	}
+	obj.store.lock.RLock()
+	entity, err := obj.store.getEntityByKey(request.GetKeyStream())
+	obj.store.lock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	if entity != nil {
+		response.SetEntities([]tgdb.TGEntity{entity})
+	}
+	return response, nil
+}
+
+func (obj *Server) traverse(request *impl.TraverseRequestMessage) (tgdb.TGMessage, tgdb.TGError) {
+	obj.store.lock.RLock()
+	entities, results, err := obj.store.traverse(request)
+	obj.store.lock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	response := impl.DefaultTraverseResponseMessage()
+	response.SetEntities(entities)
+	response.SetResults(results)
+	return response, nil
+}
+
+func (obj *Server) commit(request *impl.CommitTransactionRequest) tgdb.TGMessage {
+	response := impl.DefaultCommitTransactionResponseMessage()
+	result, txnErr := obj.store.commit(request.GetEntityStream())
+	if txnErr != nil {
+		logger.Warning(fmt.Sprintf("WARNING: Server:commit - rejecting the transaction w/ '%s'", txnErr.GetErrorMsg()))
+		response.SetException(txnErr)
+		return response
+	}
+	response.SetAttrDescId(result.attrDescIds)
+	response.SetAddedIdList(result.addedIds)
+	response.SetUpdatedIdList(result.updatedIds)
+	response.SetRemovedIdList(result.removedIds)
+	return response
+}
+
+// admin answers the commands that show and create indices and create attribute descriptors and types
+func (obj *Server) admin(request *impl.AdminRequestMessage) (tgdb.TGMessage, tgdb.TGError) {
+	command := request.GetCommand()
+	obj.lock.Lock()
+	obj.adminCounts[command]++
+	obj.lock.Unlock()
+
+	response := impl.DefaultAdminResponseMessage()
+	response.SetCommand(command)
+	obj.store.lock.Lock()
+	defer obj.store.lock.Unlock()
+	var err tgdb.TGError
+	switch command {
+	case impl.AdminCommandShowIndices:
+		response.SetIndexList(append([]tgdb.TGIndexInfo(nil), obj.store.indices...))
+	case impl.AdminCommandCreateAttrDesc:
+		attrDesc := request.GetAttributeDescriptor()
+		if desc, _ := obj.store.gmd.GetAttributeDescriptor(attrDesc.GetName()); desc != nil {
+			response.SetResultId(impl.TGAdminResultDuplicateAttrDesc)
+			return response, nil
+		}
+		desc := obj.store.addAttributeDescriptor(attrDesc.GetName(), attrDesc.GetAttrType(), attrDesc.IsAttributeArray())
+		desc.SetIsEncrypted(attrDesc.Is_Encrypted())
+		desc.SetPrecision(attrDesc.GetPrecision())
+		desc.SetScale(attrDesc.GetScale())
+	case impl.AdminCommandCreateNodeType, impl.AdminCommandCreateEdgeType:
+		typeInfo := request.GetCreateEntityTypeInfo()
+		if obj.store.isSystemObject(typeInfo.GetName()) {
+			response.SetResultId(impl.TGAdminResultDuplicateSystemObject)
+			return response, nil
+		}
+		if command == impl.AdminCommandCreateNodeType {
+			_, err = obj.store.addNodeType(typeInfo.GetName(), typeInfo.GetAttributes(), typeInfo.GetPrimaryKeys())
+		} else {
+			_, err = obj.store.addEdgeType(typeInfo.GetName(), typeInfo.GetDirectionType(), typeInfo.GetFromNodeType(),
+				typeInfo.GetToNodeType(), typeInfo.GetAttributes())
+		}
+	case impl.AdminCommandCreateIndex:
+		indexInfo := request.GetCreateIndexInfo()
+		if obj.store.isSystemObject(indexInfo.GetName()) {
+			response.SetResultId(impl.TGAdminResultDuplicateSystemObject)
+			return response, nil
+		}
+		obj.store.addIndex(indexInfo.GetName(), indexInfo.GetNodeTypes(), indexInfo.GetAttributes(), indexInfo.IsUnique())
+	default:
+		errMsg := fmt.Sprintf("Admin command '%d' is not supported by the mock server", int(command))
+		err = impl.GetErrorByType(impl.TGErrorProtocolNotSupported, TGDB_MOCKSERVER_ERROR, errMsg, "")
+	}
+	if err != nil {
+		response.SetResultId(-1)
+		response.SetErrorMessage(err.GetErrorMsg())
+	}
+	return response, nil
+}
+
+// beginExport starts sending the stored bulk records, in batches of at most the requested number of records
+func (obj *Server) beginExport(sess *session, request *impl.BeginExportRequestMessage) (tgdb.TGMessage, tgdb.TGError) {
+	if !request.GetIsBatch() {
+		return nil, impl.GetErrorByType(impl.TGErrorProtocolNotSupported, TGDB_MOCKSERVER_ERROR, "Exports to a file are not supported by the mock server", "")
+	}
+	batchSize := request.GetMaxBatchEntities()
+	if batchSize <= 0 {
+		batchSize = impl.DefaultBulkIOBatchSize
+	}
+	obj.store.lock.RLock()
+	types := obj.store.copyBulkTypes()
+	obj.store.lock.RUnlock()
+
+	typeList := make([]tgdb.TGBulkDescriptor, 0, len(types))
+	numRequests := 0
+	for _, bulkType := range types {
+		typeList = append(typeList, impl.NewBulkDescriptorImpl(bulkType.Name, bulkType.IsNode, int64(len(bulkType.Records))))
+		numRequests += (len(bulkType.Records) + batchSize - 1) / batchSize
+	}
+	sess.export = &bulkExport{types: types, batchSize: batchSize}
+	response := impl.DefaultBeginExportResponseMessage()
+	response.SetNumRequests(numRequests)
+	response.SetTypeList(typeList)
+	return response, nil
+}
+
+// partialExport sends the next batch of the export. Types without records are skipped
+func (obj *Server) partialExport(sess *session) (tgdb.TGMessage, tgdb.TGError) {
+	export := sess.export
+	if export == nil {
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, "No export is in progress", "")
+	}
+	skipEmptyTypes := func() {
+		for export.typeIdx < len(export.types) && len(export.types[export.typeIdx].Records) == 0 {
+			export.typeIdx++
+		}
+	}
+	response := impl.DefaultPartialExportResponseMessage()
+	response.SetIsBatch(true)
+	skipEmptyTypes()
+	if export.typeIdx < len(export.types) {
+		bulkType := export.types[export.typeIdx]
+		if export.recordIdx == 0 {
+			response.SetNewType(true)
+			response.SetTypeName(bulkType.Name)
+			response.SetAttrList(bulkType.Attributes)
+		}
+		end := export.recordIdx + export.batchSize
+		if end > len(bulkType.Records) {
+			end = len(bulkType.Records)
+		}
+		var data bytes.Buffer
+		for _, record := range bulkType.Records[export.recordIdx:end] {
+			data.WriteString(record)
+			data.WriteByte('\n')
+		}
+		response.SetNumEntities(end - export.recordIdx)
+		response.SetData(data.Bytes())
+		export.recordIdx = end
+		if end == len(bulkType.Records) {
+			export.typeIdx++
+			export.recordIdx = 0
+		}
+	}
+	skipEmptyTypes()
+	hasMore := export.typeIdx < len(export.types)
+	response.SetHasMore(hasMore)
+	if !hasMore {
+		sess.export = nil
+	}
+	return response, nil
+}
+
+func (obj *Server) beginImport(sess *session, request *impl.BeginImportRequestMessage) (tgdb.TGMessage, tgdb.TGError) {
+	if !request.GetIsBatch() {
+		return nil, impl.GetErrorByType(impl.TGErrorProtocolNotSupported, TGDB_MOCKSERVER_ERROR, "Imports from files are not supported by the mock server", "")
+	}
+	sess.imports = make(map[string]*BulkType, 0)
+	return impl.DefaultBeginImportResponseMessage(), nil
+}
+
+// partialImport collects the records of a type, and stores them once its last batch has arrived. A type
+// is imported as a node type unless an edge type has its name
+func (obj *Server) partialImport(sess *session, request *impl.PartialImportRequestMessage) (tgdb.TGMessage, tgdb.TGError) {
+	if sess.imports == nil {
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, "No import is in progress", "")
+	}
+	if !request.GetIsBatch() {
+		return nil, impl.GetErrorByType(impl.TGErrorProtocolNotSupported, TGDB_MOCKSERVER_ERROR, "Imports from files are not supported by the mock server", "")
+	}
+	obj.store.lock.Lock()
+	defer obj.store.lock.Unlock()
+	typeName := request.GetTypeName()
+	bulkType, ok := sess.imports[typeName]
+	if !ok || request.GetReqIdx() == 0 {
+		edgeType, _ := obj.store.gmd.GetEdgeType(typeName)
+		bulkType = &BulkType{Name: typeName, IsNode: edgeType == nil, Attributes: request.GetAttrList()}
+		sess.imports[typeName] = bulkType
+	}
+	records := splitRecords(request.GetData())
+	bulkType.Records = append(bulkType.Records, records...)
+	if request.GetReqIdx() >= request.GetTotalRequestsForType()-1 {
+		obj.store.setBulkType(*bulkType)
+		delete(sess.imports, typeName)
+	}
+	response := impl.DefaultPartialImportResponseMessage()
+	response.SetResultList([]tgdb.TGBulkDescriptor{impl.NewBulkDescriptorImpl(typeName, bulkType.IsNode, int64(len(records)))})
+	return response, nil
+}
+
+func (obj *Server) sendException(conn net.Conn, sess *session, request tgdb.TGMessage, errMsg string) bool {
+	response := impl.NewExceptionMessageWithTypeWithServerErrorCode(impl.TGErrorGeneralException, errMsg, -1)
+	response.SetRequestId(request.GetRequestId())
+	response.SetAuthToken(sess.authToken)
+	response.SetSessionId(sess.sessionId)
+	return obj.send(conn, response)
+}
+
+// send writes a response, holding the store read lock so that stored entities do not change while
+// they are serialized
+func (obj *Server) send(conn net.Conn, response tgdb.TGMessage) bool {
+	obj.store.lock.RLock()
+	buf, bufLen, err := response.ToBytes()
+	obj.store.lock.RUnlock()
+	if err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning Server:send - unable to write a '%d' message w/ error: '%s'", response.GetVerbId(), err.Error()))
+		return false
+	}
+	if _, wErr := conn.Write(buf[:bufLen]); wErr != nil {
+		return false
+	}
+	return true
+}
+
+// splitRecords splits bulk data into its records, at new lines outside quotes
+func splitRecords(data []byte) []string {
+	records := make([]string, 0)
+	inQuotes := false
+	start := 0
+	for i, b := range data {
+		switch b {
+		case '"':
+			inQuotes = !inQuotes
+		case '\n':
+			if !inQuotes {
+				records = append(records, string(data[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if start < len(data) {
+		records = append(records, string(data[start:]))
+	}
+	return records
+}
+
+// readFrame reads one length prefixed message, including its 4 byte length
+func readFrame(conn net.Conn) ([]byte, error) {
+	lenBuf := make([]byte, 4)
+	if _, err := io.ReadFull(conn, lenBuf); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(lenBuf)
+	if length < 4 || length > maxFrameLength {
+		return nil, fmt.Errorf("invalid message length %d", length)
+	}
+	frame := make([]byte, length)
+	copy(frame, lenBuf)
+	if _, err := io.ReadFull(conn, frame[4:]); err != nil {
+		return nil, err
+	}
+	return frame, nil
+}
@@ -0,0 +1,880 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: store.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package mockserver
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"tgdb"
+	"tgdb/impl"
+)
+
+// Store is the in-memory graph behind a Server. It holds the graph metadata that is sent to clients
+// and the nodes and edges that they read and commit. Nodes do not carry their edge lists.
+// The data of bulk exports and imports is held apart from the nodes and edges, as records per type.
+type Store struct {
+	lock         sync.RWMutex
+	gmd          *impl.GraphMetadata
+	entities     map[int64]tgdb.TGEntity
+	indices      []tgdb.TGIndexInfo
+	queries      map[string][]int64
+	bulkTypes    []BulkType
+	nextAttrId   int64
+	nextTypeId   int
+	nextIndexId  int
+	nextEntityId int64
+}
+
+// BulkType is the data of one type as exported and imported in bulk - the names of its columns and its
+// records, each a line of comma separated values without the trailing new line
+type BulkType struct {
+	Name       string
+	IsNode     bool
+	Attributes []string
+	Records    []string
+}
+
+// attrValue is an attribute value read off a commit request
+type attrValue struct {
+	desc  *impl.AttributeDescriptor
+	value interface{}
+}
+
+// entityRecord is an entity read off a commit request
+type entityRecord struct {
+	kind      tgdb.TGEntityKind
+	id        int64
+	version   int
+	typeId    int
+	attrs     []attrValue
+	direction tgdb.TGDirectionType
+	fromId    int64
+	toId      int64
+}
+
+// commitResult holds the id lists of the commit response
+type commitResult struct {
+	attrDescIds []int64
+	addedIds    []int64
+	updatedIds  []int64
+	removedIds  []int64
+}
+
+func NewStore() *Store {
+	return &Store{
+		gmd:          impl.DefaultGraphMetadata(),
+		entities:     make(map[int64]tgdb.TGEntity, 0),
+		indices:      make([]tgdb.TGIndexInfo, 0),
+		queries:      make(map[string][]int64, 0),
+		bulkTypes:    make([]BulkType, 0),
+		nextAttrId:   1,
+		nextTypeId:   1,
+		nextIndexId:  1,
+		nextEntityId: 1,
+	}
+}
+
+/////////////////////////////////////////////////////////////////
+// Helper functions for Store
+/////////////////////////////////////////////////////////////////
+
+// GetGraphMetadata returns the server side graph metadata
+func (obj *Store) GetGraphMetadata() *impl.GraphMetadata {
+	return obj.gmd
+}
+
+// AddAttributeDescriptor registers a new attribute descriptor, or returns the existing one of that name
+func (obj *Store) AddAttributeDescriptor(name string, attrType int, isArray bool) *impl.AttributeDescriptor {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.addAttributeDescriptor(name, attrType, isArray)
+}
+
+func (obj *Store) addAttributeDescriptor(name string, attrType int, isArray bool) *impl.AttributeDescriptor {
+	if desc, _ := obj.gmd.GetAttributeDescriptor(name); desc != nil {
+		return desc.(*impl.AttributeDescriptor)
+	}
+	desc := impl.NewAttributeDescriptorOnServer(name, attrType, isArray, obj.nextAttrId)
+	obj.nextAttrId++
+	_ = obj.gmd.UpdateMetadata([]tgdb.TGAttributeDescriptor{desc}, nil, nil)
+	return desc
+}
+
+// AddNodeType registers a node type whose primary key is made of the named attributes
+func (obj *Store) AddNodeType(name string, pKeyNames ...string) (*impl.NodeType, tgdb.TGError) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.addNodeType(name, nil, pKeyNames)
+}
+
+// AddEdgeType registers an edge type between two node types. Either node type name may be empty
+func (obj *Store) AddEdgeType(name string, direction tgdb.TGDirectionType, fromTypeName, toTypeName string) (*impl.EdgeType, tgdb.TGError) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.addEdgeType(name, direction, fromTypeName, toTypeName, nil)
+}
+
+// AddIndex registers an index on the named attributes of the named node types
+func (obj *Store) AddIndex(name string, nodeTypeNames, attrNames []string, unique bool) tgdb.TGIndexInfo {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return obj.addIndex(name, nodeTypeNames, attrNames, unique)
+}
+
+// AddNode stores a node of the named node type with the given attribute values
+func (obj *Store) AddNode(typeName string, attrs map[string]interface{}) (*impl.Node, tgdb.TGError) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	nodeType, _ := obj.gmd.GetNodeType(typeName)
+	if nodeType == nil {
+		errMsg := fmt.Sprintf("Node type '%s' is not defined", typeName)
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+	}
+	node := impl.NewNodeWithType(obj.gmd, nodeType)
+	for name, value := range attrs {
+		if err := obj.setAttribute(node, name, value); err != nil {
+			return nil, err
+		}
+	}
+	obj.store(node, node.AbstractEntity)
+	return node, nil
+}
+
+// AddEdge stores an edge of the named edge type between two stored nodes. The edge type name may be
+// empty for an untyped, directed edge
+func (obj *Store) AddEdge(typeName string, fromId, toId int64, attrs map[string]interface{}) (*impl.Edge, tgdb.TGError) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	fromNode, ok1 := obj.entities[fromId].(*impl.Node)
+	toNode, ok2 := obj.entities[toId].(*impl.Node)
+	if !ok1 || !ok2 {
+		errMsg := fmt.Sprintf("Edge end points '%d' and '%d' must both be stored nodes", fromId, toId)
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+	}
+	var edge *impl.Edge
+	if typeName == "" {
+		edge = impl.NewEdgeWithDirection(obj.gmd, fromNode, toNode, tgdb.DirectionTypeDirected)
+	} else {
+		edgeType, _ := obj.gmd.GetEdgeType(typeName)
+		if edgeType == nil {
+			errMsg := fmt.Sprintf("Edge type '%s' is not defined", typeName)
+			return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+		}
+		edge = impl.NewEdgeWithEdgeType(obj.gmd, fromNode, toNode, edgeType)
+	}
+	for name, value := range attrs {
+		if err := obj.setAttribute(edge, name, value); err != nil {
+			return nil, err
+		}
+	}
+	obj.store(edge, edge.AbstractEntity)
+	return edge, nil
+}
+
+// GetEntity returns the stored entity with the given id, or nil
+func (obj *Store) GetEntity(id int64) tgdb.TGEntity {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
+	return obj.entities[id]
+}
+
+// GetIndices returns the registered indices
+func (obj *Store) GetIndices() []tgdb.TGIndexInfo {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
+	return append([]tgdb.TGIndexInfo(nil), obj.indices...)
+}
+
+// GetEntityCount returns the number of stored nodes and edges
+func (obj *Store) GetEntityCount() int {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
+	return len(obj.entities)
+}
+
+// SetQueryResult scripts the entities returned for a query expression. Unscripted queries return no result
+func (obj *Store) SetQueryResult(expr string, ids ...int64) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.queries[expr] = ids
+}
+
+// SetBulkType sets the records exported for a type, replacing any earlier ones. Types are exported in
+// the order they were first set
+func (obj *Store) SetBulkType(bulkType BulkType) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	obj.setBulkType(bulkType)
+}
+
+// GetBulkTypes returns the records of all types, as set or imported
+func (obj *Store) GetBulkTypes() []BulkType {
+	obj.lock.RLock()
+	defer obj.lock.RUnlock()
+	return obj.copyBulkTypes()
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for Store - called with the lock held
+/////////////////////////////////////////////////////////////////
+
+func (obj *Store) setBulkType(bulkType BulkType) {
+	bulkType.Attributes = append([]string(nil), bulkType.Attributes...)
+	bulkType.Records = append([]string(nil), bulkType.Records...)
+	for i := range obj.bulkTypes {
+		if obj.bulkTypes[i].Name == bulkType.Name {
+			obj.bulkTypes[i] = bulkType
+			return
+		}
+	}
+	obj.bulkTypes = append(obj.bulkTypes, bulkType)
+}
+
+func (obj *Store) copyBulkTypes() []BulkType {
+	bulkTypes := make([]BulkType, 0, len(obj.bulkTypes))
+	for _, bulkType := range obj.bulkTypes {
+		bulkType.Attributes = append([]string(nil), bulkType.Attributes...)
+		bulkType.Records = append([]string(nil), bulkType.Records...)
+		bulkTypes = append(bulkTypes, bulkType)
+	}
+	return bulkTypes
+}
+
+// attributeDescriptors looks up the named attribute descriptors
+func (obj *Store) attributeDescriptors(typeName string, attrNames []string) ([]*impl.AttributeDescriptor, tgdb.TGError) {
+	descs := make([]*impl.AttributeDescriptor, 0, len(attrNames))
+	for _, attrName := range attrNames {
+		desc, _ := obj.gmd.GetAttributeDescriptor(attrName)
+		if desc == nil {
+			errMsg := fmt.Sprintf("Attribute '%s' of '%s' is not defined", attrName, typeName)
+			return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+		}
+		descs = append(descs, desc.(*impl.AttributeDescriptor))
+	}
+	return descs, nil
+}
+
+// isSystemObject checks whether a node type, edge type or index already has the name
+func (obj *Store) isSystemObject(name string) bool {
+	if nodeType, _ := obj.gmd.GetNodeType(name); nodeType != nil {
+		return true
+	}
+	if edgeType, _ := obj.gmd.GetEdgeType(name); edgeType != nil {
+		return true
+	}
+	for _, index := range obj.indices {
+		if index.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (obj *Store) addNodeType(name string, attrNames, pKeyNames []string) (*impl.NodeType, tgdb.TGError) {
+	attrs, err := obj.attributeDescriptors(name, attrNames)
+	if err != nil {
+		return nil, err
+	}
+	pKeys, err := obj.attributeDescriptors(name, pKeyNames)
+	if err != nil {
+		return nil, err
+	}
+	nodeType := impl.NewNodeType(name, nil)
+	nodeType.SetEntityTypeId(obj.nextTypeId)
+	obj.nextTypeId++
+	nodeType.SetPKeyAttributeDescriptors(pKeys)
+	for _, desc := range append(attrs, pKeys...) {
+		nodeType.AddAttributeDescriptor(desc.GetName(), desc)
+	}
+	_ = obj.gmd.UpdateMetadata(nil, []tgdb.TGNodeType{nodeType}, nil)
+	return nodeType, nil
+}
+
+func (obj *Store) addEdgeType(name string, direction tgdb.TGDirectionType, fromTypeName, toTypeName string, attrNames []string) (*impl.EdgeType, tgdb.TGError) {
+	attrs, err := obj.attributeDescriptors(name, attrNames)
+	if err != nil {
+		return nil, err
+	}
+	edgeType := impl.NewEdgeType(name, direction, nil)
+	for i, typeName := range []string{fromTypeName, toTypeName} {
+		if typeName == "" {
+			continue
+		}
+		nodeType, _ := obj.gmd.GetNodeType(typeName)
+		if nodeType == nil {
+			errMsg := fmt.Sprintf("Node type '%s' of edge type '%s' is not defined", typeName, name)
+			return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+		}
+		if i == 0 {
+			edgeType.SetFromTypeId(nodeType.GetEntityTypeId())
+		} else {
+			edgeType.SetToTypeId(nodeType.GetEntityTypeId())
+		}
+	}
+	for _, desc := range attrs {
+		edgeType.AddAttributeDescriptor(desc.GetName(), desc)
+	}
+	edgeType.SetEntityTypeId(obj.nextTypeId)
+	obj.nextTypeId++
+	_ = obj.gmd.UpdateMetadata(nil, nil, []tgdb.TGEdgeType{edgeType})
+	return edgeType, nil
+}
+
+func (obj *Store) addIndex(name string, nodeTypeNames, attrNames []string, unique bool) tgdb.TGIndexInfo {
+	index := impl.NewIndexInfoImpl(obj.nextIndexId, name, 0, unique, attrNames, nodeTypeNames, 0, "Ready")
+	obj.nextIndexId++
+	obj.indices = append(obj.indices, index)
+	return index
+}
+
+// setAttribute sets an attribute, defining its descriptor from the value type if needed
+func (obj *Store) setAttribute(entity tgdb.TGEntity, name string, value interface{}) tgdb.TGError {
+	if desc, _ := obj.gmd.GetAttributeDescriptor(name); desc == nil && value != nil {
+		attrType := impl.GetAttributeTypeFromName(fmt.Sprintf("%T", value))
+		if attrType.GetTypeId() == impl.AttributeTypeInvalid {
+			errMsg := fmt.Sprintf("Attribute '%s' has a value of unsupported type '%T'", name, value)
+			return impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+		}
+		obj.addAttributeDescriptor(name, attrType.GetTypeId(), false)
+	}
+	return entity.SetOrCreateAttribute(name, value)
+}
+
+// store assigns the next entity id to a new entity. Stored entities keep their virtual id equal to
+// their entity id, and keep all their attributes marked as modified so that WriteExternal sends them.
+func (obj *Store) store(entity tgdb.TGEntity, base *impl.AbstractEntity) int64 {
+	id := obj.nextEntityId
+	obj.nextEntityId++
+	base.SetEntityId(id)
+	base.SetIsNew(false)
+	base.SetVersion(1)
+	obj.entities[id] = entity
+	return id
+}
+
+func (obj *Store) queryResult(expr string) []tgdb.TGEntity {
+	entities := make([]tgdb.TGEntity, 0)
+	for _, id := range obj.queries[expr] {
+		if entity, ok := obj.entities[id]; ok {
+			entities = append(entities, entity)
+		}
+	}
+	return entities
+}
+
+// findNode returns the node of the named type (any type when empty) whose attributes hold the given values
+func (obj *Store) findNode(typeName string, values map[string]interface{}) tgdb.TGEntity {
+	ids := make([]int64, 0, len(obj.entities))
+	for id := range obj.entities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for _, id := range ids {
+		node, ok := obj.entities[id].(*impl.Node)
+		if !ok {
+			continue
+		}
+		if typeName != "" && (node.GetEntityType() == nil || node.GetEntityType().GetName() != typeName) {
+			continue
+		}
+		if matchesValues(node, values) {
+			return node
+		}
+	}
+	return nil
+}
+
+// getEntityByKey resolves a composite key read off a get entity request
+func (obj *Store) getEntityByKey(is tgdb.TGInputStream) (tgdb.TGEntity, tgdb.TGError) {
+	key := impl.NewCompositeKey(obj.gmd, "")
+	if err := key.ReadExternal(is); err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{}, 0)
+	for name, attr := range key.GetAttributes() {
+		values[name] = attr.GetValue()
+	}
+	return obj.findNode(key.GetKeyName(), values), nil
+}
+
+func matchesValues(entity tgdb.TGEntity, values map[string]interface{}) bool {
+	for name, value := range values {
+		attr := entity.GetAttribute(name)
+		if attr == nil || fmt.Sprint(attr.GetValue()) != fmt.Sprint(value) {
+			return false
+		}
+	}
+	return true
+}
+
+/////////////////////////////////////////////////////////////////
+// Commit processing
+/////////////////////////////////////////////////////////////////
+
+// commit reads the sections of a commit request and applies them. Nothing is applied when a section
+// cannot be read or an entity fails validation.
+func (obj *Store) commit(is tgdb.TGInputStream) (*commitResult, tgdb.TGTransactionError) {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+
+	in := is.(*impl.ProtocolDataInputStream)
+	newDescs := make(map[int64]*impl.AttributeDescriptor, 0)
+	descOrder := make([]int64, 0)
+	var added, updated, removed []*entityRecord
+	for {
+		avail, err := in.Available()
+		if err != nil || avail < 6 {
+			break
+		}
+		opcode, err := in.ReadShort()
+		if err != nil {
+			return nil, impl.BuildException(tgdb.TGTransactionMalFormed, err.Error())
+		}
+		count, err := in.ReadInt()
+		if err != nil {
+			return nil, impl.BuildException(tgdb.TGTransactionMalFormed, err.Error())
+		}
+		switch opcode {
+		case 0x1010:
+			for i := 0; i < count; i++ {
+				desc := impl.NewAttributeDescriptor(0)
+				if err := desc.ReadExternal(in); err != nil {
+					return nil, impl.BuildException(tgdb.TGTransactionMalFormed, err.Error())
+				}
+				newDescs[desc.GetAttributeId()] = desc
+				descOrder = append(descOrder, desc.GetAttributeId())
+			}
+		case 0x1011, 0x1012, 0x1013:
+			records := make([]*entityRecord, 0, count)
+			for i := 0; i < count; i++ {
+				record, err := obj.readEntity(in, newDescs)
+				if err != nil {
+					return nil, impl.BuildException(tgdb.TGTransactionMalFormed, err.Error())
+				}
+				records = append(records, record)
+			}
+			if opcode == 0x1011 {
+				added = records
+			} else if opcode == 0x1012 {
+				updated = records
+			} else {
+				removed = records
+			}
+		default:
+			errMsg := fmt.Sprintf("Unknown commit section 0x%x", opcode)
+			return nil, impl.BuildException(tgdb.TGTransactionMalFormed, errMsg)
+		}
+	}
+
+	if txnErr := obj.validate(added, updated, removed); txnErr != nil {
+		return nil, txnErr
+	}
+
+	result := &commitResult{}
+	for _, tempId := range descOrder {
+		desc := newDescs[tempId]
+		realDesc := obj.addAttributeDescriptor(desc.GetName(), desc.GetAttrType(), desc.IsAttributeArray())
+		realDesc.SetPrecision(desc.GetPrecision())
+		realDesc.SetScale(desc.GetScale())
+		result.attrDescIds = append(result.attrDescIds, tempId, realDesc.GetAttributeId())
+		desc.SetAttributeId(realDesc.GetAttributeId())
+	}
+
+	// Nodes first, so that new edges can refer to the nodes added in the same transaction
+	realIds := make(map[int64]int64, 0)
+	for _, kind := range []tgdb.TGEntityKind{tgdb.EntityKindNode, tgdb.EntityKindEdge} {
+		for _, record := range added {
+			if record.kind != kind {
+				continue
+			}
+			id, err := obj.addEntity(record, realIds)
+			if err != nil {
+				return nil, impl.BuildException(tgdb.TGTransactionGeneralError, err.Error())
+			}
+			realIds[record.id] = id
+			result.addedIds = append(result.addedIds, record.id, id, 1)
+		}
+	}
+	for _, record := range updated {
+		entity := obj.entities[record.id]
+		for _, av := range record.attrs {
+			if av.value == nil {
+				continue
+			}
+			if err := entity.SetOrCreateAttribute(av.desc.GetName(), av.value); err != nil {
+				return nil, impl.BuildException(tgdb.TGTransactionGeneralError, err.Error())
+			}
+		}
+		entity.SetVersion(record.version + 1)
+		result.updatedIds = append(result.updatedIds, record.id, int64(record.version+1))
+	}
+	for _, record := range removed {
+		delete(obj.entities, record.id)
+		result.removedIds = append(result.removedIds, record.id)
+	}
+	if logger.IsDebug() {
+		logger.Debug(fmt.Sprintf("Returning Store:commit w/ '%d' added, '%d' updated and '%d' removed entities", len(added), len(updated), len(removed)))
+	}
+	return result, nil
+}
+
+// validate checks a commit against the stored entities - updated and removed entities must exist,
+// updates must carry the stored version and new nodes must not repeat a primary key
+func (obj *Store) validate(added, updated, removed []*entityRecord) tgdb.TGTransactionError {
+	for _, record := range append(updated, removed...) {
+		entity, ok := obj.entities[record.id]
+		if !ok {
+			errMsg := fmt.Sprintf("Entity '%d' does not exist", record.id)
+			return impl.BuildException(tgdb.TGTransactionGeneralError, errMsg)
+		}
+		if entity.GetVersion() != record.version {
+			errMsg := fmt.Sprintf("Entity '%d' is at version '%d', not '%d'", record.id, entity.GetVersion(), record.version)
+			return impl.BuildException(tgdb.TGTransactionOptimisticLockFailed, errMsg)
+		}
+	}
+	newKeys := make(map[string]bool, 0)
+	for _, record := range added {
+		if record.kind != tgdb.EntityKindNode {
+			continue
+		}
+		nodeType, _ := obj.gmd.GetNodeTypeById(record.typeId)
+		if nodeType == nil || len(nodeType.GetPKeyAttributeDescriptors()) == 0 {
+			continue
+		}
+		values := make(map[string]interface{}, 0)
+		for _, pKey := range nodeType.GetPKeyAttributeDescriptors() {
+			values[pKey.GetName()] = nil
+			for _, av := range record.attrs {
+				if av.desc.GetName() == pKey.GetName() {
+					values[pKey.GetName()] = av.value
+				}
+			}
+		}
+		keyString := fmt.Sprintf("%s:%v", nodeType.GetName(), values)
+		if newKeys[keyString] || obj.findNode(nodeType.GetName(), values) != nil {
+			errMsg := fmt.Sprintf("A '%s' node with key '%v' already exists", nodeType.GetName(), values)
+			return impl.BuildException(tgdb.TGTransactionUniqueConstraintViolation, errMsg)
+		}
+		newKeys[keyString] = true
+	}
+	return nil
+}
+
+// addEntity stores a new entity, resolving edge end points through the ids assigned in this commit
+func (obj *Store) addEntity(record *entityRecord, realIds map[int64]int64) (int64, tgdb.TGError) {
+	var entity tgdb.TGEntity
+	var base *impl.AbstractEntity
+	if record.kind == tgdb.EntityKindNode {
+		node := impl.NewNode(obj.gmd)
+		if nodeType, _ := obj.gmd.GetNodeTypeById(record.typeId); nodeType != nil {
+			node.SetEntityType(nodeType)
+		}
+		entity, base = node, node.AbstractEntity
+	} else {
+		ends := make([]*impl.Node, 0, 2)
+		for _, id := range []int64{record.fromId, record.toId} {
+			if realId, ok := realIds[id]; ok {
+				id = realId
+			}
+			node, ok := obj.entities[id].(*impl.Node)
+			if !ok {
+				errMsg := fmt.Sprintf("Edge end point '%d' is not a stored node", id)
+				return 0, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+			}
+			ends = append(ends, node)
+		}
+		edge := impl.NewEdgeWithDirection(obj.gmd, ends[0], ends[1], record.direction)
+		if edgeType, _ := obj.gmd.GetEdgeTypeById(record.typeId); edgeType != nil {
+			edge.SetEntityType(edgeType)
+		}
+		entity, base = edge, edge.AbstractEntity
+	}
+	for _, av := range record.attrs {
+		if av.value == nil {
+			continue
+		}
+		if err := entity.SetOrCreateAttribute(av.desc.GetName(), av.value); err != nil {
+			return 0, err
+		}
+	}
+	return obj.store(entity, base), nil
+}
+
+// readEntity reads an entity written by Node.WriteExternal or Edge.WriteExternal
+func (obj *Store) readEntity(in *impl.ProtocolDataInputStream, newDescs map[int64]*impl.AttributeDescriptor) (*entityRecord, tgdb.TGError) {
+	if _, err := in.ReadInt(); err != nil { // entity buffer length
+		return nil, err
+	}
+	if _, err := in.ReadBoolean(); err != nil { // isNew
+		return nil, err
+	}
+	kind, err := in.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	record := &entityRecord{kind: tgdb.TGEntityKind(kind)}
+	if record.id, err = in.ReadLong(); err != nil {
+		return nil, err
+	}
+	if record.version, err = in.ReadInt(); err != nil {
+		return nil, err
+	}
+	if record.typeId, err = in.ReadInt(); err != nil {
+		return nil, err
+	}
+	attrCount, err := in.ReadInt()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < attrCount; i++ {
+		attrId, err := in.ReadInt()
+		if err != nil {
+			return nil, err
+		}
+		desc := newDescs[int64(attrId)]
+		if desc == nil {
+			if d, _ := obj.gmd.GetAttributeDescriptorById(int64(attrId)); d != nil {
+				desc = d.(*impl.AttributeDescriptor)
+			}
+		}
+		if desc == nil {
+			errMsg := fmt.Sprintf("Attribute descriptor '%d' is not defined", attrId)
+			return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+		}
+		if desc.Is_Encrypted() {
+			errMsg := fmt.Sprintf("Encrypted attribute '%s' is not supported", desc.GetName())
+			return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+		}
+		attr, err := impl.CreateAttributeWithDesc(nil, desc, nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := attr.ReadExternal(in); err != nil {
+			return nil, err
+		}
+		record.attrs = append(record.attrs, attrValue{desc: desc, value: attr.GetValue()})
+	}
+	switch record.kind {
+	case tgdb.EntityKindNode:
+		edgeCount, err := in.ReadInt()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < edgeCount; i++ {
+			if _, err := in.ReadLong(); err != nil {
+				return nil, err
+			}
+		}
+	case tgdb.EntityKindEdge:
+		direction, err := in.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		record.direction = tgdb.TGDirectionType(direction)
+		if record.fromId, err = in.ReadLong(); err != nil {
+			return nil, err
+		}
+		if record.toId, err = in.ReadLong(); err != nil {
+			return nil, err
+		}
+	default:
+		errMsg := fmt.Sprintf("Unsupported entity kind '%d'", kind)
+		return nil, impl.GetErrorByType(impl.TGErrorGeneralException, TGDB_MOCKSERVER_ERROR, errMsg, "")
+	}
+	return record, nil
+}
+
+/////////////////////////////////////////////////////////////////
+// Traversal processing
+/////////////////////////////////////////////////////////////////
+
+// traversalStep is an edge that can be followed from a node, with the node it leads to
+type traversalStep struct {
+	edge     *impl.Edge
+	neighbor *impl.Node
+}
+
+// traverse walks the stored graph breadth first, so that global uniqueness keeps the shortest path to a
+// node, and returns the reached entities, nodes before edges, and the results as lists of their ids
+func (obj *Store) traverse(request *impl.TraverseRequestMessage) ([]tgdb.TGEntity, [][]int64, tgdb.TGError) {
+	if request.GetNodeFilter() != "" || request.GetEdgeFilter() != "" {
+		errMsg := "Traversal predicates are not evaluated by the mock server"
+		return nil, nil, impl.GetErrorByType(impl.TGErrorProtocolNotSupported, TGDB_MOCKSERVER_ERROR, errMsg, "")
+	}
+	adjacency := obj.adjacency(request)
+	uniqueness := impl.TraversalUniqueness(request.GetUniqueness())
+	visited := make(map[int64]bool, 0)
+	frontier := make([][]int64, 0)
+	for _, startId := range request.GetStartIds() {
+		if _, ok := obj.entities[startId].(*impl.Node); !ok || (uniqueness == impl.UniqueNodesGlobal && visited[startId]) {
+			continue
+		}
+		visited[startId] = true
+		frontier = append(frontier, []int64{startId})
+	}
+
+	paths := make([][]int64, 0)
+	for depth := 0; depth <= request.GetMaxDepth() && len(frontier) > 0; depth++ {
+		next := make([][]int64, 0)
+		for _, path := range frontier {
+			if depth >= request.GetMinDepth() {
+				paths = append(paths, path)
+			}
+			if depth == request.GetMaxDepth() {
+				continue
+			}
+			steps := adjacency[path[len(path)-1]]
+			if request.GetEdgeLimit() > 0 && len(steps) > request.GetEdgeLimit() {
+				steps = steps[:request.GetEdgeLimit()]
+			}
+			for _, step := range steps {
+				neighborId, edgeId := step.neighbor.GetVirtualId(), step.edge.GetVirtualId()
+				if !allowedStep(uniqueness, path, edgeId, neighborId, visited) {
+					continue
+				}
+				visited[neighborId] = true
+				extended := make([]int64, len(path), len(path)+2)
+				copy(extended, path)
+				next = append(next, append(extended, edgeId, neighborId))
+			}
+		}
+		frontier = next
+	}
+	return obj.traversalResults(impl.TraversalResultShape(request.GetResultShape()), paths)
+}
+
+// adjacency lists the steps that may be taken from each node, in the order of the edge ids
+func (obj *Store) adjacency(request *impl.TraverseRequestMessage) map[int64][]traversalStep {
+	ids := make([]int64, 0, len(obj.entities))
+	for id := range obj.entities {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	direction := tgdb.TGDirection(request.GetDirection())
+	adjacency := make(map[int64][]traversalStep, 0)
+	for _, id := range ids {
+		edge, ok := obj.entities[id].(*impl.Edge)
+		if !ok {
+			continue
+		}
+		if len(request.GetEdgeTypes()) > 0 && !containsEdgeType(request.GetEdgeTypes(), edge) {
+			continue
+		}
+		vertices := edge.GetVertices()
+		fromNode, ok1 := vertices[0].(*impl.Node)
+		toNode, ok2 := vertices[1].(*impl.Node)
+		if !ok1 || !ok2 {
+			continue
+		}
+		directed := edge.GetDirectionType() == tgdb.DirectionTypeDirected
+		if direction != tgdb.DirectionInbound || !directed {
+			adjacency[fromNode.GetVirtualId()] = append(adjacency[fromNode.GetVirtualId()], traversalStep{edge: edge, neighbor: toNode})
+		}
+		if direction != tgdb.DirectionOutbound || !directed {
+			adjacency[toNode.GetVirtualId()] = append(adjacency[toNode.GetVirtualId()], traversalStep{edge: edge, neighbor: fromNode})
+		}
+	}
+	return adjacency
+}
+
+// traversalResults shapes the paths into results and collects the entities they refer to
+func (obj *Store) traversalResults(shape impl.TraversalResultShape, paths [][]int64) ([]tgdb.TGEntity, [][]int64, tgdb.TGError) {
+	results := make([][]int64, 0, len(paths))
+	seen := make(map[int64]bool, 0)
+	for _, path := range paths {
+		var id int64
+		switch shape {
+		case impl.TraversalResultNodes:
+			id = path[len(path)-1]
+		case impl.TraversalResultEdges:
+			if len(path) < 2 {
+				continue
+			}
+			id = path[len(path)-2]
+		default:
+			results = append(results, path)
+			continue
+		}
+		if !seen[id] {
+			seen[id] = true
+			results = append(results, []int64{id})
+		}
+	}
+
+	nodes := make([]tgdb.TGEntity, 0)
+	edges := make([]tgdb.TGEntity, 0)
+	included := make(map[int64]bool, 0)
+	for _, result := range results {
+		for _, id := range result {
+			if included[id] {
+				continue
+			}
+			included[id] = true
+			switch entity := obj.entities[id].(type) {
+			case *impl.Node:
+				nodes = append(nodes, entity)
+			case *impl.Edge:
+				// An edge refers to its end nodes, so they are sent along and read first
+				for _, vertex := range entity.GetVertices() {
+					if !included[vertex.GetVirtualId()] {
+						included[vertex.GetVirtualId()] = true
+						nodes = append(nodes, vertex)
+					}
+				}
+				edges = append(edges, entity)
+			}
+		}
+	}
+	return append(nodes, edges...), results, nil
+}
+
+func allowedStep(uniqueness impl.TraversalUniqueness, path []int64, edgeId, neighborId int64, visited map[int64]bool) bool {
+	switch uniqueness {
+	case impl.UniqueNodesGlobal:
+		return !visited[neighborId]
+	case impl.UniqueNodesPerPath:
+		for i := 0; i < len(path); i += 2 {
+			if path[i] == neighborId {
+				return false
+			}
+		}
+	case impl.UniqueEdgesPerPath:
+		for i := 1; i < len(path); i += 2 {
+			if path[i] == edgeId {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func containsEdgeType(edgeTypes []string, edge *impl.Edge) bool {
+	if edge.GetEntityType() == nil {
+		return false
+	}
+	for _, name := range edgeTypes {
+		if name == edge.GetEntityType().GetName() {
+			return true
+		}
+	}
+	return false
+}
@@ -130,7 +130,14 @@ type TGQuery interface {
 	String() string
 }
 
+// TGFilter is a predicate over entities. It can be evaluated on the client or rendered for the server
 type TGFilter interface {
+	// Matches evaluates the filter against an entity on the client side
+	Matches(entity TGEntity) bool
+	// ToTGQL renders the filter as a TGQL expression for ExecuteQuery
+	ToTGQL() (string, TGError)
+	// ToGremlin renders the filter as Gremlin filter steps that can follow g.V()
+	ToGremlin() (string, TGError)
 }
 
 type TGTraversalDescriptor interface {
@@ -21,8 +21,6 @@
 
 package tgdb
 
-import "bytes"
-
 // ======= Various Transaction Status Returned from TGDB server =======
 type TGTransactionStatus int
 
@@ -74,40 +72,35 @@ func FromStatus(status int) TGTransactionStatus {
 }
 
 func (txnStatus TGTransactionStatus) String() string {
-	// Use a buffer for efficient string concatenation
-	var buffer bytes.Buffer
-	buffer.WriteString("")
-
-	if txnStatus&TGTransactionInvalid == TGTransactionInvalid {
-		buffer.WriteString("TransactionInvalid")
-	} else if txnStatus&TGTransactionSuccess == TGTransactionSuccess {
-		buffer.WriteString("TransactionSuccess")
-	} else if txnStatus&TGTransactionAlreadyInProgress == TGTransactionAlreadyInProgress {
-		buffer.WriteString("TransactionAlreadyInProgress")
-	} else if txnStatus&TGTransactionClientDisconnected == TGTransactionClientDisconnected {
-		buffer.WriteString("TransactionClientDisconnected")
-	} else if txnStatus&TGTransactionMalFormed == TGTransactionMalFormed {
-		buffer.WriteString("TransactionMalFormed")
-	} else if txnStatus&TGTransactionGeneralError == TGTransactionGeneralError {
-		buffer.WriteString("TransactionGeneralError")
-	} else if txnStatus&TGTransactionVerificationError == TGTransactionVerificationError {
-		buffer.WriteString("TransactionVerificationError")
-	} else if txnStatus&TGTransactionInBadState == TGTransactionInBadState {
-		buffer.WriteString("TransactionInBadState")
-	} else if txnStatus&TGTransactionUniqueConstraintViolation == TGTransactionUniqueConstraintViolation {
-		buffer.WriteString("TransactionUniqueConstraintViolation")
-	} else if txnStatus&TGTransactionOptimisticLockFailed == TGTransactionOptimisticLockFailed {
-		buffer.WriteString("TransactionOptimisticLockFailed")
-	} else if txnStatus&TGTransactionResourceExceeded == TGTransactionResourceExceeded {
-		buffer.WriteString("TransactionResourceExceeded")
-	} else if txnStatus&TGCurrentThreadNotInTransaction == TGCurrentThreadNotInTransaction {
-		buffer.WriteString("CurrentThreadNotInTransaction")
-	} else if txnStatus&TGTransactionUniqueIndexKeyAttributeNullError == TGTransactionUniqueIndexKeyAttributeNullError {
-		buffer.WriteString("TransactionUniqueIndexKeyAttributeNullError")
-	}
-	if buffer.Len() == 0 {
-		return ""
+	// The statuses are distinct values rather than bit flags
+	switch txnStatus {
+	case TGTransactionInvalid:
+		return "TransactionInvalid"
+	case TGTransactionSuccess:
+		return "TransactionSuccess"
+	case TGTransactionAlreadyInProgress:
+		return "TransactionAlreadyInProgress"
+	case TGTransactionClientDisconnected:
+		return "TransactionClientDisconnected"
+	case TGTransactionMalFormed:
+		return "TransactionMalFormed"
+	case TGTransactionGeneralError:
+		return "TransactionGeneralError"
+	case TGTransactionVerificationError:
+		return "TransactionVerificationError"
+	case TGTransactionInBadState:
+		return "TransactionInBadState"
+	case TGTransactionUniqueConstraintViolation:
+		return "TransactionUniqueConstraintViolation"
+	case TGTransactionOptimisticLockFailed:
+		return "TransactionOptimisticLockFailed"
+	case TGTransactionResourceExceeded:
+		return "TransactionResourceExceeded"
+	case TGCurrentThreadNotInTransaction:
+		return "CurrentThreadNotInTransaction"
+	case TGTransactionUniqueIndexKeyAttributeNullError:
+		return "TransactionUniqueIndexKeyAttributeNullError"
 	}
-	return buffer.String()
+	return ""
 }
 
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File Name: transactionstatus_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package tgdb
+
+import "testing"
+
+func TestTransactionStatusString(t *testing.T) {
+	expected := map[TGTransactionStatus]string{
+		TGTransactionInvalid:                   "TransactionInvalid",
+		TGTransactionSuccess:                   "TransactionSuccess",
+		TGTransactionAlreadyInProgress:         "TransactionAlreadyInProgress",
+		TGTransactionUniqueConstraintViolation: "TransactionUniqueConstraintViolation",
+		TGTransactionOptimisticLockFailed:      "TransactionOptimisticLockFailed",
+		TGCurrentThreadNotInTransaction:        "CurrentThreadNotInTransaction",
+	}
+	for status, name := range expected {
+		if status.String() != name {
+			t.Errorf("Expected status %d to be '%s', got '%s'", int(status), name, status.String())
+		}
+	}
+	if FromStatus(int(TGTransactionResourceExceeded)).String() != "TransactionResourceExceeded" {
+		t.Errorf("Expected a status read off the wire to keep its name")
+	}
+}
@@ -34,13 +34,14 @@ import (
 	"tgdb"
 	"tgdb/factory"
 	"tgdb/impl"
+	"tgdb/metrics"
 	"tgdbrest"
 	"time"
 )
 
 var topURLBase string
 var authenticateURLBase string
-var disconnectURLBase string
+var logoutURLBase string
 var hostPort string
 var pingURLBase string
 var transactionURLBase string
@@ -50,12 +51,15 @@ var adminURLBase string
 var metadataURLBase string
 var entityURLBase string
 var importExportURLBase string
+var metricsURL string
 
 var connPool tgdb.TGConnectionPool
+var sessionPool tgdb.TGConnectionPool
+var sessionManager *tgdbrest.TGDBRestSessionManager
+var oDataPool tgdb.TGConnectionPool
+var oDataSessionManager *tgdbrest.TGDBRestSessionManager
 
 
-var mapUSERNAME_AND_REMOTE_IP2TOKEN = make(map[string] tgdbrest.TGDBRestOAuthMetadataForConnection)
-
 var TGDB_REST_PATH = "/TGDB/OData.svc/"
 var TGDB_REST_FULL_PATH = "https://localhost:3001/TGDB/OData.svc/"
 var TGDB_REST_METADATA_PATH = "/TGDB/OData.svc/$metadata"
@@ -69,7 +73,13 @@ var hostPort4OData = ""
 var dbURL4OData = ""
 var TGDB_REST_API_USERNAME = "api"
 var TGDB_REST_API_PASSWORD = "api"
+var TGDB_REST_API_PASSWORD_ENV = ""
+var TGDB_REST_API_PASSWORD_FILE = ""
 var TGDB_REST_DB_CONNECTION_POOL_SIZE = 5
+var TGDB_REST_MAX_SESSIONS = 20
+var TGDB_REST_MAX_ODATA_REQUESTS = 5
+var TGDB_REST_SESSION_IDLE_TIMEOUT = 900
+var TGDB_REST_SESSION_LIFETIME = 28800
 var HTTP_PROTOCOL = "http"
 
 var engineNamePtr *string
@@ -185,11 +195,20 @@ func main() {
 	logFileCountPtr = flag.Int("logfilecount", 10, "Specify Log File Count")
 	logFileSizePtr = flag.Int("logfilesize", 10, "Specify Max Log File Size")
 	logToConsolePtr = flag.Bool("logtoconsole", true, "Specify the messages on console")
+	metricsIntervalPtr := flag.Int("metricsinterval", 30, "Specify Server Info Poll Interval In Seconds")
+	userPtr := flag.String("user", TGDB_REST_API_USERNAME, "Specify Database User Of The Connection Pool")
+	passwordEnvPtr := flag.String("passwordenv", "", "Specify Environment Variable Holding The Database Password")
+	passwordFilePtr := flag.String("passwordfile", "", "Specify File Holding The Database Password")
+	unsafeDebugPtr := flag.Bool("unsafedebug", false, "Specify Whether Secrets Are Written To The Log")
+	maxSessionsPtr := flag.Int("maxsessions", TGDB_REST_MAX_SESSIONS, "Specify Maximum Number Of Signed In Users")
+	maxODataRequestsPtr := flag.Int("maxodatarequests", TGDB_REST_MAX_ODATA_REQUESTS, "Specify Maximum Number Of OData Requests Served At Once")
+	sessionIdleTimeoutPtr := flag.Int("sessionidletimeout", TGDB_REST_SESSION_IDLE_TIMEOUT, "Specify Session Idle Timeout In Seconds")
+	sessionLifetimePtr := flag.Int("sessionlifetime", TGDB_REST_SESSION_LIFETIME, "Specify Session Lifetime In Seconds")
 
 
 	flag.Usage = func() {
-		usageString := "usage: tgdb-rest [--listen <host:port>] [--dburl db_url] [--name name] [--loglevel Error|Warning|Info|Debug] [--logdir log_directory_path] [--logtoconsole true|false]\n\n"
-		fmt.Fprintf(os.Stdout, usageString)
+		usageString := "usage: tgdb-rest [--listen <host:port>] [--dburl db_url] [--name name] [--loglevel Error|Warning|Info|Debug] [--logdir log_directory_path] [--logtoconsole true|false] [--metricsinterval seconds] [--user user] [--passwordenv env_var | --passwordfile file_path] [--maxsessions count] [--maxodatarequests count] [--sessionidletimeout seconds] [--sessionlifetime seconds] [--unsafedebug]\n\n"
+		fmt.Fprint(os.Stdout, usageString)
 		fmt.Fprintf(os.Stdout, "optional arguments:\n")
 		fmt.Fprintf(os.Stdout, "  --listen <host:port>  The host & port for server to listen to         (default \"localhost:9500\").\n")
 		fmt.Fprintf(os.Stdout, "  --dburl db_name       The URL to connect to TIBCO Graph Database      (default \"tcp://scott@localhost:8222/{dbName=demodb}\").\n")
@@ -199,6 +218,15 @@ func main() {
 		//fmt.Fprintf(os.Stdout, "  --logfilesize         The max filesize of each log file in MB         (default 10 MB)\n")
 		//fmt.Fprintf(os.Stdout, "  --logfilecount        The max number of log files (rollover after threashold) (default 10)\n")
 		fmt.Fprintf(os.Stdout, "  --logtoconsole        The boolean value to log messages on console    (default true)\n")
+		fmt.Fprintf(os.Stdout, "  --metricsinterval     The seconds between server polls for /TGDB/metrics (default 30)\n")
+		fmt.Fprintf(os.Stdout, "  --user user           The database user of the connection pool        (default \"api\").\n")
+		fmt.Fprintf(os.Stdout, "  --passwordenv env_var The environment variable holding the password of the user, read on every reconnect.\n")
+		fmt.Fprintf(os.Stdout, "  --passwordfile path   The file holding the password of the user, read again whenever it changes.\n")
+		fmt.Fprintf(os.Stdout, "  --maxsessions count   The maximum number of users signed in at once, each holds a connection (default 20)\n")
+		fmt.Fprintf(os.Stdout, "  --maxodatarequests count The maximum number of OData requests served at once, each holds a connection (default 5)\n")
+		fmt.Fprintf(os.Stdout, "  --sessionidletimeout  The seconds of inactivity after which a session token is revoked (default 900)\n")
+		fmt.Fprintf(os.Stdout, "  --sessionlifetime     The seconds after which a session token expires  (default 28800)\n")
+		fmt.Fprintf(os.Stdout, "  --unsafedebug         Write passwords, tokens and encrypted values to the log. Local troubleshooting only (default false)\n")
 		fmt.Fprintf(os.Stdout, "  -h, --help            Show this help message.\n")
 	}
 
@@ -206,6 +234,13 @@ func main() {
 	//slogger.Log("Engine-Name: " + *engineNamePtr)
 	hostPort4OData = *hostPortPtr
 	dbURL4OData = *dbURLPtr
+	TGDB_REST_API_USERNAME = *userPtr
+	TGDB_REST_API_PASSWORD_ENV = *passwordEnvPtr
+	TGDB_REST_API_PASSWORD_FILE = *passwordFilePtr
+	TGDB_REST_MAX_SESSIONS = *maxSessionsPtr
+	TGDB_REST_MAX_ODATA_REQUESTS = *maxODataRequestsPtr
+	TGDB_REST_SESSION_IDLE_TIMEOUT = *sessionIdleTimeoutPtr
+	TGDB_REST_SESSION_LIFETIME = *sessionLifetimePtr
 
 	*logFileSizePtr = *logFileSizePtr * 1000000
 	//*logFileSizePtr = *logFileSizePtr * 1000
@@ -268,6 +303,9 @@ func main() {
 	logger.SetLogPrefix("")
 
 	logger.Info("LogLevel:       " + logLvl)
+	if *unsafeDebugPtr {
+		impl.SetUnsafeDebug(true)
+	}
 	logger.Info("TIBCO Graph Database REST Server Starting...")
 
 	initializeSetupData ();
@@ -286,6 +324,7 @@ func main() {
 		return
 	}
 	logDBSpecificInfo ()
+	registerMetricsURL(time.Duration(*metricsIntervalPtr) * time.Second)
 
 	logger.Info("TIBCO Graph Database REST Server Running At: " + hostPort4OData)
 	error := http.ListenAndServe(hostPort4OData, nil)
@@ -298,6 +337,10 @@ func main() {
 func logDBSpecificInfo() {
 	logger.Info("Connected to TIBCO Graph Database URL: " + dbURL4OData)
 	logger.Info("Connection Pool Size: " + strconv.Itoa(TGDB_REST_DB_CONNECTION_POOL_SIZE))
+	logger.Info("Maximum Sessions:     " + strconv.Itoa(TGDB_REST_MAX_SESSIONS))
+	logger.Info("Max OData Requests:   " + strconv.Itoa(TGDB_REST_MAX_ODATA_REQUESTS))
+	logger.Info("Session Idle Timeout: " + strconv.Itoa(TGDB_REST_SESSION_IDLE_TIMEOUT) + " Seconds")
+	logger.Info("Session Lifetime:     " + strconv.Itoa(TGDB_REST_SESSION_LIFETIME) + " Seconds")
 }
 
 func registerTransactionURL() {
@@ -306,29 +349,36 @@ func registerTransactionURL() {
 }
 
 func transactionURLHandler(w http.ResponseWriter, r *http.Request) {
-	headers, body, nToken, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
+	defer sessionManager.Release(session)
 
-	connection, prevToken, err := setConnectionWithUserToken (nToken)
-	if err != nil {
+	headers, body, bResult := readRESTRequest(w, r)
+	if !bResult {
 		return
 	}
 
 	verb := headers["Verb"]
 	if len(verb) < 1 || strings.Compare(strings.ToLower(verb), "createnode") == 0 {
-		tgdbrest.RESTTransaction(connection, w, r, headers, body)
+		tgdbrest.RESTTransaction(session.GetConnection(), w, r, headers, body)
 	}
-	resetConnectionWithPrevToken(connection, prevToken)
-
 }
 
 func initTGDBConnectionPool() tgdb.TGError {
 	connFactory := impl.NewTGConnectionFactory()
 	var err tgdb.TGError
 
-	connPool, err = connFactory.CreateConnectionPoolWithType(dbURL4OData, TGDB_REST_API_USERNAME, TGDB_REST_API_PASSWORD, TGDB_REST_DB_CONNECTION_POOL_SIZE, nil, tgdb.TypeAdmin)
+	var credentials tgdb.TGCredentialProvider
+	if TGDB_REST_API_PASSWORD_ENV != "" {
+		credentials = impl.NewEnvCredentialProvider(TGDB_REST_API_USERNAME, TGDB_REST_API_PASSWORD_ENV)
+	} else if TGDB_REST_API_PASSWORD_FILE != "" {
+		credentials = impl.NewFileCredentialProvider(TGDB_REST_API_USERNAME, TGDB_REST_API_PASSWORD_FILE)
+	} else {
+		credentials = impl.NewStaticCredentialProvider(TGDB_REST_API_USERNAME, []byte(TGDB_REST_API_PASSWORD))
+	}
+	connPool, err = connFactory.CreateConnectionPoolWithCredentials(dbURL4OData, credentials, TGDB_REST_DB_CONNECTION_POOL_SIZE, nil, tgdb.TypeAdmin)
 	if (err != nil) {
 		logger.Error(err.Error())
 		return err
@@ -339,9 +389,52 @@ func initTGDBConnectionPool() tgdb.TGError {
 		logger.Error(err.Error())
 		return err
 	}
+
+	// Every session signs its user in on a connection of its own, so the session pool may not share channels
+	sessionEnv := map[string]string{"tgdb.connectionpool.useDedicatedChannelPerConnection": "true"}
+	sessionPool, err = connFactory.CreateConnectionPoolWithCredentials(dbURL4OData, credentials, TGDB_REST_MAX_SESSIONS, sessionEnv, tgdb.TypeAdmin)
+	if (err != nil) {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = sessionPool.Connect();
+	if (err != nil) {
+		logger.Error(err.Error())
+		return err
+	}
+
+	sessionManager = tgdbrest.NewTGDBRestSessionManager(sessionPool,
+		time.Duration(TGDB_REST_SESSION_IDLE_TIMEOUT) * time.Second, time.Duration(TGDB_REST_SESSION_LIFETIME) * time.Second)
+
+	// OData requests sign in for the length of the request. They get a pool of their own, so that a burst of them
+	// cannot take the connections of the signed in users, nor the other way round
+	oDataPool, err = connFactory.CreateConnectionPoolWithCredentials(dbURL4OData, credentials, TGDB_REST_MAX_ODATA_REQUESTS, sessionEnv, tgdb.TypeAdmin)
+	if (err != nil) {
+		logger.Error(err.Error())
+		return err
+	}
+
+	err = oDataPool.Connect();
+	if (err != nil) {
+		logger.Error(err.Error())
+		return err
+	}
+
+	oDataSessionManager = tgdbrest.NewTGDBRestSessionManager(oDataPool,
+		time.Duration(TGDB_REST_SESSION_IDLE_TIMEOUT) * time.Second, time.Duration(TGDB_REST_SESSION_LIFETIME) * time.Second)
 	return nil
 }
 
+func registerMetricsURL(interval time.Duration) {
+	// register the Prometheus metrics endpoint - the server info is polled through the admin connection pool
+	exporter := metrics.NewExporter()
+	exporter.AddConnectionPool("tgdb-rest", connPool)
+	exporter.PollServerInfo(metrics.PoolSource(connPool), interval)
+	http.Handle(metricsURL, exporter)
+	logger.Info("Registered REST URL: " + HTTP_PROTOCOL + "://" + hostPort4OData + metricsURL)
+}
+
 func registerTopURL() {
 	// register the topURLBase
 	http.HandleFunc(topURLBase, topURLHandler)
@@ -370,8 +463,9 @@ func registerConnectDisconnectURL() {
 	http.HandleFunc(authenticateURLBase, authenticationURLHandler)
 	logger.Info("Registered REST URL: " + HTTP_PROTOCOL + "://" + hostPort4OData + authenticateURLBase)
 
-	//logger.Info ("Registering: " + disconnectURLBase)
-	//http.HandleFunc(disconnectURLBase, disconnectURLHandler)
+	// register the logout URL for revoking session tokens
+	http.HandleFunc(logoutURLBase, logoutURLHandler)
+	logger.Info("Registered REST URL: " + HTTP_PROTOCOL + "://" + hostPort4OData + logoutURLBase)
 }
 
 func registerMetadataURL() {
@@ -413,7 +507,7 @@ func initializeSetupData() {
 	topURLBase = "/TGDB/"
 
 	authenticateURLBase = topURLBase + "Authenticate" + "/"
-	disconnectURLBase = topURLBase + "Disconnect" + "/"
+	logoutURLBase = topURLBase + "Logout" + "/"
 	pingURLBase = topURLBase + "Ping" + "/"
 	transactionURLBase = topURLBase + "Transaction" + "/"
 	queryURLBase = topURLBase + "Query" + "/"
@@ -422,6 +516,7 @@ func initializeSetupData() {
 	metadataURLBase = topURLBase + "Metadata" + "/"
 	entityURLBase = topURLBase + "Entity" + "/"
 	importExportURLBase = topURLBase + "ImportExport" + "/"
+	metricsURL = topURLBase + "metrics"
 }
 
 func vizFileServHandler (w http.ResponseWriter, r *http.Request) {
@@ -430,7 +525,7 @@ func vizFileServHandler (w http.ResponseWriter, r *http.Request) {
 
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "POST, GET")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 
 		w.WriteHeader(204)
 		return
@@ -447,7 +542,7 @@ func vizFileServHandler (w http.ResponseWriter, r *http.Request) {
 	} else {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "POST, GET")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 		w.Write(dat)
 	}
 }
@@ -455,24 +550,28 @@ func vizFileServHandler (w http.ResponseWriter, r *http.Request) {
 func topURLHandler (w http.ResponseWriter, r *http.Request) {
 	//fmt.Fprintf(w,"Request Method is: %s", r.Method);
 
-	_, _, _, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
+	defer sessionManager.Release(session)
 
-	fmt.Fprintf(w, createURLsForAllEndpoints(topURLBase))
+	w.Write([]byte(createURLsForAllEndpoints(topURLBase)))
 }
 
 func metadataURLHandler4NodeTypes (w http.ResponseWriter, r *http.Request) {
 
-	headers, body, nToken, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
-	connection, prevToken, err := setConnectionWithUserToken (nToken)
-	if err != nil {
+	defer sessionManager.Release(session)
+
+	headers, body, bResult := readRESTRequest(w, r)
+	if !bResult {
 		return
 	}
+	connection := session.GetConnection()
 
 	bodyMap := make(map[string]string)
 
@@ -481,47 +580,21 @@ func metadataURLHandler4NodeTypes (w http.ResponseWriter, r *http.Request) {
 	}
 
 	tgdbrest.MetadataQueryForNodeTypes(connection, w, r, headers, bodyMap);
-	resetConnectionWithPrevToken(connection, prevToken)
-}
-
-func resetConnectionWithPrevToken(conn tgdb.TGConnection,  prevToken int64) tgdb.TGError {
-	conn.GetChannel().SetAuthToken(prevToken)
-	logger.Debug("ConnectionPool Release " + strconv.FormatInt(conn.GetConnectionId(), 10))
-	_, err := connPool.ReleaseConnection(conn)
-
-	if err != nil {
-		logger.Error("error:" + err.Error())
-		return err
-	}
-	return nil
-}
-
-func setConnectionWithUserToken(nToken int64) (tgdb.TGConnection, int64, tgdb.TGError) {
-	conn, err := connPool.Get()
-	logger.Debug ("ConnectionPool Get " + strconv.FormatInt(conn.GetConnectionId(), 10))
-
-	if err != nil {
-		logger.Error("error:" + err.Error())
-		return nil, -1, err
-	}
-
-	channel := conn.GetChannel()
-	prevToken := channel.GetAuthToken()
-	channel.SetAuthToken(nToken)
-	return conn, prevToken, nil
 }
 
 func metadataURLHandler4EdgeTypes (w http.ResponseWriter, r *http.Request) {
 
-	headers, body, nToken, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
+	defer sessionManager.Release(session)
 
-	connection, prevToken, err := setConnectionWithUserToken (nToken)
-	if err != nil {
+	headers, body, bResult := readRESTRequest(w, r)
+	if !bResult {
 		return
 	}
+	connection := session.GetConnection()
 
 	bodyMap := make(map[string]string)
 
@@ -529,19 +602,20 @@ func metadataURLHandler4EdgeTypes (w http.ResponseWriter, r *http.Request) {
 		bodyMap[k] = v.(string)
 	}
 	tgdbrest.MetadataQueryForEdgeTypes(connection, w, r, headers, bodyMap);
-	resetConnectionWithPrevToken(connection, prevToken)
 }
 
 func metadataURLHandler4AttributeDescriptors (w http.ResponseWriter, r *http.Request) {
-	headers, body, nToken, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
+	defer sessionManager.Release(session)
 
-	connection, prevToken, err := setConnectionWithUserToken (nToken)
-	if err != nil {
+	headers, body, bResult := readRESTRequest(w, r)
+	if !bResult {
 		return
 	}
+	connection := session.GetConnection()
 
 	bodyMap := make(map[string]string)
 
@@ -556,19 +630,20 @@ func metadataURLHandler4AttributeDescriptors (w http.ResponseWriter, r *http.Req
 	} else if strings.Compare(strings.ToLower(verb), "create") == 0 {
 		tgdbrest.MetadataCreateForAttributeDescriptors(connection, w, r, headers, bodyMap)
 	}
-	resetConnectionWithPrevToken(connection, prevToken)
 }
 
 func metadataURLHandler4Users (w http.ResponseWriter, r *http.Request) {
-	headers, body, nToken, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
+	defer sessionManager.Release(session)
 
-	connection, prevToken, err := setConnectionWithUserToken (nToken)
-	if err != nil {
+	headers, body, bResult := readRESTRequest(w, r)
+	if !bResult {
 		return
 	}
+	connection := session.GetConnection()
 
 	bodyMap := make(map[string]string)
 
@@ -581,21 +656,20 @@ func metadataURLHandler4Users (w http.ResponseWriter, r *http.Request) {
 	if len(verb) < 1 || strings.Compare(strings.ToLower(verb), "get") == 0 {
 		tgdbrest.MetadataQueryForUsers(connection, w, r, headers, bodyMap)
 	}
-
-	resetConnectionWithPrevToken(connection, prevToken)
-
 }
 
 func metadataURLHandler4Connections (w http.ResponseWriter, r *http.Request) {
-	headers, body, nToken, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
+	defer sessionManager.Release(session)
 
-	connection, prevToken, err := setConnectionWithUserToken (nToken)
-	if err != nil {
+	headers, body, bResult := readRESTRequest(w, r)
+	if !bResult {
 		return
 	}
+	connection := session.GetConnection()
 
 	bodyMap := make(map[string]string)
 
@@ -607,9 +681,6 @@ func metadataURLHandler4Connections (w http.ResponseWriter, r *http.Request) {
 	if len(verb) < 1 || strings.Compare(strings.ToLower(verb), "get") == 0 {
 		tgdbrest.MetadataQueryForConnections(connection, w, r, headers, bodyMap)
 	}
-
-	resetConnectionWithPrevToken(connection, prevToken)
-
 }
 
 
@@ -620,19 +691,22 @@ func queryURLHandler (w http.ResponseWriter, r *http.Request) {
 	if strings.Compare(strings.ToLower("Options"), strings.ToLower(r.Method)) == 0 {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "POST, GET")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 		w.WriteHeader(204)
 		return
 	}
 
-	headers, body, nToken, bResult := isAuthenticRequest(w, r)
+	session, bResult := authorizeRequest(w, r)
 	if !bResult {
 		return
 	}
-	connection, prevToken, err := setConnectionWithUserToken (nToken)
-	if err != nil {
+	defer sessionManager.Release(session)
+
+	headers, body, bResult := readRESTRequest(w, r)
+	if !bResult {
 		return
 	}
+	connection := session.GetConnection()
 
 	bodyMap := make(map[string]string)
 
@@ -649,8 +723,6 @@ func queryURLHandler (w http.ResponseWriter, r *http.Request) {
 		logger.Debug("Query String: " + queryStr)
 		logger.Debug("Query Execution Time: " + diff.String())
 	}
-
-	resetConnectionWithPrevToken(connection, prevToken)
 }
 
 
@@ -690,8 +762,8 @@ func createURLsForAllEndpoints (topURLBase string) string {
 	restEndpointsInfo.EndpointInfo[8].URL = hostPort + authenticateURLBase
 	restEndpointsInfo.EndpointInfo[8].Description = "This is Authentication Endpoint"
 
-	restEndpointsInfo.EndpointInfo[9].URL = hostPort + disconnectURLBase
-	restEndpointsInfo.EndpointInfo[9].Description = "This is Disconnect Endpoint"
+	restEndpointsInfo.EndpointInfo[9].URL = hostPort + logoutURLBase
+	restEndpointsInfo.EndpointInfo[9].Description = "This is Logout Endpoint"
 
 	b, err := json.MarshalIndent(restEndpointsInfo, "", "\t")
 	if err != nil {
@@ -709,65 +781,95 @@ func authenticationURLHandler (w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var err tgdb.TGError
-
-	conn, err := connPool.Get()
+	// The user gets a connection of the session pool, signed in as the user, until the token is revoked or expires
+	token, session, err := sessionManager.Authenticate(user, []byte(pass))
 	if err != nil {
-		logger.Error("Returning from SimpleConnectAndDisconnect - error during conn.Connect: " + err.Error())
+		if err.GetErrorType() == impl.TGErrorBadAuthentication {
+			w.WriteHeader(401)
+			w.Write([]byte("Unauthorised.\n"))
+		} else {
+			logger.Error("Unable to start a session for user '" + user + "': " + err.Error())
+			w.WriteHeader(503)
+			w.Write([]byte("Service Unavailable.\n"))
+		}
 		return
 	}
 
-	defer connPool.ReleaseConnection(conn)
-
-	prevToken := conn.GetChannel().GetAuthToken()
+	var authResponse tgdbrest.TGDBRestAuthenticateResponse
+	authResponse.Token = token
+	authResponse.TokenType = "Bearer"
+	authResponse.ExpiresIn = int64(session.GetExpiresAt().Sub(session.GetCreatedAt()) / time.Second)
+	authResponse.IdleTimeout = int64(TGDB_REST_SESSION_IDLE_TIMEOUT)
+	b, error := json.MarshalIndent(authResponse, "", "\t")
+	if error != nil {
+		logger.Error("error:" + error.Error())
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(b)
+}
 
-	absChannel, ok := conn.GetChannel().(*impl.TCPChannel)
-	if ok {
-		absChannel.SetChannelUserName(user)
-		absChannel.SetChannelPassword([]byte(pass))
+func logoutURLHandler (w http.ResponseWriter, r *http.Request) {
+	if strings.Compare(strings.ToLower("Options"), strings.ToLower(r.Method)) == 0 {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, GET")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.WriteHeader(204)
+		return
 	}
 
-	err = absChannel.DoAuthenticateForRESTConsumer()
-	if err != nil {
-		// Handle the error here
+	if !sessionManager.Logout(bearerToken(r)) {
+		writeUnauthorized(w)
+		return
 	}
-	userToken := absChannel.GetAuthToken()
 
-	absChannel.SetAuthToken(prevToken)
+	var disconnectResponse tgdbrest.DisconnectResponse
+	disconnectResponse.Description = "Session Logged Out Successfully."
 
-	var authResponse tgdbrest.TGDBRestAuthenticateResponse
-	authResponse.Token = strconv.FormatInt(userToken, 10)
-	b, error := json.MarshalIndent(authResponse, "", "\t")
-	if error != nil {
+	b, err := json.MarshalIndent(disconnectResponse, "", "\t")
+	if err != nil {
 		logger.Error("error:" + err.Error())
 	}
-	fmt.Fprintf(w, string(b))
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Write(b)
 }
 
+// authorizeRequest returns the session of the bearer token in the Authorization header. The session is held for
+// the request and must be handed back with sessionManager.Release
+func authorizeRequest (w http.ResponseWriter, r *http.Request) (*tgdbrest.TGDBRestSession, bool) {
+	session, ok := sessionManager.Acquire(bearerToken(r))
+	if !ok {
+		writeUnauthorized(w)
+		return nil, false
+	}
+	return session, true
+}
 
-//func disconnectURLHandler (w http.ResponseWriter, r *http.Request) {
-//
-//	_, _, nToken, bResult := isAuthenticRequest(w, r)
-//	if !bResult {
-//		return
-//	}
-//
-//	connection := mapToken2Connection[nToken]
-//	connection.Disconnect()
-//	delete(mapToken2Connection, nToken)
-//	var disconnectResponse tgdbrest.DisconnectResponse
-//	disconnectResponse.Description = "Client Disconnected Successfully."
-//
-//	b, err := json.MarshalIndent(disconnectResponse, "", "\t")
-//	if err != nil {
-//		logger.Error("error:" + err.Error())
-//	}
-//	fmt.Fprintf(w, string(b))
-//}
+// bearerToken returns the token of an 'Authorization: Bearer <token>' header, if any
+func bearerToken (r *http.Request) string {
+	authorization := strings.TrimSpace(r.Header.Get("Authorization"))
+	if len(authorization) < 7 || !strings.EqualFold(authorization[:7], "Bearer ") {
+		return ""
+	}
+	return strings.TrimSpace(authorization[7:])
+}
 
+func writeUnauthorized (w http.ResponseWriter) {
+	var tgdbError tgdbrest.TGDBRESTError
+	tgdbError.ErrorMessage = "Please specify a valid session token as 'Authorization: Bearer <token>' in the request."
 
+	b, err := json.MarshalIndent(tgdbError, "", "\t")
+	if err != nil {
+		logger.Error("error:" + err.Error())
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, GET")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+	w.Header().Set("WWW-Authenticate", "Bearer realm=\"tgdb\"")
+	w.WriteHeader(401)
+	w.Write(b)
+}
 
-func isAuthenticRequest (w http.ResponseWriter, r *http.Request) (map[string] string, map[string] interface{}, int64, bool) {
+func readRESTRequest (w http.ResponseWriter, r *http.Request) (map[string] string, map[string] interface{}, bool) {
 
 	var restNodeTypesRequest tgdbrest.TGDBRestRequest
 
@@ -775,39 +877,9 @@ func isAuthenticRequest (w http.ResponseWriter, r *http.Request) (map[string] st
 	if err != nil {
 		logger.Error("Error: " + err.Error())
 		http.Error(w, err.Error(), http.StatusBadRequest)
-		return nil, nil, -1, false
-	}
-
-	api_auth_token := restNodeTypesRequest.Headers["Token"]
-
-	if api_auth_token == "" {
-		var tgdbError tgdbrest.TGDBRESTError
-		tgdbError.ErrorMessage = "Please specify valid " + TGDB_AUTH_TOKEN + " as a query parameter in the request."
-
-		b, err := json.MarshalIndent(tgdbError, "", "\t")
-		if err != nil {
-			logger.Error("error:" + err.Error())
-		}
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, GET")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-		fmt.Fprintf(w, string(b))
-		return nil, nil, -1, false
+		return nil, nil, false
 	}
-
-	nToken, err := strconv.ParseInt(api_auth_token, 10, 64)
-	if err != nil {
-		var tgdbError tgdbrest.TGDBRESTError
-		tgdbError.ErrorMessage = "Please specify valid " + TGDB_AUTH_TOKEN + " as a query parameter in the request."
-
-		b, err := json.MarshalIndent(tgdbError, "", "\t")
-		if err != nil {
-			logger.Error("error:" + err.Error())
-		}
-		fmt.Fprintf(w, string(b))
-		return nil, nil, -1, false
-	}
-	return restNodeTypesRequest.Headers, restNodeTypesRequest.Body, nToken, true
+	return restNodeTypesRequest.Headers, restNodeTypesRequest.Body, true
 }
 
 /*
@@ -836,29 +908,29 @@ func oDataURLHandler (w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	conn, err := connPool.Get()
-
+	// OData clients send basic credentials with every request, so the session lasts for this request only
+	token, _, err := oDataSessionManager.Authenticate(user, []byte(pass))
 	if err != nil {
-		logger.Error("Error during Connect to TGDB Server: " + err.Error())
-		w.WriteHeader(500)
-		w.Write([]byte(err.Error()))
+		if err.GetErrorType() == impl.TGErrorBadAuthentication {
+			w.WriteHeader(401)
+			w.Write([]byte("Unauthorised.\n"))
+		} else {
+			logger.Error("Unable to start a session for user '" + user + "': " + err.Error())
+			w.WriteHeader(503)
+			w.Write([]byte("Service Unavailable.\n"))
+		}
 		return
 	}
+	defer oDataSessionManager.Logout(token)
 
-	prevToken := conn.GetChannel().GetAuthToken()
-
-	absChannel, ok := conn.GetChannel().(*impl.TCPChannel)
-	if ok {
-		absChannel.SetChannelUserName(user)
-		absChannel.SetChannelPassword([]byte(pass))
-	}
-
-	err = absChannel.DoAuthenticateForRESTConsumer()
-	if err != nil {
-		// Handle the error here
+	session, bResult := oDataSessionManager.Acquire(token)
+	if !bResult {
+		writeUnauthorized(w)
+		return
 	}
-	userToken := absChannel.GetAuthToken()
-	absChannel.SetAuthToken(prevToken)
+	defer oDataSessionManager.Release(session)
+	conn := session.GetConnection()
+	userToken := conn.GetChannel().GetAuthToken()
 
 	var tgdbRestOAuthMetadata tgdbrest.TGDBRestOAuthMetadataForConnection
 	//var currentConnection tgdb.TGConnection
@@ -870,13 +942,13 @@ func oDataURLHandler (w http.ResponseWriter, r *http.Request) {
 	}
 
 	if strings.Compare(r.URL.Path, TGDB_REST_PATH) == 0 {
-		fmt.Fprintf(w, readSvcCollectionContent())
+		w.Write([]byte(readSvcCollectionContent()))
 	}
 	if strings.Compare(r.URL.Path, TGDB_REST_METADATA_PATH) == 0 {
-		fmt.Fprintf(w, readSvcMetadataCollectionContent())
+		w.Write([]byte(readSvcMetadataCollectionContent()))
 	}
 	if strings.Compare(r.URL.Path, TGDB_REST_CONNECTIONS_PATH) == 0 {
-		fmt.Fprintf(w, readSvcConnections(tgdbRestOAuthMetadata))
+		w.Write([]byte(readSvcConnections(tgdbRestOAuthMetadata)))
 	}
 	if strings.Compare(r.URL.Path, TGDB_REST_TYPES_PATH) == 0 {
 		content := readSvcTypes(conn)
@@ -886,7 +958,7 @@ func oDataURLHandler (w http.ResponseWriter, r *http.Request) {
 			w.Write([]byte("Internal Server Error.\n"))
 			return
 		}
-		fmt.Fprintf(w, content)
+		w.Write([]byte(content))
 	}
 	if strings.Compare(r.URL.Path, TGDB_REST_TYPEDETAILS_PATH) == 0 {
 		//content := readSvcTypeDetails(currentConnection)
@@ -897,9 +969,8 @@ func oDataURLHandler (w http.ResponseWriter, r *http.Request) {
 			w.Write([]byte("Internal Server Error.\n"))
 			return
 		}
-		fmt.Fprintf(w, content)
+		w.Write([]byte(content))
 	}
-	resetConnectionWithPrevToken(conn, prevToken)
 }
 
 func readSvcCollectionContent () string {
@@ -27,6 +27,9 @@ type TGDBRestAuthenticateRequest struct {
 
 type TGDBRestAuthenticateResponse struct {
 	Token	string
+	TokenType	string
+	ExpiresIn	int64
+	IdleTimeout	int64
 }
 
 type TGDBRestRequest struct {
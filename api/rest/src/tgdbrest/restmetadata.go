@@ -46,7 +46,7 @@ func RESTTransaction (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Req
 		handleRESTError(err.Error(), w)
 		return
 	}
-	gmd, err := conn.GetGraphMetadata(true);
+	gmd, err := conn.GetGraphMetadataContext(r.Context(), true);
 	nodeDetail, ok := body["CreateNode"]
 	if ok {
 		nodeDetailConcrete, ok := nodeDetail.(map[string]interface{})
@@ -92,7 +92,7 @@ func RESTTransaction (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Req
 				return
 			}
 
-			_, err = conn.Commit()
+			_, err = conn.CommitContext(r.Context())
 			if err != nil {
 				handleRESTError(err.Error(), w)
 				return
@@ -150,7 +150,7 @@ func RESTTransaction (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Req
 				}
 			}
 
-			tgEntity, tgError := conn.GetEntity(compositeKey, nil)
+			tgEntity, tgError := conn.GetEntityContext(r.Context(), compositeKey, nil)
 			if tgError != nil {
 				handleRESTError(tgError.Error(), w)
 				return
@@ -160,7 +160,7 @@ func RESTTransaction (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Req
 				handleRESTError(err.Error(), w)
 				return
 			}
-			_, err = conn.Commit()
+			_, err = conn.CommitContext(r.Context())
 			if err != nil {
 				handleRESTError(err.Error(), w)
 				return
@@ -231,7 +231,7 @@ func RESTTransaction (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Req
 							}
 						}
 
-						tgEntityFromNode, tgError = conn.GetEntity(compositeKeyForFromNode, nil)
+						tgEntityFromNode, tgError = conn.GetEntityContext(r.Context(), compositeKeyForFromNode, nil)
 						if tgError != nil {
 							handleRESTError(tgError.Error(), w)
 							return
@@ -277,7 +277,7 @@ func RESTTransaction (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Req
 							}
 						}
 
-						tgEntityToNode, tgError = conn.GetEntity(compositeKeyForToNode, nil)
+						tgEntityToNode, tgError = conn.GetEntityContext(r.Context(), compositeKeyForToNode, nil)
 						if tgError != nil {
 							handleRESTError(tgError.Error(), w)
 							return
@@ -321,7 +321,7 @@ func RESTTransaction (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Req
 					handleRESTError(err.Error(), w)
 					return
 				}
-				_, tgError = conn.Commit()
+				_, tgError = conn.CommitContext(r.Context())
 				if tgError != nil {
 					handleRESTError(tgError.Error(), w)
 					return
@@ -369,7 +369,7 @@ func MetadataQueryForNodeTypes (conn tgdb.TGConnection, w http.ResponseWriter, r
 	}
 
 	//if prefetchMetaData {
-	gmd, err := conn.GetGraphMetadata(true)
+	gmd, err := conn.GetGraphMetadataContext(r.Context(), true)
 	if err != nil {
 		handleRESTError(err.Error(), w)
 		return
@@ -395,7 +395,7 @@ func MetadataQueryForNodeTypes (conn tgdb.TGConnection, w http.ResponseWriter, r
 			return
 		}
 
-		fmt.Fprintf(w, string(b))
+		w.Write(b)
 	} else {
 		var nodeType tgdb.TGNodeType
 		var tgError tgdb.TGError
@@ -413,7 +413,7 @@ func MetadataQueryForNodeTypes (conn tgdb.TGConnection, w http.ResponseWriter, r
 			handleRESTError(er.Error(), w)
 			return
 		}
-		fmt.Fprintf(w, string(result))
+		w.Write(result)
 	}
 }
 
@@ -428,7 +428,7 @@ func MetadataQueryForEdgeTypes (conn tgdb.TGConnection, w http.ResponseWriter, r
 	}
 
 
-	gmd, err := conn.GetGraphMetadata(true)
+	gmd, err := conn.GetGraphMetadataContext(r.Context(), true)
 	if err != nil {
 		handleRESTError(err.Error(), w)
 		return
@@ -449,7 +449,7 @@ func MetadataQueryForEdgeTypes (conn tgdb.TGConnection, w http.ResponseWriter, r
 			return
 		}
 
-		fmt.Fprintf(w, string(b))
+		w.Write(b)
 	} else {
 		var edgeType tgdb.TGEdgeType
 		var tgError tgdb.TGError
@@ -467,7 +467,7 @@ func MetadataQueryForEdgeTypes (conn tgdb.TGConnection, w http.ResponseWriter, r
 			handleRESTError(er.Error(), w)
 			return
 		}
-		fmt.Fprintf(w, string(result))
+		w.Write(result)
 	}
 
 }
@@ -482,7 +482,7 @@ func MetadataQueryForAttributeDescriptors (conn tgdb.TGConnection, w http.Respon
 		return
 	}
 
-	gmd, err := conn.GetGraphMetadata(true)
+	gmd, err := conn.GetGraphMetadataContext(r.Context(), true)
 	if err != nil {
 		handleRESTError(err.Error(), w)
 		return
@@ -502,7 +502,7 @@ func MetadataQueryForAttributeDescriptors (conn tgdb.TGConnection, w http.Respon
 			handleRESTError(error.Error(), w)
 			return
 		}
-		fmt.Fprintf(w, string(b))
+		w.Write(b)
 	} else {
 		var attributeDescriptor tgdb.TGAttributeDescriptor
 		var tgError tgdb.TGError
@@ -521,7 +521,7 @@ func MetadataQueryForAttributeDescriptors (conn tgdb.TGConnection, w http.Respon
 			return
 		}
 
-		fmt.Fprintf(w, string(result))
+		w.Write(result)
 	}
 }
 
@@ -536,7 +536,7 @@ func MetadataCreateForAttributeDescriptors (conn tgdb.TGConnection, w http.Respo
 		return
 	}
 
-	gmd, err := conn.GetGraphMetadata(true)
+	gmd, err := conn.GetGraphMetadataContext(r.Context(), true)
 	if err != nil {
 		return
 	}
@@ -564,7 +564,7 @@ func MetadataQueryForUsers (conn tgdb.TGConnection, w http.ResponseWriter, r *ht
 		handleRESTError(er.Error(), w)
 		return
 	}
-	fmt.Fprintf(w, string(result))
+	w.Write(result)
 }
 
 func MetadataQueryForConnections (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Request, headers map[string]string, body map[string] string) {
@@ -584,7 +584,7 @@ func MetadataQueryForConnections (conn tgdb.TGConnection, w http.ResponseWriter,
 		handleRESTError(er.Error(), w)
 		return
 	}
-	fmt.Fprintf(w, string(result))
+	w.Write(result)
 }
 
 
@@ -596,14 +596,14 @@ func Query (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Request, head
 		//resultSet, err := conn.ExecuteQuery(gremlinQuery, queryOptions)
 		//return obj.TGDBConnection.ExecuteQuery(expr, options)
 		//resultSet, err := conn.ExecuteQuery(gremlinQuery, nil)
-		resultSet, err := conn.(*impl.AdminConnectionImpl).TGDBConnection.ExecuteQuery(gremlinQuery, queryOptions)
+		resultSet, err := conn.(*impl.AdminConnectionImpl).TGDBConnection.ExecuteQueryContext(r.Context(), gremlinQuery, queryOptions)
 		if err != nil {
 			serverErrorCode := err.GetServerErrorCode()
 			serverErrorMsg := err.GetErrorMsg()
 			logger.Error("ErrorCode: " + strconv.Itoa(serverErrorCode))
 			logger.Error("ErrorMessage: " + serverErrorMsg)
 
-			fmt.Fprintf(w, serverErrorMsg)
+			w.Write([]byte(serverErrorMsg))
 			return
 		} else {
 			cytoscapeForm := false
@@ -630,7 +630,7 @@ func Query (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Request, head
 					logger.Debug("Query Result:" + string(result))
 				}
 				w.Header().Set("Access-Control-Allow-Origin", "*")
-				fmt.Fprintf(w, string(result))
+				w.Write(result)
 			} else {
 				var result [] byte
 				var er error
@@ -642,7 +642,7 @@ func Query (conn tgdb.TGConnection, w http.ResponseWriter, r *http.Request, head
 					return
 				}
 				w.Header().Set("Access-Control-Allow-Origin", "*")
-				fmt.Fprintf(w, string(result))
+				w.Write(result)
 			}
 		}
 	}
@@ -0,0 +1,309 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: restsession.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package tgdbrest
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"tgdb"
+	"tgdb/impl"
+	"time"
+)
+
+// TGDBRestSession is a user signed in to the gateway. It holds a connection of the session pool, whose channel
+// is authenticated as the user, until the user logs out or the session expires
+type TGDBRestSession struct {
+	user            string
+	conn            tgdb.TGConnection
+	poolCredentials tgdb.TGCredentialProvider // The credentials the channel goes back to when the session ends
+	createdAt       time.Time
+	expiresAt       time.Time
+	lastUsed        int64      // UnixNano of the last request, accessed atomically
+	busy            int32      // Number of requests in flight, accessed atomically
+	closed          bool       // Guarded by lock
+	lock            sync.Mutex // Lets one request at a time use the connection
+}
+
+// GetConnection returns the connection of the session
+func (obj *TGDBRestSession) GetConnection() tgdb.TGConnection {
+	return obj.conn
+}
+
+// GetUser returns the user the session is authenticated as
+func (obj *TGDBRestSession) GetUser() string {
+	return obj.user
+}
+
+// GetCreatedAt returns when the user signed in
+func (obj *TGDBRestSession) GetCreatedAt() time.Time {
+	return obj.createdAt
+}
+
+// GetExpiresAt returns when the session ends, however busy it is
+func (obj *TGDBRestSession) GetExpiresAt() time.Time {
+	return obj.expiresAt
+}
+
+func (obj *TGDBRestSession) String() string {
+	return fmt.Sprintf("TGDBRestSession:{User: %s, ConnectionId: %d, CreatedAt: %s, ExpiresAt: %s}",
+		obj.user, obj.conn.GetConnectionId(), obj.createdAt.Format(time.RFC3339), obj.expiresAt.Format(time.RFC3339))
+}
+
+// isExpired checks whether the session has reached its lifetime, or has been idle for too long
+func (obj *TGDBRestSession) isExpired(now time.Time, idleTimeout time.Duration) bool {
+	if now.After(obj.expiresAt) {
+		return true
+	}
+	lastUsed := time.Unix(0, atomic.LoadInt64(&obj.lastUsed))
+	return idleTimeout > 0 && atomic.LoadInt32(&obj.busy) == 0 && now.Sub(lastUsed) > idleTimeout
+}
+
+// TGDBRestSessionManager issues the bearer tokens of the gateway and keeps the sessions they stand for.
+// Tokens are random and opaque, and only their hash is kept, so a token cannot be guessed from another one
+// nor recovered from the gateway
+type TGDBRestSessionManager struct {
+	pool        tgdb.TGConnectionPool
+	idleTimeout time.Duration
+	lifetime    time.Duration
+	lock        sync.Mutex
+	sessions    map[string]*TGDBRestSession // By hash of the token
+	stop        chan struct{}
+}
+
+// sessionTokenBytes is the number of random bytes of a token
+const sessionTokenBytes = 32
+
+// NewTGDBRestSessionManager returns a session manager that hands out the connections of pool. Every connection must
+// have a channel of its own, i.e. the pool needs tgdb.connectionpool.useDedicatedChannelPerConnection=true, since the
+// channel is authenticated as the user of the session. Sessions end after lifetime, or once idle for idleTimeout
+func NewTGDBRestSessionManager(pool tgdb.TGConnectionPool, idleTimeout, lifetime time.Duration) *TGDBRestSessionManager {
+	obj := &TGDBRestSessionManager{
+		pool:        pool,
+		idleTimeout: idleTimeout,
+		lifetime:    lifetime,
+		sessions:    make(map[string]*TGDBRestSession),
+		stop:        make(chan struct{}),
+	}
+	go obj.evictExpired()
+	return obj
+}
+
+// Authenticate signs the user in on a connection of the pool and returns the bearer token of the new session
+func (obj *TGDBRestSessionManager) Authenticate(user string, password []byte) (string, *TGDBRestSession, tgdb.TGError) {
+	token, err := newSessionToken()
+	if err != nil {
+		errMsg := "Unable to generate a session token"
+		return "", nil, impl.GetErrorByType(impl.TGErrorGeneralException, impl.INTERNAL_SERVER_ERROR, errMsg, err.Error())
+	}
+	conn, tgErr := obj.pool.Get()
+	if tgErr != nil {
+		logger.Error(fmt.Sprintf("ERROR: Returning TGDBRestSessionManager:Authenticate - unable to get a connection for user '%s' w/ '%s'", user, tgErr.Error()))
+		return "", nil, tgErr
+	}
+	channel := conn.GetChannel()
+	poolCredentials := channel.GetCredentialProvider()
+	userCredentials := impl.NewStaticCredentialProvider(user, password)
+	if tgErr := authenticateChannel(channel, userCredentials); tgErr != nil {
+		logger.Warning(fmt.Sprintf("WARNING: Returning TGDBRestSessionManager:Authenticate - user '%s' failed to authenticate w/ '%s'", user, tgErr.Error()))
+		_, _ = obj.pool.ReleaseConnection(conn)
+		return "", nil, tgErr
+	}
+	// Reconnects of the channel sign in as the user too, for as long as the session lasts
+	channel.SetCredentialProvider(userCredentials)
+
+	now := time.Now()
+	session := &TGDBRestSession{
+		user:            user,
+		conn:            conn,
+		poolCredentials: poolCredentials,
+		createdAt:       now,
+		expiresAt:       now.Add(obj.lifetime),
+		lastUsed:        now.UnixNano(),
+	}
+	obj.lock.Lock()
+	obj.sessions[hashSessionToken(token)] = session
+	obj.lock.Unlock()
+	logger.Info(fmt.Sprintf("Started session of user '%s' on connection '%d'", user, conn.GetConnectionId()))
+	return token, session, nil
+}
+
+// Acquire returns the live session of the token for a request, after any other request of the session is done.
+// Every acquired session must be handed back with Release
+func (obj *TGDBRestSessionManager) Acquire(token string) (*TGDBRestSession, bool) {
+	if token == "" {
+		return nil, false
+	}
+	key := hashSessionToken(token)
+	obj.lock.Lock()
+	session, ok := obj.sessions[key]
+	obj.lock.Unlock()
+	if !ok {
+		return nil, false
+	}
+	atomic.AddInt32(&session.busy, 1)
+	session.lock.Lock()
+	if session.closed || time.Now().After(session.expiresAt) {
+		session.lock.Unlock()
+		atomic.AddInt32(&session.busy, -1)
+		obj.end(key, "it expired")
+		return nil, false
+	}
+	atomic.StoreInt64(&session.lastUsed, time.Now().UnixNano())
+	return session, true
+}
+
+// Release hands back a session obtained from Acquire
+func (obj *TGDBRestSessionManager) Release(session *TGDBRestSession) {
+	atomic.StoreInt64(&session.lastUsed, time.Now().UnixNano())
+	session.lock.Unlock()
+	atomic.AddInt32(&session.busy, -1)
+}
+
+// Logout ends the session of the token, and returns whether there was one
+func (obj *TGDBRestSessionManager) Logout(token string) bool {
+	if token == "" {
+		return false
+	}
+	return obj.end(hashSessionToken(token), "the user logged out")
+}
+
+// Close ends all the sessions and stops evicting
+func (obj *TGDBRestSessionManager) Close() {
+	close(obj.stop)
+	obj.lock.Lock()
+	keys := make([]string, 0, len(obj.sessions))
+	for key := range obj.sessions {
+		keys = append(keys, key)
+	}
+	obj.lock.Unlock()
+	for _, key := range keys {
+		obj.end(key, "the gateway is shutting down")
+	}
+}
+
+// GetSessionCount returns the number of live sessions
+func (obj *TGDBRestSessionManager) GetSessionCount() int {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	return len(obj.sessions)
+}
+
+/////////////////////////////////////////////////////////////////
+// Private functions for TGDBRestSessionManager
+/////////////////////////////////////////////////////////////////
+
+// end removes the session and gives its connection back to the pool once the request in flight, if any, is done
+func (obj *TGDBRestSessionManager) end(key, reason string) bool {
+	obj.lock.Lock()
+	session, ok := obj.sessions[key]
+	delete(obj.sessions, key)
+	obj.lock.Unlock()
+	if !ok {
+		return false
+	}
+	session.lock.Lock()
+	defer session.lock.Unlock()
+	if session.closed {
+		return false
+	}
+	session.closed = true
+	logger.Info(fmt.Sprintf("Ended session of user '%s' on connection '%d' as %s", session.user, session.conn.GetConnectionId(), reason))
+	obj.returnConnection(session.conn, session.poolCredentials)
+	return true
+}
+
+// returnConnection authenticates the channel as the pool user again and releases the connection. A connection
+// that cannot authenticate is disconnected, so that the pool replaces it rather than hand out the user's channel
+func (obj *TGDBRestSessionManager) returnConnection(conn tgdb.TGConnection, poolCredentials tgdb.TGCredentialProvider) {
+	channel := conn.GetChannel()
+	channel.SetCredentialProvider(poolCredentials)
+	if err := authenticateChannel(channel, poolCredentials); err != nil {
+		logger.Warning(fmt.Sprintf("WARNING: Inside TGDBRestSessionManager:returnConnection - unable to authenticate connection '%d' as the pool user w/ '%s'", conn.GetConnectionId(), err.Error()))
+		_ = conn.Disconnect()
+	}
+	if _, err := obj.pool.ReleaseConnection(conn); err != nil {
+		logger.Error(fmt.Sprintf("ERROR: Inside TGDBRestSessionManager:returnConnection - unable to release connection '%d' w/ '%s'", conn.GetConnectionId(), err.Error()))
+	}
+}
+
+// evictExpired ends the sessions that expired or went idle, until the manager is closed
+func (obj *TGDBRestSessionManager) evictExpired() {
+	interval := obj.idleTimeout / 2
+	if interval <= 0 || interval > time.Minute {
+		interval = time.Minute
+	} else if interval < time.Second {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-obj.stop:
+			return
+		case now := <-ticker.C:
+			expired := make([]string, 0)
+			obj.lock.Lock()
+			for key, session := range obj.sessions {
+				if session.isExpired(now, obj.idleTimeout) {
+					expired = append(expired, key)
+				}
+			}
+			obj.lock.Unlock()
+			for _, key := range expired {
+				obj.end(key, "it expired or went idle")
+			}
+		}
+	}
+}
+
+// restAuthenticator is implemented by the channels that can sign in another user while connected
+type restAuthenticator interface {
+	DoAuthenticateForRESTConsumer(credentials tgdb.TGCredentialProvider) tgdb.TGError
+}
+
+// authenticateChannel signs the connected channel in with credentials
+func authenticateChannel(channel tgdb.TGChannel, credentials tgdb.TGCredentialProvider) tgdb.TGError {
+	authenticator, ok := channel.(restAuthenticator)
+	if !ok {
+		errMsg := fmt.Sprintf("Channel '%T' does not support gateway sessions", channel)
+		return impl.GetErrorByType(impl.TGErrorGeneralException, impl.INTERNAL_SERVER_ERROR, errMsg, "")
+	}
+	return authenticator.DoAuthenticateForRESTConsumer(credentials)
+}
+
+// newSessionToken returns a random, URL safe token
+func newSessionToken() (string, error) {
+	buf := make([]byte, sessionTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
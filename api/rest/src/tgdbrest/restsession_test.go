@@ -0,0 +1,269 @@
+/*
+ * Copyright 2019 TIBCO Software Inc. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); You may not use this file except
+ * in compliance with the License.
+ * A copy of the License is included in the distribution package with this file.
+ * You also may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * File name: restsession_test.go
+ * Created on: 10/18/2026
+ *
+ * SVN Id: $Id:  $
+ */
+
+package tgdbrest_test
+
+import (
+	"sync"
+	"testing"
+	"tgdb"
+	"tgdb/impl"
+	"tgdb/mockserver"
+	"tgdbrest"
+	"time"
+)
+
+const poolUser = "api"
+
+// authRecorder keeps the user names the mock server was asked to authenticate, in arrival order
+type authRecorder struct {
+	lock  sync.Mutex
+	users []string
+}
+
+func (obj *authRecorder) record(request tgdb.TGMessage) (tgdb.TGMessage, tgdb.TGError) {
+	authRequest := request.(*impl.AuthenticateRequestMessage)
+	obj.lock.Lock()
+	obj.users = append(obj.users, authRequest.GetUserName())
+	obj.lock.Unlock()
+	if string(authRequest.GetPassword()) == "wrong" {
+		response := impl.DefaultAuthenticateResponseMessage()
+		response.SetSuccess(false)
+		response.SetErrorStatus(1)
+		return response, nil
+	}
+	return nil, nil
+}
+
+func (obj *authRecorder) lastUser() string {
+	obj.lock.Lock()
+	defer obj.lock.Unlock()
+	if len(obj.users) == 0 {
+		return ""
+	}
+	return obj.users[len(obj.users)-1]
+}
+
+// startSessionPool starts a mock server that rejects the password 'wrong', and a pool of poolSize connections
+// with a channel each, signed in as the pool user
+func startSessionPool(t *testing.T, poolSize int) (*mockserver.Server, tgdb.TGConnectionPool, *authRecorder) {
+	recorder := &authRecorder{}
+	server := mockserver.DefaultServer()
+	server.SetHandler(impl.VerbAuthenticateRequest, recorder.record)
+	if err := server.Start(); err != nil {
+		t.Fatalf("Unable to start the mock server: %s", err.Error())
+	}
+	env := map[string]string{"tgdb.connectionpool.useDedicatedChannelPerConnection": "true"}
+	credentials := impl.NewStaticCredentialProvider(poolUser, []byte("api"))
+	pool, err := impl.NewTGConnectionFactory().CreateConnectionPoolWithCredentials(server.GetUrl(), credentials, poolSize, env, tgdb.TypeConventional)
+	if err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	if err := pool.Connect(); err != nil {
+		server.Close()
+		t.Fatal(err.Error())
+	}
+	return server, pool, recorder
+}
+
+// waitFor polls condition until it holds, and fails the test once timeout elapses
+func waitFor(t *testing.T, timeout time.Duration, what string, condition func() bool) {
+	deadline := time.Now().Add(timeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSessionAuthenticatesTheUserOnItsConnection(t *testing.T) {
+	server, pool, recorder := startSessionPool(t, 1)
+	defer server.Close()
+	defer pool.Disconnect()
+	manager := tgdbrest.NewTGDBRestSessionManager(pool, 0, time.Hour)
+	defer manager.Close()
+
+	token, session, err := manager.Authenticate("alice", []byte("alice"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if token == "" || session.GetUser() != "alice" || recorder.lastUser() != "alice" {
+		t.Fatalf("Expected a token for a session of 'alice', got '%s' for '%s' authenticated as '%s'", token, session.GetUser(), recorder.lastUser())
+	}
+	acquired, ok := manager.Acquire(token)
+	if !ok || acquired != session {
+		t.Fatalf("Expected the token to stand for the session")
+	}
+	manager.Release(acquired)
+
+	if _, ok := manager.Acquire(token + "x"); ok {
+		t.Fatalf("Expected another token to be rejected")
+	}
+	if _, ok := manager.Acquire(""); ok {
+		t.Fatalf("Expected an empty token to be rejected")
+	}
+}
+
+func TestSessionRejectsBadPasswordAndReleasesTheConnection(t *testing.T) {
+	server, pool, _ := startSessionPool(t, 1)
+	defer server.Close()
+	defer pool.Disconnect()
+	manager := tgdbrest.NewTGDBRestSessionManager(pool, 0, time.Hour)
+	defer manager.Close()
+
+	_, _, err := manager.Authenticate("alice", []byte("wrong"))
+	if err == nil || err.GetErrorType() != impl.TGErrorBadAuthentication {
+		t.Fatalf("Expected a bad authentication error, got '%v'", err)
+	}
+	if manager.GetSessionCount() != 0 {
+		t.Fatalf("Expected no session, got %d", manager.GetSessionCount())
+	}
+	// The only connection of the pool must be available again
+	if _, _, err := manager.Authenticate("alice", []byte("alice")); err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func TestSessionExpiredTokenIsRejected(t *testing.T) {
+	server, pool, _ := startSessionPool(t, 1)
+	defer server.Close()
+	defer pool.Disconnect()
+	manager := tgdbrest.NewTGDBRestSessionManager(pool, 0, 50*time.Millisecond)
+	defer manager.Close()
+
+	token, _, err := manager.Authenticate("alice", []byte("alice"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	time.Sleep(100 * time.Millisecond)
+	if _, ok := manager.Acquire(token); ok {
+		t.Fatalf("Expected the expired token to be rejected")
+	}
+	if manager.GetSessionCount() != 0 {
+		t.Fatalf("Expected the expired session to be ended, got %d sessions", manager.GetSessionCount())
+	}
+}
+
+func TestSessionLogoutRevokesTheToken(t *testing.T) {
+	server, pool, _ := startSessionPool(t, 1)
+	defer server.Close()
+	defer pool.Disconnect()
+	manager := tgdbrest.NewTGDBRestSessionManager(pool, 0, time.Hour)
+	defer manager.Close()
+
+	token, _, err := manager.Authenticate("alice", []byte("alice"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !manager.Logout(token) {
+		t.Fatalf("Expected the logout to end the session")
+	}
+	if _, ok := manager.Acquire(token); ok {
+		t.Fatalf("Expected the token to be revoked by the logout")
+	}
+	if manager.Logout(token) {
+		t.Fatalf("Expected a second logout to find no session")
+	}
+}
+
+func TestSessionIdleEvictionSkipsBusySessions(t *testing.T) {
+	server, pool, _ := startSessionPool(t, 1)
+	defer server.Close()
+	defer pool.Disconnect()
+	manager := tgdbrest.NewTGDBRestSessionManager(pool, 100*time.Millisecond, time.Hour)
+	defer manager.Close()
+
+	token, _, err := manager.Authenticate("alice", []byte("alice"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	session, ok := manager.Acquire(token)
+	if !ok {
+		t.Fatalf("Expected the token to stand for a session")
+	}
+	// Evictions run every second at the most, so a busy session has been passed over at least once by now
+	time.Sleep(1500 * time.Millisecond)
+	if manager.GetSessionCount() != 1 {
+		manager.Release(session)
+		t.Fatalf("Expected the busy session to be kept, got %d sessions", manager.GetSessionCount())
+	}
+	manager.Release(session)
+	waitFor(t, 3*time.Second, "the idle session to be evicted", func() bool {
+		return manager.GetSessionCount() == 0
+	})
+	if _, ok := manager.Acquire(token); ok {
+		t.Fatalf("Expected the token of the evicted session to be rejected")
+	}
+}
+
+func TestSessionConnectionIsAuthenticatedAsPoolUserBeforeRelease(t *testing.T) {
+	server, pool, recorder := startSessionPool(t, 1)
+	defer server.Close()
+	defer pool.Disconnect()
+	manager := tgdbrest.NewTGDBRestSessionManager(pool, 0, time.Hour)
+	defer manager.Close()
+
+	token, session, err := manager.Authenticate("alice", []byte("alice"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	connectionId := session.GetConnection().GetConnectionId()
+
+	// The session holds the only connection, so the pool hands it out once the session gives it back
+	type handout struct {
+		conn     tgdb.TGConnection
+		lastUser string
+	}
+	handouts := make(chan handout, 1)
+	go func() {
+		conn, err := pool.Get()
+		if err != nil {
+			t.Error(err.Error())
+			handouts <- handout{}
+			return
+		}
+		handouts <- handout{conn, recorder.lastUser()}
+	}()
+
+	if !manager.Logout(token) {
+		t.Fatalf("Expected the logout to end the session")
+	}
+	select {
+	case next := <-handouts:
+		if next.conn == nil {
+			t.FailNow()
+		}
+		defer pool.ReleaseConnection(next.conn)
+		if next.conn.GetConnectionId() != connectionId {
+			t.Fatalf("Expected connection '%d' back in the pool, got '%d'", connectionId, next.conn.GetConnectionId())
+		}
+		if next.lastUser != poolUser {
+			t.Fatalf("Expected the connection to be authenticated as '%s' before release, was '%s'", poolUser, next.lastUser)
+		}
+		user, _, err := next.conn.GetChannel().GetCredentialProvider().GetCredentials()
+		if err != nil || user != poolUser {
+			t.Fatalf("Expected the channel to reconnect as '%s', got '%s'", poolUser, user)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the pool to hand out the connection")
+	}
+}